@@ -19,5 +19,10 @@ func (app *Application) Run() error {
 		return err
 	}
 
-	return app.writer.Write(app.generator.Generate(swagger))
+	result, err := app.generator.Generate(swagger)
+	if err != nil {
+		return err
+	}
+
+	return app.writer.Write(result)
 }
@@ -12,6 +12,14 @@ import (
 	"github.com/heetch/confita/backend/flags"
 )
 
+const (
+	RouterChi    = "chi"
+	RouterStdlib = "stdlib"
+	RouterEcho   = "echo"
+	RouterGin    = "gin"
+	RouterFiber  = "fiber"
+)
+
 type Config struct {
 	SwaggerAddr string `config:"swagger-addr,required"`
 	Package     string `config:"package,required"`
@@ -22,12 +30,27 @@ type Config struct {
 
 	Authorization string `config:"authorization,short=a,description=a list of comma-separated key:value pairs to be sent as headers alongside each http request"`
 
-	PassRawRequest    bool `config:"pass-raw-request,description=pass raw request to handler"`
-	PrioritizeXGoType bool `config:"prioritize-x-go-type,description=prioritize x-go-type declaration over schema type, if both are provided"`
+	PassRawRequest     bool `config:"pass-raw-request,description=pass raw request to handler"`
+	PrioritizeXGoType  bool `config:"prioritize-x-go-type,description=prioritize x-go-type declaration over schema type, if both are provided"`
+	GenerateClient     bool `config:"client,description=generate a typed http client per tag into client_gen.go"`
+	Optional           bool `config:"optional,description=generate non-required and nullable component properties as Optional[T] telling absent, null and set values apart"`
+	PreciseTypes       bool `config:"precise-types,description=map int32/int64/float/double formats to sized go types, date-time to time.Time, date to Date and duration to Duration"`
+	ProblemDetails     bool `config:"problem-details,description=answer parse, validation and security failures with application/problem+json instead of calling the service"`
+	AggregateErrors    bool `config:"aggregate-errors,description=run every parameter and body parser and report all their violations together through RequestParseFailed"`
+	StrictService      bool `config:"strict-service,description=generate service methods returning (Response, error), errors are answered by a ServiceErrorHandler"`
+	Codecs             bool `config:"codecs,description=decode and encode bodies with the Codec registered for their media type in the Codecs passed to <Tag>Handler"`
+	ContentNegotiation bool `config:"content-negotiation,description=negotiate the response media type from the Accept header, requests accepting no declared media type are answered 406"`
+	SecurityPrincipals bool `config:"security-principals,description=security schemas return the authenticated principal, attached to the request and its context"`
+
+	MultipartMaxMemory int64 `config:"multipart-max-memory,description=bytes of a multipart/form-data body kept in memory while parsing, larger files are stored on disk"`
+
+	Router string `config:"router,description=router the generated routes are mounted on: chi, stdlib, echo, gin or fiber"`
 }
 
 func (config *Config) Defaults() *Config {
 	config.SwaggerAddr = "swagger.yaml"
+	config.Router = RouterChi
+	config.MultipartMaxMemory = 32 << 20
 
 	return config
 }
@@ -70,6 +93,12 @@ func (configurator *Configurator) PostConstruct() (err error) {
 		return err
 	}
 
+	switch configurator.config.Router {
+	case RouterChi, RouterStdlib, RouterEcho, RouterGin, RouterFiber:
+	default:
+		return fmt.Errorf("unsupported router: %q", configurator.config.Router)
+	}
+
 	if configurator.config.Path, err = configurator.concatPaths(configurator.config.Path); err != nil {
 		return err
 	}
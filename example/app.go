@@ -10,198 +10,162 @@ import (
 
 type transactionsService struct{}
 
-func (t transactionsService) PostTransaction(ctx context.Context, request *PostTransactionRequest) *PostTransactionResponse {
+func (t transactionsService) PostTransaction(ctx context.Context, request PostTransactionRequest) PostTransactionResponse {
 	log.Printf("processing create transaction request...\n")
 
 	if err := request.ProcessingResult.Err(); err != nil {
-		return &PostTransactionResponse{
-			response: &response{
-				statusCode: 400,
-				body:       GenericResponse{Result: ResultFailed},
-				headers:    map[string]string{"Content-Type": "application/json"},
-			},
-		}
+		return PostTransactionResponseBuilder().
+			StatusCode400().
+			ApplicationJson().
+			Body(GenericResponse{Result: GenericResponseResultEnumFailed}).
+			Build()
 	}
 
-	log.Printf("creating transaction - '%v'\n", request.body)
+	log.Printf("creating transaction - '%v'\n", request.Body)
 
-	res := GenericResponse{Result: ResultSuccess}
+	res := GenericResponse{Result: GenericResponseResultEnumSuccess}
 	if err := res.Validate(); err != nil {
-		return &PostTransactionResponse{
-			response: &response{
-				statusCode: 500,
-				body:       GenericResponse{Result: ResultFailed},
-				headers:    map[string]string{"Content-Type": "application/json"},
-			},
-		}
+		return PostTransactionResponseBuilder().
+			StatusCode500().
+			ApplicationJson().
+			Body(GenericResponse{Result: GenericResponseResultEnumFailed}).
+			Build()
 	}
 
-	return &PostTransactionResponse{
-		response: &response{
-			statusCode: 201,
-			body:       res,
-			headers:    map[string]string{"Content-Type": "application/json"},
-		},
-	}
+	return PostTransactionResponseBuilder().
+		StatusCode201().
+		ApplicationJson().
+		Body(res).
+		Build()
 }
 
-func (t transactionsService) PutTransaction(ctx context.Context, request *PutTransactionRequest) *PutTransactionResponse {
+func (t transactionsService) PutTransaction(ctx context.Context, request PutTransactionRequest) PutTransactionResponse {
 	log.Printf("processing update transaction request...\n")
 
 	if err := request.ProcessingResult.Err(); err != nil {
-		return &PutTransactionResponse{
-			response: &response{
-				statusCode: 400,
-				body:       GenericResponse{Result: ResultFailed},
-				headers:    map[string]string{"Content-Type": "application/json"},
-			},
-		}
+		return PutTransactionResponseBuilder().
+			StatusCode400().
+			ApplicationJson().
+			Body(GenericResponse{Result: GenericResponseResultEnumFailed}).
+			Build()
 	}
 
-	log.Printf("updating transaction - '%v'\n", request.body)
+	log.Printf("updating transaction - '%v'\n", request.Body)
 
-	res := GenericResponse{Result: ResultSuccess}
+	res := GenericResponse{Result: GenericResponseResultEnumSuccess}
 	if err := res.Validate(); err != nil {
-		return &PutTransactionResponse{
-			response: &response{
-				statusCode: 500,
-				body:       GenericResponse{Result: ResultFailed},
-				headers:    map[string]string{"Content-Type": "application/json"},
-			},
-		}
+		return PutTransactionResponseBuilder().
+			StatusCode500().
+			ApplicationJson().
+			Body(GenericResponse{Result: GenericResponseResultEnumFailed}).
+			Build()
 	}
 
-	return &PutTransactionResponse{
-		response: &response{
-			statusCode: 200,
-			body:       res,
-			headers:    map[string]string{"Content-Type": "application/json"},
-		},
-	}
+	return PutTransactionResponseBuilder().
+		StatusCode200().
+		ApplicationJson().
+		Body(res).
+		Build()
 }
 
-func (t transactionsService) DeleteTransactionsUUID(ctx context.Context, request *DeleteTransactionsUUIDRequest) *DeleteTransactionsUUIDResponse {
+func (t transactionsService) DeleteTransactionsUUID(ctx context.Context, request DeleteTransactionsUUIDRequest) DeleteTransactionsUUIDResponse {
 	log.Printf("processing delete transaction request...\n")
 
 	if err := request.ProcessingResult.Err(); err != nil {
-		return &DeleteTransactionsUUIDResponse{
-			response: &response{
-				statusCode: 400,
-				body:       GenericResponse{Result: ResultFailed},
-				headers:    map[string]string{"Content-Type": "application/json"},
-			},
-		}
+		return DeleteTransactionsUUIDResponseBuilder().
+			StatusCode400().
+			ApplicationJson().
+			Body(GenericResponse{Result: GenericResponseResultEnumFailed}).
+			Build()
 	}
 
-	log.Printf("deleting transaction with UUID: %s\n", request.path.UUID)
-
-	res := GenericResponse{Result: ResultSuccess}
-	return &DeleteTransactionsUUIDResponse{
-		response: &response{
-			statusCode: 200,
-			body:       res,
-			headers: map[string]string{
-				"Content-Type":     "application/json",
-				"Content-Encoding": "gzip",
-			},
-		},
-	}
+	log.Printf("deleting transaction with UUID: %s\n", request.Path.UUID)
+
+	return DeleteTransactionsUUIDResponseBuilder().
+		StatusCode200().
+		ApplicationJson().
+		Body(GenericResponse{Result: GenericResponseResultEnumSuccess}).
+		Build()
 }
 
 type authService struct{}
 
-func (a authService) GetSecureEndpoint(ctx context.Context, request *GetSecureEndpointRequest) *GetSecureEndpointResponse {
-	return &GetSecureEndpointResponse{
-		response: &response{
-			statusCode: 200,
-			body:       map[string]string{"message": "Hello from secure endpoint"},
-			headers:    map[string]string{"Content-Type": "application/json"},
-		},
+func (a authService) GetSecureEndpoint(ctx context.Context, request GetSecureEndpointRequest) GetSecureEndpointResponse {
+	if request.ProcessingResult.Err() != nil {
+		return GetSecureEndpointResponseBuilder().StatusCode401().Build()
 	}
+
+	return GetSecureEndpointResponseBuilder().
+		StatusCode200().
+		ApplicationJson().
+		Body(GetSecureEndpointApplicationjson{Message: "Hello from secure endpoint"}).
+		Build()
 }
 
-func (a authService) GetSemiSecureEndpoint(ctx context.Context, request *GetSemiSecureEndpointRequest) *GetSemiSecureEndpointResponse {
-	return &GetSemiSecureEndpointResponse{
-		response: &response{
-			statusCode: 200,
-			body: map[string]string{
-				"message": "Hello from semi-secure endpoint",
-				"apiKey":  "received",
-			},
-			headers: map[string]string{"Content-Type": "application/json"},
-		},
+func (a authService) GetSemiSecureEndpoint(ctx context.Context, request GetSemiSecureEndpointRequest) GetSemiSecureEndpointResponse {
+	if request.ProcessingResult.Err() != nil {
+		return GetSemiSecureEndpointResponseBuilder().StatusCode400().Build()
 	}
+
+	return GetSemiSecureEndpointResponseBuilder().
+		StatusCode200().
+		ApplicationJson().
+		Body(GetSemiSecureEndpointApplicationjson{Message: "Hello from semi-secure endpoint", ApiKey: "received"}).
+		Build()
 }
 
-func (a authService) PostBearerEndpoint(ctx context.Context, request *PostBearerEndpointRequest) *PostBearerEndpointResponse {
-	return &PostBearerEndpointResponse{
-		response: &response{
-			statusCode: 200,
-			body:       map[string]string{"message": "Hello from bearer endpoint"},
-			headers:    map[string]string{"Content-Type": "application/json"},
-		},
+func (a authService) PostBearerEndpoint(ctx context.Context, request PostBearerEndpointRequest) PostBearerEndpointResponse {
+	if request.ProcessingResult.Err() != nil {
+		return PostBearerEndpointResponseBuilder().StatusCode401().Build()
 	}
+
+	return PostBearerEndpointResponseBuilder().
+		StatusCode200().
+		ApplicationJson().
+		Body(PostBearerEndpointApplicationjson{Message: "Hello from bearer endpoint"}).
+		Build()
 }
 
 type callbacksService struct{}
 
-func (c callbacksService) PostCallbacksCallbackType(ctx context.Context, request *PostCallbacksCallbackTypeRequest) *PostCallbacksCallbackTypeResponse {
-	log.Printf("processing callback of type: %s\n", request.path.CallbackType)
-	
-	return &PostCallbacksCallbackTypeResponse{
-		response: &response{
-			statusCode: 200,
-			body:       request.body, // Echo back the raw payload
-			headers: map[string]string{
-				"Content-Type":     "application/octet-stream",
-				"Set-Cookie":       "JSESSIONID=example123; Path=/; HttpOnly",
-				"x-jws-signature":  "example-signature",
-			},
-		},
-	}
+func (c callbacksService) PostCallbacksCallbackType(ctx context.Context, request PostCallbacksCallbackTypeRequest) PostCallbacksCallbackTypeResponse {
+	log.Printf("processing callback of type: %s\n", request.Path.CallbackType)
+
+	// Echo back the raw payload
+	return PostCallbacksCallbackTypeResponseBuilder().
+		StatusCode200().
+		Headers(PostCallbacksCallbackType200Headers{XJwsSignature: "example-signature"}).
+		SetCookie(http.Cookie{Name: "JSESSIONID", Value: "example123", Path: "/", HttpOnly: true}).
+		ApplicationOctetStream().
+		Body(request.Body).
+		Build()
 }
 
-func NewApp() *http.Server {
-	// Create services
-	transactionsService := &transactionsService{}
-	authService := &authService{}
-	callbacksService := &callbacksService{}
+// securitySchemas accepts any non-empty credential.
+type securitySchemas struct{}
 
-	// Create routers
-	transactionsRouter := NewTransactionsRouter(transactionsService)
-	authRouter := NewAuthRouter(authService)
-	callbacksRouter := NewCallbacksRouter(callbacksService)
+func (securitySchemas) SecuritySchemeApiKeyAuth(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return nil
+}
 
-	// Main router
-	mainRouter := chi.NewRouter()
+func (securitySchemas) SecuritySchemeBasic(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return nil
+}
 
-	// Manually register routes (since generated code doesn't auto-register)
-	// Transaction routes
-	mainRouter.Post("/transaction", func(w http.ResponseWriter, r *http.Request) {
-		transactionsRouter.postTransaction(w, r)
-	})
-	mainRouter.Put("/transaction", func(w http.ResponseWriter, r *http.Request) {
-		transactionsRouter.putTransaction(w, r)
-	})
-	mainRouter.Delete("/transactions/{uuid}", func(w http.ResponseWriter, r *http.Request) {
-		transactionsRouter.deleteTransactionsUUID(w, r)
-	})
+func (securitySchemas) SecuritySchemeBearer(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return nil
+}
 
-	// Auth routes  
-	mainRouter.Get("/secure-endpoint", func(w http.ResponseWriter, r *http.Request) {
-		authRouter.getSecureEndpoint(w, r)
-	})
-	mainRouter.Get("/semi-secure-endpoint", func(w http.ResponseWriter, r *http.Request) {
-		authRouter.getSemiSecureEndpoint(w, r)
-	})
-	mainRouter.Post("/bearer-endpoint", func(w http.ResponseWriter, r *http.Request) {
-		authRouter.postBearerEndpoint(w, r)
-	})
+func (securitySchemas) SecuritySchemeCookie(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return nil
+}
 
-	// Callback routes
-	mainRouter.Post("/callbacks/{callbackType}", func(w http.ResponseWriter, r *http.Request) {
-		callbacksRouter.postCallbacksCallbackType(w, r)
-	})
+func NewApp() *http.Server {
+	mainRouter := chi.NewRouter()
+
+	TransactionsHandler(transactionsService{}, mainRouter, nil, securitySchemas{})
+	AuthHandler(authService{}, mainRouter, nil, securitySchemas{})
+	CallbacksHandler(callbacksService{}, mainRouter, nil, securitySchemas{})
 
 	// Health check endpoint
 	mainRouter.Get("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -214,4 +178,4 @@ func NewApp() *http.Server {
 		Addr:    ":8080",
 		Handler: mainRouter,
 	}
-}
\ No newline at end of file
+}
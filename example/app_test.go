@@ -48,10 +48,10 @@ func TestValidation(t *testing.T) {
 }
 
 func TestGenericResponse(t *testing.T) {
-	// Test valid enum values - note: GenericResponse.Result is of type Result enum
+	// Test valid enum values - note: GenericResponse.Result is of type GenericResponseResultEnum
 	validResponses := []GenericResponse{
-		{Result: ResultSuccess},
-		{Result: ResultFailed},
+		{Result: GenericResponseResultEnumSuccess},
+		{Result: GenericResponseResultEnumFailed},
 	}
 
 	for _, resp := range validResponses {
@@ -60,22 +60,21 @@ func TestGenericResponse(t *testing.T) {
 		}
 	}
 
-	// Test Result enum validation directly since GenericResponse.Validate() returns nil
-	// Valid enum values should validate correctly
-	validResults := []Result{
-		ResultSuccess,
-		ResultFailed,
+	// Test GenericResponseResultEnum directly, valid enum values should pass the check
+	validResults := []GenericResponseResultEnum{
+		GenericResponseResultEnumSuccess,
+		GenericResponseResultEnumFailed,
 	}
 
 	for _, result := range validResults {
-		if err := result.Validate(); err != nil {
+		if err := result.Check(); err != nil {
 			t.Errorf("expected valid result %v to pass validation, got error: %v", result, err)
 		}
 	}
 
 	// Test invalid enum value
-	invalidResult := Result("invalid")
-	if err := invalidResult.Validate(); err == nil {
+	invalidResult := GenericResponseResultEnum("invalid")
+	if err := invalidResult.Check(); err == nil {
 		t.Error("expected invalid result to fail validation")
 	}
 }
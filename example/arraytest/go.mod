@@ -4,4 +4,4 @@ go 1.24.4
 
 require github.com/go-chi/chi/v5 v5.2.2
 
-require github.com/go-ozzo/ozzo-validation/v4 v4.3.0 // indirect
+require github.com/go-ozzo/ozzo-validation/v4 v4.3.0
@@ -4,6 +4,7 @@ package example
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	uuid "github.com/google/uuid"
@@ -11,7 +12,10 @@ import (
 	currency "github.com/mikekonan/go-types/v2/currency"
 	email "github.com/mikekonan/go-types/v2/email"
 	url "github.com/mikekonan/go-types/v2/url"
+	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -51,7 +55,7 @@ type CreateTransactionRequest struct {
 func (body *CreateTransactionRequest) UnmarshalJSON(data []byte) error {
 	var value createTransactionRequest
 	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+		return unmarshalViolations(data, &value, err)
 	}
 
 	body.Amount = value.Amount
@@ -61,15 +65,15 @@ func (body *CreateTransactionRequest) UnmarshalJSON(data []byte) error {
 	body.Currency = value.Currency
 	body.Details = value.Details
 	body.Email = value.Email
-	if !regexParamRegex.MatchString(body.RegexParam) {
-		return fmt.Errorf("RegexParam not matched by the '^[.?\\d]+$' regex")
+	if value.RegexParam != "" && !regexParamRegex.MatchString(value.RegexParam) {
+		return NewViolation("", "/regexParam", "pattern", "not matched by the '^[.?\\d]+$' regex")
 	}
 	body.RegexParam = value.RegexParam
 	body.Title = strings.TrimSpace(value.Title)
 	body.TransactionID = value.TransactionID
 
 	if value.Description == nil {
-		return fmt.Errorf("Description is required")
+		return NewViolation("", "/description", "required", "is required")
 	}
 
 	body.Description = strings.TrimSpace(*value.Description)
@@ -79,10 +83,11 @@ func (body *CreateTransactionRequest) UnmarshalJSON(data []byte) error {
 func (body CreateTransactionRequest) Validate() error {
 	return validation.ValidateStruct(&body,
 		validation.Field(&body.Amount, validation.Min(0.009).Exclusive()),
-		validation.Field(&body.Country, validation.Skip.When(body.Country == ""), validation.RuneLength(2, 2)),
-		validation.Field(&body.Currency, validation.Skip.When(body.Currency == ""), validation.RuneLength(3, 3)),
-		validation.Field(&body.Title, validation.Skip.When(body.Title == ""), validation.RuneLength(8, 50)),
-		validation.Field(&body.Description, validation.Required, validation.RuneLength(8, 100)))
+		validation.Field(&body.AmountCents, validation.Max(100)),
+		validation.Field(&body.Country, validation.Skip.When(body.Country == ""), validation.RuneLength(2, 0), validation.RuneLength(0, 2)),
+		validation.Field(&body.Currency, validation.Skip.When(body.Currency == ""), validation.RuneLength(3, 0), validation.RuneLength(0, 3)),
+		validation.Field(&body.Title, validation.Skip.When(body.Title == ""), validation.RuneLength(8, 0), validation.RuneLength(0, 50)),
+		validation.Field(&body.Description, validation.Required, validation.RuneLength(8, 0), validation.RuneLength(0, 100)))
 }
 
 type Email = email.Email
@@ -98,7 +103,7 @@ type GenericResponse struct {
 func (body *GenericResponse) UnmarshalJSON(data []byte) error {
 	var value genericResponse
 	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+		return unmarshalViolations(data, &value, err)
 	}
 
 	body.Result = value.Result
@@ -132,14 +137,14 @@ type UpdateTransactionRequest struct {
 func (body *UpdateTransactionRequest) UnmarshalJSON(data []byte) error {
 	var value updateTransactionRequest
 	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+		return unmarshalViolations(data, &value, err)
 	}
 
 	body.Details = value.Details
 	body.Title = strings.TrimSpace(value.Title)
 
 	if value.Description == nil {
-		return fmt.Errorf("Description is required")
+		return NewViolation("", "/description", "required", "is required")
 	}
 
 	body.Description = strings.TrimSpace(*value.Description)
@@ -158,7 +163,7 @@ type PostBearerEndpointApplicationjson struct {
 func (body *PostBearerEndpointApplicationjson) UnmarshalJSON(data []byte) error {
 	var value postBearerEndpointApplicationjson
 	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+		return unmarshalViolations(data, &value, err)
 	}
 
 	body.Message = value.Message
@@ -180,7 +185,7 @@ type GetSecureEndpointApplicationjson struct {
 func (body *GetSecureEndpointApplicationjson) UnmarshalJSON(data []byte) error {
 	var value getSecureEndpointApplicationjson
 	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+		return unmarshalViolations(data, &value, err)
 	}
 
 	body.Message = value.Message
@@ -204,7 +209,7 @@ type GetSemiSecureEndpointApplicationjson struct {
 func (body *GetSemiSecureEndpointApplicationjson) UnmarshalJSON(data []byte) error {
 	var value getSemiSecureEndpointApplicationjson
 	if err := json.Unmarshal(data, &value); err != nil {
-		return err
+		return unmarshalViolations(data, &value, err)
 	}
 
 	body.ApiKey = value.ApiKey
@@ -240,7 +245,7 @@ func (enum *GenericResponseResultEnum) UnmarshalJSON(data []byte) error {
 	enumValue := GenericResponseResultEnum(strValue)
 	if err := enumValue.Check(); err != nil {
 
-		return err
+		return NewViolation("", "", "enum", err.Error())
 	}
 	*enum = enumValue
 
@@ -271,9 +276,202 @@ func (enum *WithEnum) UnmarshalJSON(data []byte) error {
 	enumValue := WithEnum(strValue)
 	if err := enumValue.Check(); err != nil {
 
-		return err
+		return NewViolation("", "", "enum", err.Error())
 	}
 	*enum = enumValue
 
 	return nil
 }
+
+// Violation is a failed constraint of a request. Pointer is the JSON pointer of the failed value in its location,
+// the body, query, header, path or cookie, and Keyword is the failed JSON Schema keyword.
+type Violation struct {
+	Pointer  string `json:"pointer"`
+	Location string `json:"location"`
+	Keyword  string `json:"keyword,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ValidationError lists the violations found while parsing and validating a request.
+type ValidationError struct {
+	Violations []Violation `json:"violations"`
+}
+
+var violationKeywords = map[string]string{
+	"validation_in_invalid":                      "enum",
+	"validation_is_dns_name":                     "format",
+	"validation_is_email":                        "format",
+	"validation_is_ipv4":                         "format",
+	"validation_is_request_url":                  "format",
+	"validation_length_too_long":                 "maxLength",
+	"validation_length_too_short":                "minLength",
+	"validation_match_invalid":                   "pattern",
+	"validation_max_items":                       "maxItems",
+	"validation_max_less_equal_than_required":    "maximum",
+	"validation_max_less_than_required":          "exclusiveMaximum",
+	"validation_max_properties":                  "maxProperties",
+	"validation_min_greater_equal_than_required": "minimum",
+	"validation_min_greater_than_required":       "exclusiveMinimum",
+	"validation_min_items":                       "minItems",
+	"validation_min_properties":                  "minProperties",
+	"validation_multiple_of_invalid":             "multipleOf",
+	"validation_nil_or_not_empty_required":       "required",
+	"validation_not_nil_required":                "required",
+	"validation_required":                        "required",
+	"validation_unique_items":                    "uniqueItems",
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func NewViolation(location, pointer, keyword, message string) ValidationError {
+	return ValidationError{Violations: []Violation{Violation{
+		Keyword:  keyword,
+		Location: location,
+		Message:  message,
+		Pointer:  pointer,
+	}}}
+}
+
+func (err ValidationError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		if violation.Pointer == "" {
+			messages = append(messages, violation.Message)
+			continue
+		}
+
+		messages = append(messages, violation.Pointer+": "+violation.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// ValidationErrorOf converts an error of a parser or a validator found in location into a ValidationError,
+// the violations of ozzo errors, encoding/json type errors and nested ValidationErrors are prefixed with pointer.
+// A nil err has no violations.
+func ValidationErrorOf(location, pointer string, err error) ValidationError {
+	if err == nil {
+		return ValidationError{}
+	}
+
+	var (
+		result     ValidationError
+		violations ValidationError
+		errs       validation.Errors
+		ruleErr    validation.Error
+		typeErr    *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &violations):
+		for _, violation := range violations.Violations {
+			violation.Pointer = pointer + violation.Pointer
+			if violation.Location == "" {
+				violation.Location = location
+			}
+			result.Violations = append(result.Violations, violation)
+		}
+	case errors.As(err, &errs):
+		keys := make([]string, 0, len(errs))
+		for key := range errs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			result.Violations = append(result.Violations, ValidationErrorOf(location, pointer+"/"+pointerEscaper.Replace(key), errs[key]).Violations...)
+		}
+	case errors.As(err, &ruleErr):
+		keyword, ok := violationKeywords[ruleErr.Code()]
+		if !ok {
+			keyword = ruleErr.Code()
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  keyword,
+			Location: location,
+			Message:  ruleErr.Error(),
+			Pointer:  pointer,
+		})
+	case errors.As(err, &typeErr):
+		if typeErr.Field != "" {
+			pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "type",
+			Location: location,
+			Message:  fmt.Sprintf("cannot unmarshal %s into %s", typeErr.Value, typeErr.Type),
+			Pointer:  pointer,
+		})
+	default:
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "",
+			Location: location,
+			Message:  err.Error(),
+			Pointer:  pointer,
+		})
+	}
+
+	return result
+}
+
+// unmarshalViolations locates the properties of data failing to unmarshal into the fields of the unmarshal helper
+// target, so the violations of nested components point at them. It is called once unmarshalling has failed.
+func unmarshalViolations(data []byte, target interface{}, err error) error {
+	var properties map[string]json.RawMessage
+	if json.Unmarshal(data, &properties) != nil {
+		return ValidationErrorOf("", "", err)
+	}
+
+	var result ValidationError
+	fields := reflect.TypeOf(target).Elem()
+	for i := 0; i < fields.NumField(); i++ {
+		name, _, _ := strings.Cut(fields.Field(i).Tag.Get("json"), ",")
+		if property, ok := properties[name]; ok {
+			result.Violations = append(result.Violations, propertyViolations("/"+pointerEscaper.Replace(name), property, fields.Field(i).Type)...)
+		}
+	}
+
+	if len(result.Violations) == 0 {
+		return ValidationErrorOf("", "", err)
+	}
+
+	return result
+}
+
+// propertyViolations unmarshals a property on its own, the items of failing arrays and maps are unmarshalled one by one.
+func propertyViolations(pointer string, data []byte, typ reflect.Type) []Violation {
+	err := json.Unmarshal(data, reflect.New(typ).Interface())
+	if err == nil {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var violations []Violation
+	switch typ.Kind() {
+	case reflect.Slice:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for i, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+strconv.Itoa(i), item, typ.Elem())...)
+			}
+		}
+	case reflect.Map:
+		var items map[string]json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for key, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+pointerEscaper.Replace(key), item, typ.Elem())...)
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return ValidationErrorOf("", pointer, err).Violations
+	}
+
+	return violations
+}
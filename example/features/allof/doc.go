@@ -0,0 +1,4 @@
+// Package allof tests the structs generated for allOf schemas.
+package allof
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package allof -path .
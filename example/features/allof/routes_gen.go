@@ -0,0 +1,395 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package allof
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	chi "github.com/go-chi/chi/v5"
+	"net/http"
+	"slices"
+)
+
+type Hooks struct {
+	RequestSecurityParseFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestSecurityParseCompleted func(*http.Request, string)
+	RequestSecurityCheckFailed    func(*http.Request, string, string, RequestProcessingResult)
+	RequestSecurityCheckCompleted func(*http.Request, string, string)
+	RequestBodyUnmarshalFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
+	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestBodyUnmarshalCompleted func(*http.Request, string)
+	RequestHeaderParseCompleted   func(*http.Request, string)
+	RequestPathParseCompleted     func(*http.Request, string)
+	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
+	RequestParseCompleted         func(*http.Request, string)
+	RequestProcessingCompleted    func(*http.Request, string)
+	RequestRedirectStarted        func(*http.Request, string, string)
+	ResponseBodyMarshalCompleted  func(*http.Request, string)
+	ResponseBodyWriteCompleted    func(*http.Request, string, int)
+	ResponseBodyMarshalFailed     func(http.ResponseWriter, *http.Request, string, error)
+	ResponseBodyWriteFailed       func(*http.Request, string, int, error)
+	ServiceCompleted              func(*http.Request, string)
+}
+
+type requestProcessingResultType uint8
+
+const (
+	BodyUnmarshalFailed requestProcessingResultType = iota + 1
+	BodyValidationFailed
+	HeaderParseFailed
+	HeaderValidationFailed
+	QueryParseFailed
+	QueryValidationFailed
+	PathParseFailed
+	PathValidationFailed
+	SecurityParseFailed
+	SecurityCheckFailed
+	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
+)
+
+type RequestProcessingResult struct {
+	error error
+	typee requestProcessingResultType
+}
+
+func NewRequestProcessingResult(t requestProcessingResultType, err error) RequestProcessingResult {
+	return RequestProcessingResult{
+		error: err,
+		typee: t,
+	}
+}
+
+func (r RequestProcessingResult) Type() requestProcessingResultType {
+	return r.typee
+}
+
+func (r RequestProcessingResult) Err() error {
+	return r.error
+}
+
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
+func EmployeesHandler(impl EmployeesService, r chi.Router, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &employeesRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type employeesRouter struct {
+	router  chi.Router
+	service EmployeesService
+	hooks   *Hooks
+}
+
+func (router *employeesRouter) mount() {
+	router.router.Post("/employees", router.PostEmployees)
+}
+
+func (router *employeesRouter) parsePostEmployeesRequest(r *http.Request) (request PostEmployeesRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	var (
+		body      Employee
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostEmployees", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostEmployees")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostEmployees", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostEmployees")
+	}
+
+	return
+}
+
+func (router *employeesRouter) PostEmployees(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.PostEmployees(r.Context(), router.parsePostEmployeesRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostEmployees", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostEmployees")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostEmployees")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "PostEmployees", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "PostEmployees")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostEmployees", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "PostEmployees", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostEmployees", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostEmployees")
+	}
+}
+
+type response struct {
+	statusCode  int
+	body        interface{}
+	bodyRaw     []byte
+	contentType string
+	redirectURL string
+	headers     map[string]string
+	cookies     []http.Cookie
+}
+
+type responseInterface interface {
+	statusCode() int
+	body() interface{}
+	bodyRaw() []byte
+	contentType() string
+	redirectURL() string
+	cookies() []http.Cookie
+	headers() map[string]string
+}
+
+type PostEmployeesResponse interface {
+	responseInterface
+	postEmployeesResponse()
+}
+
+type postEmployeesResponse struct {
+	response
+}
+
+func (postEmployeesResponse) postEmployeesResponse() {}
+
+func (response postEmployeesResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postEmployeesResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postEmployeesResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postEmployeesResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postEmployeesResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postEmployeesResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postEmployeesResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type postEmployeesStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostEmployeesResponseBuilder() *postEmployeesStatusCodeResponseBuilder {
+	return new(postEmployeesStatusCodeResponseBuilder)
+}
+
+func (builder *postEmployeesStatusCodeResponseBuilder) StatusCode201() *postEmployees201ContentTypeBuilder {
+	builder.response.statusCode = 201
+
+	return &postEmployees201ContentTypeBuilder{response: builder.response}
+}
+
+type postEmployees201ContentTypeBuilder struct {
+	response
+}
+
+type PostEmployees201ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *PostEmployees201ApplicationJsonResponseBuilder) Build() PostEmployeesResponse {
+	return postEmployeesResponse{response: builder.response}
+}
+
+func (builder *postEmployees201ContentTypeBuilder) ApplicationJson() *postEmployees201ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &postEmployees201ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type postEmployees201ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *postEmployees201ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *PostEmployees201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &PostEmployees201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postEmployees201ApplicationJsonBodyBuilder) BodyBytes(body []byte) *PostEmployees201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &PostEmployees201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postEmployees201ApplicationJsonBodyBuilder) Body(body Employee) *PostEmployees201ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &PostEmployees201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type EmployeesService interface {
+	PostEmployees(context.Context, PostEmployeesRequest) PostEmployeesResponse
+}
+
+type PostEmployeesRequest struct {
+	Body             Employee
+	ProcessingResult RequestProcessingResult
+}
+
+type SecurityScheme string
+
+const ()
+
+type securityProcessor struct {
+	scheme  SecurityScheme
+	extract func(r *http.Request) (string, string, bool)
+	handle  func(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+var securityExtractorsFuncs = map[SecurityScheme]func(r *http.Request) (string, string, bool){}
+
+type SecuritySchemas interface{}
+
+type SecurityCheckResult struct {
+	Scheme SecurityScheme
+	Value  string
+}
@@ -0,0 +1,12 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package allof
+
+import "net/http"
+
+var spec = []byte("{\"components\":{\"schemas\":{\"Audited\":{\"properties\":{\"createdBy\":{\"type\":\"string\"}},\"type\":\"object\"},\"Employee\":{\"allOf\":[{\"$ref\":\"#/components/schemas/Person\"},{\"$ref\":\"#/components/schemas/Audited\"},{\"properties\":{\"homepage\":{\"$ref\":\"#/components/schemas/Homepage\"},\"salary\":{\"minimum\":1,\"type\":\"integer\"}},\"required\":[\"salary\"],\"type\":\"object\"}]},\"Homepage\":{\"allOf\":[{\"$ref\":\"#/components/schemas/Link\"},{\"description\":\"the homepage of a person\"}]},\"Link\":{\"properties\":{\"href\":{\"type\":\"string\"}},\"required\":[\"href\"],\"type\":\"object\"},\"Name\":{\"minLength\":1,\"type\":\"string\"},\"Nickname\":{\"allOf\":[{\"$ref\":\"#/components/schemas/Name\"},{\"description\":\"the name a person goes by\"}]},\"Person\":{\"properties\":{\"name\":{\"minLength\":1,\"type\":\"string\"}},\"required\":[\"name\"],\"type\":\"object\"}}},\"info\":{\"description\":\"allOf schemas embedding their referenced parts and merging their inline parts\",\"title\":\"AllOf\",\"version\":\"1.0.0\"},\"openapi\":\"3.0.3\",\"paths\":{\"/employees\":{\"post\":{\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Employee\"}}},\"required\":true},\"responses\":{\"201\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Employee\"}}},\"description\":\"created\"}},\"tags\":[\"employees\"]}}}}")
+
+func Spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(spec)
+}
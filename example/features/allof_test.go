@@ -0,0 +1,106 @@
+package features
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestAllOfEmbedsEveryPart(t *testing.T) {
+	var employee Employee
+	if err := json.Unmarshal([]byte(`{"name": "Ann", "createdBy": "hr", "salary": 10}`), &employee); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if employee.Name != "Ann" || employee.Salary != 10 {
+		t.Errorf("Expected the properties of every part, got %+v", employee)
+	}
+
+	if createdBy, ok := employee.CreatedBy.Get(); !ok || createdBy != "hr" {
+		t.Errorf("Expected createdBy to be hr, got %+v", employee.CreatedBy)
+	}
+
+	data, err := json.Marshal(employee)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(data) != `{"createdBy":"hr","name":"Ann","salary":10}` {
+		t.Errorf("Expected a single flat object, got %s", data)
+	}
+}
+
+func TestAllOfRequiredAndValidation(t *testing.T) {
+	tests := []struct {
+		name                  string
+		jsonInput             string
+		expectUnmarshalError  bool
+		expectValidationError bool
+	}{
+		{
+			name:      "Valid employee",
+			jsonInput: `{"name": "Ann", "salary": 10}`,
+		},
+		{
+			name:                 "Required property of the first part missing",
+			jsonInput:            `{"salary": 10}`,
+			expectUnmarshalError: true,
+		},
+		{
+			name:                 "Required property of the inline part missing",
+			jsonInput:            `{"name": "Ann"}`,
+			expectUnmarshalError: true,
+		},
+		{
+			name:                  "Inline part below its minimum",
+			jsonInput:             `{"name": "Ann", "salary": -1}`,
+			expectValidationError: true,
+		},
+		{
+			name:                  "First part below its minLength",
+			jsonInput:             `{"name": "", "salary": 10}`,
+			expectValidationError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var employee Employee
+			err := json.Unmarshal([]byte(tt.jsonInput), &employee)
+
+			if tt.expectUnmarshalError != (err != nil) {
+				t.Fatalf("Expected unmarshal error: %v, got: %v", tt.expectUnmarshalError, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if err := employee.Validate(); tt.expectValidationError != (err != nil) {
+				t.Errorf("Expected validation error: %v, got: %v", tt.expectValidationError, err)
+			}
+		})
+	}
+}
+
+func TestAllOfBodies(t *testing.T) {
+	service := &animalsService{}
+	handler := AnimalsHandler(service, chi.NewRouter(), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/employees", strings.NewReader(`{"name": "Ann", "salary": -1}`))
+	req.Header.Set("Content-Type", "application/json")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if service.result.Type() != BodyValidationFailed {
+		t.Fatalf("Expected the body validation to fail, got: %v", service.result.Err())
+	}
+
+	violations, ok := service.result.ValidationError()
+	if !ok || len(violations.Violations) != 1 || violations.Violations[0].Pointer != "/salary" {
+		t.Errorf("Expected a single violation at /salary, got: %+v", violations)
+	}
+}
@@ -0,0 +1,583 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type ClientResponse interface {
+	StatusCode() int
+	ContentType() string
+	Headers() map[string]string
+	Cookies() []http.Cookie
+	Body() interface{}
+}
+
+var securityAppliersFuncs = map[SecurityScheme]func(r *http.Request, value string){
+	SecuritySchemeApiKey: func(r *http.Request, value string) {
+		r.Header.Set("X-API-Key", value)
+	},
+}
+
+func applySecurityRequirements(r *http.Request, credentials map[SecurityScheme]string, requirements [][]SecurityScheme, optional bool) error {
+	for _, schemes := range requirements {
+		isSatisfied := true
+		for _, scheme := range schemes {
+			if _, ok := credentials[scheme]; !ok {
+				isSatisfied = false
+				break
+			}
+		}
+
+		if !isSatisfied {
+			continue
+		}
+
+		for _, scheme := range schemes {
+			if apply, ok := securityAppliersFuncs[scheme]; ok {
+				apply(r, credentials[scheme])
+			}
+		}
+
+		return nil
+	}
+
+	if optional {
+		return nil
+	}
+
+	return errors.New("no credentials satisfy the operation security requirements")
+}
+
+func clientParameterValues(value interface{}) []string {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Slice || reflected.Type().Elem().Kind() == reflect.Uint8 {
+		return []string{clientParameterValue(value)}
+	}
+
+	values := make([]string, 0, reflected.Len())
+	for i := 0; i < reflected.Len(); i++ {
+		values = append(values, clientParameterValue(reflected.Index(i).Interface()))
+	}
+
+	return values
+}
+
+func clientParameterValue(value interface{}) string {
+	if t, ok := value.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	return fmt.Sprint(value)
+}
+
+func clientPathValue(value interface{}) string {
+	values := clientParameterValues(value)
+	for i := range values {
+		values[i] = url.PathEscape(values[i])
+	}
+
+	return strings.Join(values, ",")
+}
+
+func clientDeepObjectValues(value interface{}) map[string]string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var properties map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&properties); err != nil {
+		return nil
+	}
+
+	values := make(map[string]string, len(properties))
+	for key, property := range properties {
+		if property != nil {
+			values[key] = fmt.Sprint(property)
+		}
+	}
+
+	return values
+}
+
+func clientEncodeBody(contentType string, body interface{}) (io.Reader, error) {
+	switch contentType {
+	case "application/xml":
+		data, err := xml.Marshal(body)
+		return bytes.NewReader(data), err
+	case "application/octet-stream":
+		data, ok := body.([]byte)
+		if !ok {
+			return nil, errors.New("body is not []byte")
+		}
+
+		return bytes.NewReader(data), nil
+	default:
+		data, err := json.Marshal(body)
+		return bytes.NewReader(data), err
+	}
+}
+
+func clientDecodeBody(contentType string, data []byte, into interface{}) error {
+	switch contentType {
+	case "application/xml":
+		return xml.Unmarshal(data, into)
+	case "application/json":
+		return json.Unmarshal(data, into)
+	}
+
+	if raw, ok := into.(*[]byte); ok {
+		*raw = data
+		return nil
+	}
+
+	return json.Unmarshal(data, into)
+}
+
+func clientResponseHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key := range header {
+		headers[key] = header.Get(key)
+	}
+
+	return headers
+}
+
+type PetsClient struct {
+	baseURL     string
+	httpClient  *http.Client
+	credentials map[SecurityScheme]string
+}
+
+func NewPetsClient(baseURL string, httpClient *http.Client, credentials map[SecurityScheme]string) *PetsClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &PetsClient{baseURL: strings.TrimRight(baseURL, "/"), httpClient: httpClient, credentials: credentials}
+}
+
+type GetBatchesIdsClientResponse interface {
+	GetBatchesIdsResponse
+	ClientResponse
+}
+
+func (response getBatchesIdsResponse) StatusCode() int {
+	return response.response.statusCode
+}
+
+func (response getBatchesIdsResponse) ContentType() string {
+	return response.response.contentType
+}
+
+func (response getBatchesIdsResponse) Headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getBatchesIdsResponse) Cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+func (response getBatchesIdsResponse) Body() interface{} {
+	if response.response.body != nil {
+		return response.response.body
+	}
+
+	return response.response.bodyRaw
+}
+
+func (response *getBatchesIdsResponse) decode(data []byte) error {
+	switch response.response.statusCode {
+	case 200:
+		switch response.response.contentType {
+		case "application/json":
+			var body GetBatchesIdsApplicationjson
+			if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+				return err
+			}
+
+			response.response.body = body
+			return nil
+		}
+	default:
+		switch response.response.statusCode / 100 {
+		case 4:
+			switch response.response.contentType {
+			case "application/json":
+				var body Failure
+				if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+					return err
+				}
+
+				response.response.body = body
+				return nil
+			}
+		default:
+			switch response.response.contentType {
+			case "application/json":
+				var body Failure
+				if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+					return err
+				}
+
+				response.response.body = body
+				return nil
+			}
+		}
+	}
+
+	response.response.bodyRaw = data
+
+	return nil
+}
+
+func (client *PetsClient) GetBatchesIds(ctx context.Context, request GetBatchesIdsRequest) (GetBatchesIdsClientResponse, error) {
+	path := "/batches/{ids}"
+	path = strings.ReplaceAll(path, "{ids}", clientPathValue(request.Path.Ids))
+
+	query := url.Values{}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, "GET", client.baseURL+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest.URL.RawQuery = query.Encode()
+
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result getBatchesIdsResponse
+	result.response.statusCode = httpResponse.StatusCode
+	result.response.contentType, _, _ = mime.ParseMediaType(httpResponse.Header.Get("Content-Type"))
+	result.response.headers = clientResponseHeaders(httpResponse.Header)
+	for _, cookie := range httpResponse.Cookies() {
+		result.response.cookies = append(result.response.cookies, *cookie)
+	}
+
+	if err := result.decode(data); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type GetPetsClientResponse interface {
+	GetPetsResponse
+	ClientResponse
+}
+
+func (response getPetsResponse) StatusCode() int {
+	return response.response.statusCode
+}
+
+func (response getPetsResponse) ContentType() string {
+	return response.response.contentType
+}
+
+func (response getPetsResponse) Headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getPetsResponse) Cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+func (response getPetsResponse) Body() interface{} {
+	if response.response.body != nil {
+		return response.response.body
+	}
+
+	return response.response.bodyRaw
+}
+
+func (response *getPetsResponse) decode(data []byte) error {
+	switch response.response.statusCode {
+	case 200:
+		switch response.response.contentType {
+		case "application/json":
+			var body GetPetsApplicationjson
+			if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+				return err
+			}
+
+			response.response.body = body
+			return nil
+		}
+	}
+
+	response.response.bodyRaw = data
+
+	return nil
+}
+
+func (client *PetsClient) GetPets(ctx context.Context, request GetPetsRequest) (GetPetsClientResponse, error) {
+	path := "/pets"
+
+	query := url.Values{}
+	if request.Query.LimitSet {
+		for _, value := range clientParameterValues(request.Query.Limit) {
+			query.Add("limit", value)
+		}
+	}
+	if request.Query.TagsSet {
+		for _, value := range clientParameterValues(request.Query.Tags) {
+			query.Add("tags", value)
+		}
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, "GET", client.baseURL+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest.URL.RawQuery = query.Encode()
+	if request.Header.XRequestIDSet {
+		httpRequest.Header.Set("X-Request-ID", strings.Join(clientParameterValues(request.Header.XRequestID), ","))
+	}
+
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result getPetsResponse
+	result.response.statusCode = httpResponse.StatusCode
+	result.response.contentType, _, _ = mime.ParseMediaType(httpResponse.Header.Get("Content-Type"))
+	result.response.headers = clientResponseHeaders(httpResponse.Header)
+	for _, cookie := range httpResponse.Cookies() {
+		result.response.cookies = append(result.response.cookies, *cookie)
+	}
+
+	if err := result.decode(data); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type PostPetsClientResponse interface {
+	PostPetsResponse
+	ClientResponse
+}
+
+func (response postPetsResponse) StatusCode() int {
+	return response.response.statusCode
+}
+
+func (response postPetsResponse) ContentType() string {
+	return response.response.contentType
+}
+
+func (response postPetsResponse) Headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postPetsResponse) Cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+func (response postPetsResponse) Body() interface{} {
+	if response.response.body != nil {
+		return response.response.body
+	}
+
+	return response.response.bodyRaw
+}
+
+func (response *postPetsResponse) decode(data []byte) error {
+	switch response.response.statusCode {
+	case 201:
+		switch response.response.contentType {
+		case "application/json":
+			var body Pet
+			if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+				return err
+			}
+
+			response.response.body = body
+			return nil
+		}
+	}
+
+	response.response.bodyRaw = data
+
+	return nil
+}
+
+func (client *PetsClient) PostPets(ctx context.Context, request PostPetsRequest) (PostPetsClientResponse, error) {
+	path := "/pets"
+
+	query := url.Values{}
+
+	body, err := clientEncodeBody("application/json", request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, "POST", client.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest.URL.RawQuery = query.Encode()
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	if err := applySecurityRequirements(httpRequest, client.credentials, [][]SecurityScheme{{SecuritySchemeApiKey}}, false); err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result postPetsResponse
+	result.response.statusCode = httpResponse.StatusCode
+	result.response.contentType, _, _ = mime.ParseMediaType(httpResponse.Header.Get("Content-Type"))
+	result.response.headers = clientResponseHeaders(httpResponse.Header)
+	for _, cookie := range httpResponse.Cookies() {
+		result.response.cookies = append(result.response.cookies, *cookie)
+	}
+
+	if err := result.decode(data); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type GetPetsPetIDClientResponse interface {
+	GetPetsPetIDResponse
+	ClientResponse
+}
+
+func (response getPetsPetIDResponse) StatusCode() int {
+	return response.response.statusCode
+}
+
+func (response getPetsPetIDResponse) ContentType() string {
+	return response.response.contentType
+}
+
+func (response getPetsPetIDResponse) Headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getPetsPetIDResponse) Cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+func (response getPetsPetIDResponse) Body() interface{} {
+	if response.response.body != nil {
+		return response.response.body
+	}
+
+	return response.response.bodyRaw
+}
+
+func (response *getPetsPetIDResponse) decode(data []byte) error {
+	switch response.response.statusCode {
+	case 200:
+		switch response.response.contentType {
+		case "application/json":
+			var body Pet
+			if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+				return err
+			}
+
+			response.response.body = body
+			return nil
+		}
+	case 404:
+		switch response.response.contentType {
+		case "application/json":
+			var body Failure
+			if err := clientDecodeBody(response.response.contentType, data, &body); err != nil {
+				return err
+			}
+
+			response.response.body = body
+			return nil
+		}
+	}
+
+	response.response.bodyRaw = data
+
+	return nil
+}
+
+func (client *PetsClient) GetPetsPetID(ctx context.Context, request GetPetsPetIDRequest) (GetPetsPetIDClientResponse, error) {
+	path := "/pets/{petId}"
+	path = strings.ReplaceAll(path, "{petId}", clientPathValue(request.Path.PetID))
+
+	query := url.Values{}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, "GET", client.baseURL+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest.URL.RawQuery = query.Encode()
+
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result getPetsPetIDResponse
+	result.response.statusCode = httpResponse.StatusCode
+	result.response.contentType, _, _ = mime.ParseMediaType(httpResponse.Header.Get("Content-Type"))
+	result.response.headers = clientResponseHeaders(httpResponse.Header)
+	for _, cookie := range httpResponse.Cookies() {
+		result.response.cookies = append(result.response.cookies, *cookie)
+	}
+
+	if err := result.decode(data); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
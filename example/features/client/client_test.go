@@ -0,0 +1,264 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type petsService struct {
+	query  GetPetsRequestQuery
+	header GetPetsRequestHeader
+	pet    NewPet
+	ids    []int
+	reject int
+	fail   int
+}
+
+func (s *petsService) GetPets(ctx context.Context, request GetPetsRequest) GetPetsResponse {
+	s.query, s.header = request.Query, request.Header
+
+	return GetPetsResponseBuilder().StatusCode200().ApplicationJson().Body(GetPetsApplicationjson{{ID: 1, Name: "Rex"}}).Build()
+}
+
+func (s *petsService) PostPets(ctx context.Context, request PostPetsRequest) PostPetsResponse {
+	if request.ProcessingResult.Type() != ParseSucceed {
+		return PostPetsResponseBuilder().StatusCode401().Build()
+	}
+
+	s.pet = request.Body
+
+	return PostPetsResponseBuilder().StatusCode201().ApplicationJson().Body(Pet{ID: 2, Name: request.Body.Name, Tag: request.Body.Tag}).Build()
+}
+
+func (s *petsService) GetPetsPetID(ctx context.Context, request GetPetsPetIDRequest) GetPetsPetIDResponse {
+	if request.Path.PetID != 1 {
+		return GetPetsPetIDResponseBuilder().StatusCode404().ApplicationJson().Body(Failure{Message: "no such pet"}).Build()
+	}
+
+	return GetPetsPetIDResponseBuilder().StatusCode200().ApplicationJson().Body(Pet{ID: 1, Name: "Rex"}).Build()
+}
+
+func (s *petsService) GetBatchesIds(ctx context.Context, request GetBatchesIdsRequest) GetBatchesIdsResponse {
+	s.ids = request.Path.Ids
+
+	if s.reject != 0 {
+		return GetBatchesIdsResponseBuilder().StatusCode4XX(s.reject).ApplicationJson().Body(Failure{Message: "rejected"}).Build()
+	}
+
+	if s.fail != 0 {
+		return GetBatchesIdsResponseBuilder().StatusCode(s.fail).ApplicationJson().Body(Failure{Message: "failed"}).Build()
+	}
+
+	return GetBatchesIdsResponseBuilder().StatusCode200().ApplicationJson().Body(GetBatchesIdsApplicationjson{}).Build()
+}
+
+var errRejected = errors.New("rejected")
+
+type securitySchemas struct{}
+
+func (securitySchemas) SecuritySchemeApiKey(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	if value != "secret" {
+		return errRejected
+	}
+
+	return nil
+}
+
+func newServer(t *testing.T, service *petsService) string {
+	server := httptest.NewServer(PetsHandler(service, chi.NewRouter(), nil, securitySchemas{}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestClientQueryAndHeaderParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  GetPetsRequestQuery
+		header GetPetsRequestHeader
+	}{
+		{
+			name: "Nothing sent",
+		},
+		{
+			name:  "Zero value sent",
+			query: GetPetsRequestQuery{Limit: 0, LimitSet: true},
+		},
+		{
+			name:   "Every parameter sent",
+			query:  GetPetsRequestQuery{Limit: 5, LimitSet: true, Tags: []string{"cat", "dog"}, TagsSet: true},
+			header: GetPetsRequestHeader{XRequestID: "abc", XRequestIDSet: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &petsService{}
+			client := NewPetsClient(newServer(t, service), nil, nil)
+
+			response, err := client.GetPets(context.Background(), GetPetsRequest{Query: tt.query, Header: tt.header})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if response.StatusCode() != http.StatusOK {
+				t.Fatalf("Expected 200, got %d", response.StatusCode())
+			}
+
+			if !reflect.DeepEqual(response.Body(), GetPetsApplicationjson{{ID: 1, Name: "Rex"}}) {
+				t.Errorf("Expected the pets, got %#v", response.Body())
+			}
+
+			if !reflect.DeepEqual(service.query, tt.query) {
+				t.Errorf("Expected the query %+v, got %+v", tt.query, service.query)
+			}
+
+			if service.header != tt.header {
+				t.Errorf("Expected the header %+v, got %+v", tt.header, service.header)
+			}
+		})
+	}
+}
+
+func TestClientBodyAndCredentials(t *testing.T) {
+	service := &petsService{}
+	url := newServer(t, service)
+	request := PostPetsRequest{Body: NewPet{Name: "Tom", Tag: "cat"}}
+
+	if _, err := NewPetsClient(url, nil, nil).PostPets(context.Background(), request); err == nil {
+		t.Errorf("Expected an error without credentials")
+	}
+
+	response, err := NewPetsClient(url, nil, map[SecurityScheme]string{SecuritySchemeApiKey: "guess"}).PostPets(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for rejected credentials, got %d", response.StatusCode())
+	}
+
+	response, err = NewPetsClient(url, nil, map[SecurityScheme]string{SecuritySchemeApiKey: "secret"}).PostPets(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode() != http.StatusCreated || response.Body() != (Pet{ID: 2, Name: "Tom", Tag: "cat"}) {
+		t.Errorf("Expected the created pet, got %d %#v", response.StatusCode(), response.Body())
+	}
+
+	if service.pet != request.Body {
+		t.Errorf("Expected the server to receive %+v, got %+v", request.Body, service.pet)
+	}
+}
+
+func TestClientPathParameterAndResponses(t *testing.T) {
+	client := NewPetsClient(newServer(t, &petsService{}), nil, nil)
+
+	response, err := client.GetPetsPetID(context.Background(), GetPetsPetIDRequest{Path: GetPetsPetIDRequestPath{PetID: 1}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode() != http.StatusOK || response.Body() != (Pet{ID: 1, Name: "Rex"}) {
+		t.Errorf("Expected the pet, got %d %#v", response.StatusCode(), response.Body())
+	}
+
+	response, err = client.GetPetsPetID(context.Background(), GetPetsPetIDRequest{Path: GetPetsPetIDRequestPath{PetID: 9}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode() != http.StatusNotFound || response.Body() != (Failure{Message: "no such pet"}) {
+		t.Errorf("Expected the failure, got %d %#v", response.StatusCode(), response.Body())
+	}
+}
+
+func TestClientArrayPathParameter(t *testing.T) {
+	service := &petsService{}
+	client := NewPetsClient(newServer(t, service), nil, nil)
+
+	response, err := client.GetBatchesIds(context.Background(), GetBatchesIdsRequest{Path: GetBatchesIdsRequestPath{Ids: []int{1, 2}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", response.StatusCode())
+	}
+
+	if !reflect.DeepEqual(service.ids, []int{1, 2}) {
+		t.Errorf("Expected the ids [1 2] sent as 1,2, got %v", service.ids)
+	}
+}
+
+func TestRangeAndDefaultStatusCodes(t *testing.T) {
+	tests := []struct {
+		name       string
+		service    *petsService
+		expectCode int
+		expectBody Failure
+	}{
+		{name: "Range code", service: &petsService{reject: http.StatusConflict}, expectCode: http.StatusConflict, expectBody: Failure{Message: "rejected"}},
+		{name: "Default code", service: &petsService{fail: http.StatusServiceUnavailable}, expectCode: http.StatusServiceUnavailable, expectBody: Failure{Message: "failed"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewPetsClient(newServer(t, tt.service), nil, nil)
+
+			response, err := client.GetBatchesIds(context.Background(), GetBatchesIdsRequest{Path: GetBatchesIdsRequestPath{Ids: []int{1}}})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if response.StatusCode() != tt.expectCode || response.Body() != tt.expectBody {
+				t.Errorf("Expected %d %#v, got %d %#v", tt.expectCode, tt.expectBody, response.StatusCode(), response.Body())
+			}
+		})
+	}
+}
+
+func TestInvalidStatusCodes(t *testing.T) {
+	tests := []struct {
+		name        string
+		service     *petsService
+		hooked      bool
+		expectCode  int
+		expectError string
+	}{
+		{name: "Code outside of the range", service: &petsService{reject: http.StatusOK}, expectCode: http.StatusInternalServerError},
+		{name: "Code outside of 100-599", service: &petsService{fail: 42}, expectCode: http.StatusInternalServerError},
+		{name: "Code reported to the hook", service: &petsService{fail: 42}, hooked: true, expectCode: http.StatusBadGateway, expectError: "status code 42 is not between 100 and 599"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported error
+			hooks := &Hooks{}
+			if tt.hooked {
+				hooks.ResponseStatusCodeFailed = func(w http.ResponseWriter, r *http.Request, operation string, err error) {
+					reported = err
+					w.WriteHeader(http.StatusBadGateway)
+				}
+			}
+
+			recorder := httptest.NewRecorder()
+			PetsHandler(tt.service, chi.NewRouter(), hooks, securitySchemas{}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/batches/1", nil))
+
+			if recorder.Code != tt.expectCode {
+				t.Errorf("Expected status %d, got %d", tt.expectCode, recorder.Code)
+			}
+
+			if tt.hooked && (reported == nil || reported.Error() != tt.expectError) {
+				t.Errorf("Expected the hook to get %q, got %v", tt.expectError, reported)
+			}
+		})
+	}
+}
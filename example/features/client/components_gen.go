@@ -0,0 +1,305 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type failure struct {
+	Message *string `json:"message"`
+}
+
+type Failure struct {
+	Message string `json:"message"`
+}
+
+func (body *Failure) UnmarshalJSON(data []byte) error {
+	var value failure
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Message == nil {
+		return NewViolation("", "/message", "required", "is required")
+	}
+
+	body.Message = *value.Message
+
+	return nil
+}
+func (body Failure) Validate() error {
+	return nil
+}
+
+type newPet struct {
+	Name *string `json:"name"`
+	Tag  string  `json:"tag"`
+}
+
+type NewPet struct {
+	Name string `json:"name"`
+	Tag  string `json:"tag"`
+}
+
+func (body *NewPet) UnmarshalJSON(data []byte) error {
+	var value newPet
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Tag = value.Tag
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	return nil
+}
+func (body NewPet) Validate() error {
+	return nil
+}
+
+type pet struct {
+	ID   *int    `json:"id"`
+	Name *string `json:"name"`
+	Tag  string  `json:"tag"`
+}
+
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Tag  string `json:"tag"`
+}
+
+func (body *Pet) UnmarshalJSON(data []byte) error {
+	var value pet
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Tag = value.Tag
+
+	if value.ID == nil {
+		return NewViolation("", "/id", "required", "is required")
+	}
+
+	body.ID = *value.ID
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	return nil
+}
+func (body Pet) Validate() error {
+	return nil
+}
+
+type GetBatchesIdsApplicationjson = []Pet
+
+type GetPetsApplicationjson = []Pet
+
+// Violation is a failed constraint of a request. Pointer is the JSON pointer of the failed value in its location,
+// the body, query, header, path or cookie, and Keyword is the failed JSON Schema keyword.
+type Violation struct {
+	Pointer  string `json:"pointer"`
+	Location string `json:"location"`
+	Keyword  string `json:"keyword,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ValidationError lists the violations found while parsing and validating a request.
+type ValidationError struct {
+	Violations []Violation `json:"violations"`
+}
+
+var violationKeywords = map[string]string{
+	"validation_in_invalid":                      "enum",
+	"validation_is_dns_name":                     "format",
+	"validation_is_email":                        "format",
+	"validation_is_ipv4":                         "format",
+	"validation_is_request_url":                  "format",
+	"validation_length_too_long":                 "maxLength",
+	"validation_length_too_short":                "minLength",
+	"validation_match_invalid":                   "pattern",
+	"validation_max_items":                       "maxItems",
+	"validation_max_less_equal_than_required":    "maximum",
+	"validation_max_less_than_required":          "exclusiveMaximum",
+	"validation_max_properties":                  "maxProperties",
+	"validation_min_greater_equal_than_required": "minimum",
+	"validation_min_greater_than_required":       "exclusiveMinimum",
+	"validation_min_items":                       "minItems",
+	"validation_min_properties":                  "minProperties",
+	"validation_multiple_of_invalid":             "multipleOf",
+	"validation_nil_or_not_empty_required":       "required",
+	"validation_not_nil_required":                "required",
+	"validation_required":                        "required",
+	"validation_unique_items":                    "uniqueItems",
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func NewViolation(location, pointer, keyword, message string) ValidationError {
+	return ValidationError{Violations: []Violation{Violation{
+		Keyword:  keyword,
+		Location: location,
+		Message:  message,
+		Pointer:  pointer,
+	}}}
+}
+
+func (err ValidationError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		if violation.Pointer == "" {
+			messages = append(messages, violation.Message)
+			continue
+		}
+
+		messages = append(messages, violation.Pointer+": "+violation.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// ValidationErrorOf converts an error of a parser or a validator found in location into a ValidationError,
+// the violations of ozzo errors, encoding/json type errors and nested ValidationErrors are prefixed with pointer.
+// A nil err has no violations.
+func ValidationErrorOf(location, pointer string, err error) ValidationError {
+	if err == nil {
+		return ValidationError{}
+	}
+
+	var (
+		result     ValidationError
+		violations ValidationError
+		errs       validation.Errors
+		ruleErr    validation.Error
+		typeErr    *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &violations):
+		for _, violation := range violations.Violations {
+			violation.Pointer = pointer + violation.Pointer
+			if violation.Location == "" {
+				violation.Location = location
+			}
+			result.Violations = append(result.Violations, violation)
+		}
+	case errors.As(err, &errs):
+		keys := make([]string, 0, len(errs))
+		for key := range errs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			result.Violations = append(result.Violations, ValidationErrorOf(location, pointer+"/"+pointerEscaper.Replace(key), errs[key]).Violations...)
+		}
+	case errors.As(err, &ruleErr):
+		keyword, ok := violationKeywords[ruleErr.Code()]
+		if !ok {
+			keyword = ruleErr.Code()
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  keyword,
+			Location: location,
+			Message:  ruleErr.Error(),
+			Pointer:  pointer,
+		})
+	case errors.As(err, &typeErr):
+		if typeErr.Field != "" {
+			pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "type",
+			Location: location,
+			Message:  fmt.Sprintf("cannot unmarshal %s into %s", typeErr.Value, typeErr.Type),
+			Pointer:  pointer,
+		})
+	default:
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "",
+			Location: location,
+			Message:  err.Error(),
+			Pointer:  pointer,
+		})
+	}
+
+	return result
+}
+
+// unmarshalViolations locates the properties of data failing to unmarshal into the fields of the unmarshal helper
+// target, so the violations of nested components point at them. It is called once unmarshalling has failed.
+func unmarshalViolations(data []byte, target interface{}, err error) error {
+	var properties map[string]json.RawMessage
+	if json.Unmarshal(data, &properties) != nil {
+		return ValidationErrorOf("", "", err)
+	}
+
+	var result ValidationError
+	fields := reflect.TypeOf(target).Elem()
+	for i := 0; i < fields.NumField(); i++ {
+		name, _, _ := strings.Cut(fields.Field(i).Tag.Get("json"), ",")
+		if property, ok := properties[name]; ok {
+			result.Violations = append(result.Violations, propertyViolations("/"+pointerEscaper.Replace(name), property, fields.Field(i).Type)...)
+		}
+	}
+
+	if len(result.Violations) == 0 {
+		return ValidationErrorOf("", "", err)
+	}
+
+	return result
+}
+
+// propertyViolations unmarshals a property on its own, the items of failing arrays and maps are unmarshalled one by one.
+func propertyViolations(pointer string, data []byte, typ reflect.Type) []Violation {
+	err := json.Unmarshal(data, reflect.New(typ).Interface())
+	if err == nil {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var violations []Violation
+	switch typ.Kind() {
+	case reflect.Slice:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for i, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+strconv.Itoa(i), item, typ.Elem())...)
+			}
+		}
+	case reflect.Map:
+		var items map[string]json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for key, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+pointerEscaper.Replace(key), item, typ.Elem())...)
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return ValidationErrorOf("", pointer, err).Violations
+	}
+
+	return violations
+}
@@ -0,0 +1,4 @@
+// Package client tests the typed client generated with -client against the generated server.
+package client
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package client -path . -client
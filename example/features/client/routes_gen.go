@@ -0,0 +1,1501 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	chi "github.com/go-chi/chi/v5"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Hooks struct {
+	RequestSecurityParseFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestSecurityParseCompleted func(*http.Request, string)
+	RequestSecurityCheckFailed    func(*http.Request, string, string, RequestProcessingResult)
+	RequestSecurityCheckCompleted func(*http.Request, string, string)
+	RequestBodyUnmarshalFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
+	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestBodyUnmarshalCompleted func(*http.Request, string)
+	RequestHeaderParseCompleted   func(*http.Request, string)
+	RequestPathParseCompleted     func(*http.Request, string)
+	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
+	RequestParseCompleted         func(*http.Request, string)
+	RequestProcessingCompleted    func(*http.Request, string)
+	RequestRedirectStarted        func(*http.Request, string, string)
+	ResponseBodyMarshalCompleted  func(*http.Request, string)
+	ResponseBodyWriteCompleted    func(*http.Request, string, int)
+	ResponseBodyMarshalFailed     func(http.ResponseWriter, *http.Request, string, error)
+	ResponseBodyWriteFailed       func(*http.Request, string, int, error)
+	ResponseStatusCodeFailed      func(http.ResponseWriter, *http.Request, string, error)
+	ServiceCompleted              func(*http.Request, string)
+}
+
+type requestProcessingResultType uint8
+
+const (
+	BodyUnmarshalFailed requestProcessingResultType = iota + 1
+	BodyValidationFailed
+	HeaderParseFailed
+	HeaderValidationFailed
+	QueryParseFailed
+	QueryValidationFailed
+	PathParseFailed
+	PathValidationFailed
+	SecurityParseFailed
+	SecurityCheckFailed
+	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
+)
+
+type RequestProcessingResult struct {
+	error error
+	typee requestProcessingResultType
+}
+
+func NewRequestProcessingResult(t requestProcessingResultType, err error) RequestProcessingResult {
+	return RequestProcessingResult{
+		error: err,
+		typee: t,
+	}
+}
+
+func (r RequestProcessingResult) Type() requestProcessingResultType {
+	return r.typee
+}
+
+func (r RequestProcessingResult) Err() error {
+	return r.error
+}
+
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
+func PetsHandler(impl PetsService, r chi.Router, hooks *Hooks, securitySchemas SecuritySchemas) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &petsRouter{router: r, service: impl, hooks: hooks}
+
+	router.securityHandlers = map[SecurityScheme]securityProcessor{
+		SecuritySchemeApiKey: {
+			scheme:  SecuritySchemeApiKey,
+			extract: securityExtractorsFuncs[SecuritySchemeApiKey],
+			handle:  securitySchemas.SecuritySchemeApiKey,
+		},
+	}
+
+	router.mount()
+
+	return router.router
+}
+
+type petsRouter struct {
+	router           chi.Router
+	service          PetsService
+	hooks            *Hooks
+	securityHandlers map[SecurityScheme]securityProcessor
+}
+
+func (router *petsRouter) mount() {
+	router.router.Get("/batches/{ids}", router.GetBatchesIds)
+	router.router.Get("/pets", router.GetPets)
+	router.router.Post("/pets", router.PostPets)
+	router.router.Get("/pets/{petId}", router.GetPetsPetID)
+}
+
+func (router *petsRouter) parseGetBatchesIdsRequest(r *http.Request) (request GetBatchesIdsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	var pathIdsValues []string
+	if value := chi.URLParam(r, "ids"); value != "" {
+		pathIdsValues = strings.Split(value, ",")
+	}
+
+	if len(pathIdsValues) == 0 {
+		err := fmt.Errorf("is empty")
+
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/ids", "required", err.Error()), typee: PathParseFailed}
+		if router.hooks.RequestPathParseFailed != nil {
+			router.hooks.RequestPathParseFailed(r, "GetBatchesIds", "ids", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	for i, value := range pathIdsValues {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/ids/"+strconv.Itoa(i), "type", err.Error()), typee: PathParseFailed}
+			if router.hooks.RequestPathParseFailed != nil {
+				router.hooks.RequestPathParseFailed(r, "GetBatchesIds", "ids", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Path.Ids = append(request.Path.Ids, int(parsed))
+	}
+
+	if err := request.Path.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
+		if router.hooks.RequestPathValidationFailed != nil {
+			router.hooks.RequestPathValidationFailed(r, "GetBatchesIds", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestPathParseCompleted != nil {
+		router.hooks.RequestPathParseCompleted(r, "GetBatchesIds")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetBatchesIds")
+	}
+
+	return
+}
+
+func (router *petsRouter) GetBatchesIds(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetBatchesIds(r.Context(), router.parseGetBatchesIdsRequest(r))
+
+	if err := response.statusCodeErr(); err != nil {
+		if router.hooks.ResponseStatusCodeFailed != nil {
+			router.hooks.ResponseStatusCodeFailed(w, r, "GetBatchesIds", err)
+			return
+		}
+
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetBatchesIds", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetBatchesIds")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetBatchesIds")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetBatchesIds", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetBatchesIds")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetBatchesIds", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetBatchesIds", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetBatchesIds", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetBatchesIds")
+	}
+}
+
+func (router *petsRouter) parseGetPetsRequest(r *http.Request) (request GetPetsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Header.XRequestIDSet = len(r.Header.Values("X-Request-ID")) > 0
+	headerXRequestID := r.Header.Get("X-Request-ID")
+	request.Header.XRequestID = headerXRequestID
+
+	if err := request.Header.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("header", "", err), typee: HeaderValidationFailed}
+		if router.hooks.RequestHeaderValidationFailed != nil {
+			router.hooks.RequestHeaderValidationFailed(r, "GetPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestHeaderParseCompleted != nil {
+		router.hooks.RequestHeaderParseCompleted(r, "GetPets")
+	}
+
+	request.Query.LimitSet = r.URL.Query().Has("limit")
+	queryLimit := r.URL.Query().Get("limit")
+	if queryLimit != "" {
+		parsed, err := strconv.Atoi(queryLimit)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/limit", "type", err.Error()), typee: QueryParseFailed}
+			if router.hooks.RequestQueryParseFailed != nil {
+				router.hooks.RequestQueryParseFailed(r, "GetPets", "limit", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Query.Limit = int(parsed)
+	}
+
+	request.Query.TagsSet = r.URL.Query().Has("tags")
+	queryTagsValues := r.URL.Query()["tags"]
+
+	for _, value := range queryTagsValues {
+		request.Query.Tags = append(request.Query.Tags, value)
+	}
+
+	if err := request.Query.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("query", "", err), typee: QueryValidationFailed}
+		if router.hooks.RequestQueryValidationFailed != nil {
+			router.hooks.RequestQueryValidationFailed(r, "GetPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestQueryParseCompleted != nil {
+		router.hooks.RequestQueryParseCompleted(r, "GetPets")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetPets")
+	}
+
+	return
+}
+
+func (router *petsRouter) GetPets(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetPets(r.Context(), router.parseGetPetsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetPets", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetPets")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetPets")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetPets", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetPets")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetPets", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetPets", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetPets", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetPets")
+	}
+}
+
+func (router *petsRouter) parsePostPetsRequest(r *http.Request) (request PostPetsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeApiKey]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "PostPets", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "PostPets", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "PostPets")
+	}
+
+	var (
+		body      NewPet
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostPets")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostPets")
+	}
+
+	return
+}
+
+func (router *petsRouter) PostPets(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.PostPets(r.Context(), router.parsePostPetsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostPets", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostPets")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostPets")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "PostPets", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "PostPets")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostPets", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "PostPets", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostPets", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostPets")
+	}
+}
+
+func (router *petsRouter) parseGetPetsPetIDRequest(r *http.Request) (request GetPetsPetIDRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	pathPetID := chi.URLParam(r, "petId")
+	if pathPetID != "" {
+		parsed, err := strconv.Atoi(pathPetID)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/petId", "type", err.Error()), typee: PathParseFailed}
+			if router.hooks.RequestPathParseFailed != nil {
+				router.hooks.RequestPathParseFailed(r, "GetPetsPetID", "petId", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Path.PetID = int(parsed)
+	} else {
+		err := fmt.Errorf("is empty")
+
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/petId", "required", err.Error()), typee: PathParseFailed}
+		if router.hooks.RequestPathParseFailed != nil {
+			router.hooks.RequestPathParseFailed(r, "GetPetsPetID", "petId", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if err := request.Path.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
+		if router.hooks.RequestPathValidationFailed != nil {
+			router.hooks.RequestPathValidationFailed(r, "GetPetsPetID", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestPathParseCompleted != nil {
+		router.hooks.RequestPathParseCompleted(r, "GetPetsPetID")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetPetsPetID")
+	}
+
+	return
+}
+
+func (router *petsRouter) GetPetsPetID(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetPetsPetID(r.Context(), router.parseGetPetsPetIDRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetPetsPetID", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetPetsPetID")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetPetsPetID")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetPetsPetID", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetPetsPetID")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetPetsPetID", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetPetsPetID", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetPetsPetID", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetPetsPetID")
+	}
+}
+
+type response struct {
+	statusCode    int
+	statusCodeErr error
+	body          interface{}
+	bodyRaw       []byte
+	contentType   string
+	redirectURL   string
+	headers       map[string]string
+	cookies       []http.Cookie
+}
+
+type responseInterface interface {
+	statusCode() int
+	statusCodeErr() error
+	body() interface{}
+	bodyRaw() []byte
+	contentType() string
+	redirectURL() string
+	cookies() []http.Cookie
+	headers() map[string]string
+}
+
+type GetBatchesIdsResponse interface {
+	responseInterface
+	getBatchesIdsResponse()
+}
+
+type getBatchesIdsResponse struct {
+	response
+}
+
+func (getBatchesIdsResponse) getBatchesIdsResponse() {}
+
+func (response getBatchesIdsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getBatchesIdsResponse) statusCodeErr() error {
+	return response.response.statusCodeErr
+}
+
+func (response getBatchesIdsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getBatchesIdsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getBatchesIdsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getBatchesIdsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getBatchesIdsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getBatchesIdsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetPetsResponse interface {
+	responseInterface
+	getPetsResponse()
+}
+
+type getPetsResponse struct {
+	response
+}
+
+func (getPetsResponse) getPetsResponse() {}
+
+func (response getPetsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getPetsResponse) statusCodeErr() error {
+	return response.response.statusCodeErr
+}
+
+func (response getPetsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getPetsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getPetsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getPetsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getPetsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getPetsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type PostPetsResponse interface {
+	responseInterface
+	postPetsResponse()
+}
+
+type postPetsResponse struct {
+	response
+}
+
+func (postPetsResponse) postPetsResponse() {}
+
+func (response postPetsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postPetsResponse) statusCodeErr() error {
+	return response.response.statusCodeErr
+}
+
+func (response postPetsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postPetsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postPetsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postPetsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postPetsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postPetsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetPetsPetIDResponse interface {
+	responseInterface
+	getPetsPetIDResponse()
+}
+
+type getPetsPetIDResponse struct {
+	response
+}
+
+func (getPetsPetIDResponse) getPetsPetIDResponse() {}
+
+func (response getPetsPetIDResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getPetsPetIDResponse) statusCodeErr() error {
+	return response.response.statusCodeErr
+}
+
+func (response getPetsPetIDResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getPetsPetIDResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getPetsPetIDResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getPetsPetIDResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getPetsPetIDResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getPetsPetIDResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type getBatchesIdsStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetBatchesIdsResponseBuilder() *getBatchesIdsStatusCodeResponseBuilder {
+	return new(getBatchesIdsStatusCodeResponseBuilder)
+}
+
+func (builder *getBatchesIdsStatusCodeResponseBuilder) StatusCode200() *getBatchesIds200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getBatchesIds200ContentTypeBuilder{response: builder.response}
+}
+
+type getBatchesIds200ContentTypeBuilder struct {
+	response
+}
+
+type GetBatchesIds200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetBatchesIds200ApplicationJsonResponseBuilder) Build() GetBatchesIdsResponse {
+	return getBatchesIdsResponse{response: builder.response}
+}
+
+func (builder *getBatchesIds200ContentTypeBuilder) ApplicationJson() *getBatchesIds200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getBatchesIds200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getBatchesIds200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getBatchesIds200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetBatchesIds200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetBatchesIds200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getBatchesIds200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetBatchesIds200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetBatchesIds200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getBatchesIds200ApplicationJsonBodyBuilder) Body(body GetBatchesIdsApplicationjson) *GetBatchesIds200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetBatchesIds200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+// StatusCode4XX answers with code, a code that is not a 4XX status code fails the response.
+func (builder *getBatchesIdsStatusCodeResponseBuilder) StatusCode4XX(code int) *getBatchesIds4XXContentTypeBuilder {
+	if code/100 != 4 {
+		builder.response.statusCodeErr = fmt.Errorf("status code %d is not a 4XX status code", code)
+	}
+
+	builder.response.statusCode = code
+
+	return &getBatchesIds4XXContentTypeBuilder{response: builder.response}
+}
+
+type getBatchesIds4XXContentTypeBuilder struct {
+	response
+}
+
+type GetBatchesIds4XXApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetBatchesIds4XXApplicationJsonResponseBuilder) Build() GetBatchesIdsResponse {
+	return getBatchesIdsResponse{response: builder.response}
+}
+
+func (builder *getBatchesIds4XXContentTypeBuilder) ApplicationJson() *getBatchesIds4XXApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getBatchesIds4XXApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getBatchesIds4XXApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getBatchesIds4XXApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetBatchesIds4XXApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetBatchesIds4XXApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getBatchesIds4XXApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetBatchesIds4XXApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetBatchesIds4XXApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getBatchesIds4XXApplicationJsonBodyBuilder) Body(body Failure) *GetBatchesIds4XXApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetBatchesIds4XXApplicationJsonResponseBuilder{response: builder.response}
+}
+
+// StatusCode answers with code, a code that is not between 100 and 599 fails the response.
+func (builder *getBatchesIdsStatusCodeResponseBuilder) StatusCode(code int) *getBatchesIdsDefaultContentTypeBuilder {
+	if code < 100 || code > 599 {
+		builder.response.statusCodeErr = fmt.Errorf("status code %d is not between 100 and 599", code)
+	}
+
+	builder.response.statusCode = code
+
+	return &getBatchesIdsDefaultContentTypeBuilder{response: builder.response}
+}
+
+type getBatchesIdsDefaultContentTypeBuilder struct {
+	response
+}
+
+type GetBatchesIdsDefaultApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetBatchesIdsDefaultApplicationJsonResponseBuilder) Build() GetBatchesIdsResponse {
+	return getBatchesIdsResponse{response: builder.response}
+}
+
+func (builder *getBatchesIdsDefaultContentTypeBuilder) ApplicationJson() *getBatchesIdsDefaultApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getBatchesIdsDefaultApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getBatchesIdsDefaultApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getBatchesIdsDefaultApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetBatchesIdsDefaultApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetBatchesIdsDefaultApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getBatchesIdsDefaultApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetBatchesIdsDefaultApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetBatchesIdsDefaultApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getBatchesIdsDefaultApplicationJsonBodyBuilder) Body(body Failure) *GetBatchesIdsDefaultApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetBatchesIdsDefaultApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type getPetsStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetPetsResponseBuilder() *getPetsStatusCodeResponseBuilder {
+	return new(getPetsStatusCodeResponseBuilder)
+}
+
+func (builder *getPetsStatusCodeResponseBuilder) StatusCode200() *getPets200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getPets200ContentTypeBuilder{response: builder.response}
+}
+
+type getPets200ContentTypeBuilder struct {
+	response
+}
+
+type GetPets200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetPets200ApplicationJsonResponseBuilder) Build() GetPetsResponse {
+	return getPetsResponse{response: builder.response}
+}
+
+func (builder *getPets200ContentTypeBuilder) ApplicationJson() *getPets200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getPets200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getPets200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getPets200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetPets200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetPets200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPets200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetPets200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetPets200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPets200ApplicationJsonBodyBuilder) Body(body GetPetsApplicationjson) *GetPets200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetPets200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type postPetsStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostPetsResponseBuilder() *postPetsStatusCodeResponseBuilder {
+	return new(postPetsStatusCodeResponseBuilder)
+}
+
+func (builder *postPetsStatusCodeResponseBuilder) StatusCode201() *postPets201ContentTypeBuilder {
+	builder.response.statusCode = 201
+
+	return &postPets201ContentTypeBuilder{response: builder.response}
+}
+
+type postPets201ContentTypeBuilder struct {
+	response
+}
+
+type PostPets201ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *PostPets201ApplicationJsonResponseBuilder) Build() PostPetsResponse {
+	return postPetsResponse{response: builder.response}
+}
+
+func (builder *postPets201ContentTypeBuilder) ApplicationJson() *postPets201ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &postPets201ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type postPets201ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *postPets201ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *PostPets201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &PostPets201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postPets201ApplicationJsonBodyBuilder) BodyBytes(body []byte) *PostPets201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &PostPets201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postPets201ApplicationJsonBodyBuilder) Body(body Pet) *PostPets201ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &PostPets201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postPetsStatusCodeResponseBuilder) StatusCode401() *PostPets401ResponseBuilder {
+	builder.response.statusCode = 401
+
+	return &PostPets401ResponseBuilder{response: builder.response}
+}
+
+type PostPets401ResponseBuilder struct {
+	response
+}
+
+func (builder *PostPets401ResponseBuilder) Build() PostPetsResponse {
+	return postPetsResponse{response: builder.response}
+}
+
+type getPetsPetIDStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetPetsPetIDResponseBuilder() *getPetsPetIDStatusCodeResponseBuilder {
+	return new(getPetsPetIDStatusCodeResponseBuilder)
+}
+
+func (builder *getPetsPetIDStatusCodeResponseBuilder) StatusCode200() *getPetsPetID200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getPetsPetID200ContentTypeBuilder{response: builder.response}
+}
+
+type getPetsPetID200ContentTypeBuilder struct {
+	response
+}
+
+type GetPetsPetID200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetPetsPetID200ApplicationJsonResponseBuilder) Build() GetPetsPetIDResponse {
+	return getPetsPetIDResponse{response: builder.response}
+}
+
+func (builder *getPetsPetID200ContentTypeBuilder) ApplicationJson() *getPetsPetID200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getPetsPetID200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getPetsPetID200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getPetsPetID200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetPetsPetID200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetPetsPetID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetID200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetPetsPetID200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetPetsPetID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetID200ApplicationJsonBodyBuilder) Body(body Pet) *GetPetsPetID200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetPetsPetID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetIDStatusCodeResponseBuilder) StatusCode404() *getPetsPetID404ContentTypeBuilder {
+	builder.response.statusCode = 404
+
+	return &getPetsPetID404ContentTypeBuilder{response: builder.response}
+}
+
+type getPetsPetID404ContentTypeBuilder struct {
+	response
+}
+
+type GetPetsPetID404ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetPetsPetID404ApplicationJsonResponseBuilder) Build() GetPetsPetIDResponse {
+	return getPetsPetIDResponse{response: builder.response}
+}
+
+func (builder *getPetsPetID404ContentTypeBuilder) ApplicationJson() *getPetsPetID404ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getPetsPetID404ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getPetsPetID404ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getPetsPetID404ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetPetsPetID404ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetPetsPetID404ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetID404ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetPetsPetID404ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetPetsPetID404ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetID404ApplicationJsonBodyBuilder) Body(body Failure) *GetPetsPetID404ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetPetsPetID404ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type PetsService interface {
+	GetBatchesIds(context.Context, GetBatchesIdsRequest) GetBatchesIdsResponse
+	GetPets(context.Context, GetPetsRequest) GetPetsResponse
+	PostPets(context.Context, PostPetsRequest) PostPetsResponse
+	GetPetsPetID(context.Context, GetPetsPetIDRequest) GetPetsPetIDResponse
+}
+
+type GetBatchesIdsRequestPath struct {
+	Ids []int `json:"ids"`
+}
+
+func (path GetBatchesIdsRequestPath) GetIds() []int {
+	return path.Ids
+}
+
+func (path GetBatchesIdsRequestPath) Validate() error {
+	return nil
+}
+
+type GetBatchesIdsRequest struct {
+	Path             GetBatchesIdsRequestPath
+	ProcessingResult RequestProcessingResult
+}
+
+type GetPetsRequestHeader struct {
+	XRequestID    string `json:"X-Request-ID"`
+	XRequestIDSet bool   `json:"-"`
+}
+
+func (header GetPetsRequestHeader) GetXRequestID() string {
+	return header.XRequestID
+}
+
+func (header GetPetsRequestHeader) Validate() error {
+	return nil
+}
+
+type GetPetsRequestQuery struct {
+	Limit    int      `json:"limit"`
+	LimitSet bool     `json:"-"`
+	Tags     []string `json:"tags"`
+	TagsSet  bool     `json:"-"`
+}
+
+func (query GetPetsRequestQuery) GetLimit() int {
+	return query.Limit
+}
+
+func (query GetPetsRequestQuery) GetTags() []string {
+	return query.Tags
+}
+
+func (query GetPetsRequestQuery) Validate() error {
+	return nil
+}
+
+type GetPetsRequest struct {
+	Header           GetPetsRequestHeader
+	Query            GetPetsRequestQuery
+	ProcessingResult RequestProcessingResult
+}
+
+type PostPetsRequest struct {
+	Body                 NewPet
+	ProcessingResult     RequestProcessingResult
+	SecurityCheckResults map[SecurityScheme]string
+}
+
+type GetPetsPetIDRequestPath struct {
+	PetID int `json:"petId"`
+}
+
+func (path GetPetsPetIDRequestPath) GetPetID() int {
+	return path.PetID
+}
+
+func (path GetPetsPetIDRequestPath) Validate() error {
+	return nil
+}
+
+type GetPetsPetIDRequest struct {
+	Path             GetPetsPetIDRequestPath
+	ProcessingResult RequestProcessingResult
+}
+
+type SecurityScheme string
+
+const (
+	SecuritySchemeApiKey SecurityScheme = "ApiKey"
+)
+
+type securityProcessor struct {
+	scheme  SecurityScheme
+	extract func(r *http.Request) (string, string, bool)
+	handle  func(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+var securityExtractorsFuncs = map[SecurityScheme]func(r *http.Request) (string, string, bool){
+	SecuritySchemeApiKey: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("X-API-Key")
+
+		return "X-API-Key", value, value != ""
+	},
+}
+
+type SecuritySchemas interface {
+	SecuritySchemeApiKey(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+type SecurityCheckResult struct {
+	Scheme SecurityScheme
+	Value  string
+}
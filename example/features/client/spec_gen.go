@@ -0,0 +1,12 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package client
+
+import "net/http"
+
+var spec = []byte("{\"components\":{\"schemas\":{\"Failure\":{\"properties\":{\"message\":{\"type\":\"string\"}},\"required\":[\"message\"],\"type\":\"object\"},\"NewPet\":{\"properties\":{\"name\":{\"type\":\"string\"},\"tag\":{\"type\":\"string\"}},\"required\":[\"name\"],\"type\":\"object\"},\"Pet\":{\"properties\":{\"id\":{\"type\":\"integer\"},\"name\":{\"type\":\"string\"},\"tag\":{\"type\":\"string\"}},\"required\":[\"id\",\"name\"],\"type\":\"object\"}},\"securitySchemes\":{\"apiKey\":{\"in\":\"header\",\"name\":\"X-API-Key\",\"type\":\"apiKey\"}}},\"info\":{\"description\":\"A typed client sending parameters, bodies and credentials to the generated server\",\"title\":\"Client\",\"version\":\"1.0.0\"},\"openapi\":\"3.0.3\",\"paths\":{\"/batches/{ids}\":{\"get\":{\"parameters\":[{\"in\":\"path\",\"name\":\"ids\",\"required\":true,\"schema\":{\"items\":{\"type\":\"integer\"},\"type\":\"array\"}}],\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"items\":{\"$ref\":\"#/components/schemas/Pet\"},\"type\":\"array\"}}},\"description\":\"the pets of the batch\"},\"4XX\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Failure\"}}},\"description\":\"the batch is rejected\"},\"default\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Failure\"}}},\"description\":\"the batch failed\"}},\"tags\":[\"pets\"]}},\"/pets\":{\"get\":{\"parameters\":[{\"in\":\"query\",\"name\":\"limit\",\"schema\":{\"type\":\"integer\"}},{\"in\":\"query\",\"name\":\"tags\",\"schema\":{\"items\":{\"type\":\"string\"},\"type\":\"array\"}},{\"in\":\"header\",\"name\":\"X-Request-ID\",\"schema\":{\"type\":\"string\"}}],\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"items\":{\"$ref\":\"#/components/schemas/Pet\"},\"type\":\"array\"}}},\"description\":\"the pets\"}},\"tags\":[\"pets\"]},\"post\":{\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/NewPet\"}}},\"required\":true},\"responses\":{\"201\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Pet\"}}},\"description\":\"created\"},\"401\":{\"description\":\"missing credentials\"}},\"security\":[{\"apiKey\":[]}],\"tags\":[\"pets\"]}},\"/pets/{petId}\":{\"get\":{\"parameters\":[{\"in\":\"path\",\"name\":\"petId\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Pet\"}}},\"description\":\"the pet\"},\"404\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Failure\"}}},\"description\":\"not found\"}},\"tags\":[\"pets\"]}}}}")
+
+func Spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(spec)
+}
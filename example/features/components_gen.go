@@ -0,0 +1,1063 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package features
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"mime/multipart"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var tagsPattern = regexp.MustCompile("^[a-z]+$")
+
+type Animal struct {
+	value interface{}
+}
+
+func AnimalFromCat(value Cat) Animal {
+	return Animal{value: value}
+}
+
+func (union Animal) AsCat() (Cat, bool) {
+	value, ok := union.value.(Cat)
+	return value, ok
+}
+
+func AnimalFromDog(value Dog) Animal {
+	return Animal{value: value}
+}
+
+func (union Animal) AsDog() (Dog, bool) {
+	value, ok := union.value.(Dog)
+	return value, ok
+}
+
+func (union Animal) Value() interface{} {
+	return union.value
+}
+
+func (union Animal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(union.value)
+}
+
+func (union *Animal) UnmarshalJSON(data []byte) error {
+	var discriminator struct {
+		Value string `json:"petType"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return err
+	}
+
+	switch discriminator.Value {
+	case "cat":
+		var value Cat
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		union.value = value
+	case "dog":
+		var value Dog
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		union.value = value
+	default:
+		return fmt.Errorf("unknown petType discriminator value '%s'", discriminator.Value)
+	}
+
+	return nil
+}
+
+func (union Animal) Validate() error {
+	if value, ok := union.value.(validation.Validatable); ok {
+		return value.Validate()
+	}
+
+	return nil
+}
+
+type audited struct {
+	CreatedBy Optional[string] `json:"createdBy,omitzero"`
+}
+
+type Audited struct {
+	CreatedBy Optional[string] `json:"createdBy,omitzero"`
+}
+
+func (body *Audited) UnmarshalJSON(data []byte) error {
+	var value audited
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.CreatedBy = value.CreatedBy
+
+	return nil
+}
+func (body Audited) Validate() error {
+	return nil
+}
+
+type cat struct {
+	Name    *string `json:"name"`
+	PetType *string `json:"petType"`
+}
+
+type Cat struct {
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+func (body *Cat) UnmarshalJSON(data []byte) error {
+	var value cat
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	if value.PetType == nil {
+		return NewViolation("", "/petType", "required", "is required")
+	}
+
+	body.PetType = *value.PetType
+
+	return nil
+}
+func (body Cat) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Name, validation.Required, validation.RuneLength(1, 0)))
+}
+
+type circle struct {
+	Radius *int `json:"radius"`
+}
+
+type Circle struct {
+	Radius int `json:"radius"`
+}
+
+func (body *Circle) UnmarshalJSON(data []byte) error {
+	var value circle
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Radius == nil {
+		return NewViolation("", "/radius", "required", "is required")
+	}
+
+	body.Radius = *value.Radius
+
+	return nil
+}
+func (body Circle) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Radius, validation.By(Minimum(1, false))))
+}
+
+type dog struct {
+	Bark    *bool   `json:"bark"`
+	PetType *string `json:"petType"`
+}
+
+type Dog struct {
+	Bark    bool   `json:"bark"`
+	PetType string `json:"petType"`
+}
+
+func (body *Dog) UnmarshalJSON(data []byte) error {
+	var value dog
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Bark == nil {
+		return NewViolation("", "/bark", "required", "is required")
+	}
+
+	body.Bark = *value.Bark
+
+	if value.PetType == nil {
+		return NewViolation("", "/petType", "required", "is required")
+	}
+
+	body.PetType = *value.PetType
+
+	return nil
+}
+func (body Dog) Validate() error {
+	return nil
+}
+
+type employeeProperties struct {
+	Salary *int `json:"salary"`
+}
+
+type EmployeeProperties struct {
+	Salary int `json:"salary"`
+}
+
+func (body *EmployeeProperties) UnmarshalJSON(data []byte) error {
+	var value employeeProperties
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Salary == nil {
+		return NewViolation("", "/salary", "required", "is required")
+	}
+
+	body.Salary = *value.Salary
+
+	return nil
+}
+func (body EmployeeProperties) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Salary, validation.By(Minimum(1, false))))
+}
+
+type Employee struct {
+	Person
+	Audited
+	EmployeeProperties
+}
+
+func (body *Employee) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &body.Person); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, &body.Audited); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, &body.EmployeeProperties); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (body Employee) MarshalJSON() ([]byte, error) {
+	return marshalAllOf(body.Person, body.Audited, body.EmployeeProperties)
+}
+
+func (body Employee) Validate() error {
+	if err := validation.Validate(body.Person); err != nil {
+		return err
+	}
+
+	if err := validation.Validate(body.Audited); err != nil {
+		return err
+	}
+
+	if err := validation.Validate(body.EmployeeProperties); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type event struct {
+	ID   *int             `json:"id"`
+	Text Optional[string] `json:"text,omitzero"`
+}
+
+type Event struct {
+	ID   int              `json:"id"`
+	Text Optional[string] `json:"text,omitzero"`
+}
+
+func (body *Event) UnmarshalJSON(data []byte) error {
+	var value event
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Text = value.Text
+
+	if value.ID == nil {
+		return NewViolation("", "/id", "required", "is required")
+	}
+
+	body.ID = *value.ID
+
+	return nil
+}
+func (body Event) Validate() error {
+	return nil
+}
+
+type newPet struct {
+	Legs   Optional[int]               `json:"legs,omitzero"`
+	Name   *string                     `json:"name"`
+	Nick   Optional[string]            `json:"nick,omitzero"`
+	Tags   Optional[[]string]          `json:"tags,omitzero"`
+	Traits Optional[map[string]string] `json:"traits,omitzero"`
+}
+
+type NewPet struct {
+	Legs   Optional[int]               `json:"legs,omitzero"`
+	Name   string                      `json:"name"`
+	Nick   Optional[string]            `json:"nick,omitzero"`
+	Tags   Optional[[]string]          `json:"tags,omitzero"`
+	Traits Optional[map[string]string] `json:"traits,omitzero"`
+}
+
+func (body *NewPet) UnmarshalJSON(data []byte) error {
+	var value newPet
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if !value.Legs.IsPresent() {
+		if err := json.Unmarshal([]byte("4"), &value.Legs); err != nil {
+			return err
+		}
+	}
+
+	body.Legs = value.Legs
+	if !value.Nick.IsPresent() {
+		if err := json.Unmarshal([]byte("\"buddy\""), &value.Nick); err != nil {
+			return err
+		}
+	}
+
+	body.Nick = value.Nick
+	body.Tags = value.Tags
+	body.Traits = value.Traits
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	return nil
+}
+func (body NewPet) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Tags, validation.Length(0, 3).ErrorObject(validation.NewError("validation_max_items", "must contain no more than 3 items")), validation.By(UniqueItems), validation.By(func(value interface{}) error {
+			items, isNil := validation.Indirect(value)
+			if isNil {
+				return nil
+			}
+
+			return validation.Validate(items, validation.Each(validation.Match(tagsPattern)))
+		})),
+		validation.Field(&body.Traits, validation.Length(0, 3).ErrorObject(validation.NewError("validation_max_properties", "must contain no more than 3 properties")), validation.By(func(value interface{}) error {
+			items, isNil := validation.Indirect(value)
+			if isNil {
+				return nil
+			}
+
+			return validation.Validate(items, validation.Each(validation.Required, validation.RuneLength(1, 0), validation.Match(tagsPattern)))
+		})),
+		validation.Field(&body.Name, validation.Required, validation.RuneLength(1, 0), validation.RuneLength(0, 20)))
+}
+
+type person struct {
+	Name *string `json:"name"`
+}
+
+type Person struct {
+	Name string `json:"name"`
+}
+
+func (body *Person) UnmarshalJSON(data []byte) error {
+	var value person
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	return nil
+}
+func (body Person) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Name, validation.Required, validation.RuneLength(1, 0)))
+}
+
+type pet struct {
+	ID   *int             `json:"id"`
+	Legs Optional[int]    `json:"legs,omitzero"`
+	Name *string          `json:"name"`
+	Nick Optional[string] `json:"nick,omitzero"`
+}
+
+type Pet struct {
+	ID   int              `json:"id"`
+	Legs Optional[int]    `json:"legs,omitzero"`
+	Name string           `json:"name"`
+	Nick Optional[string] `json:"nick,omitzero"`
+}
+
+func (body *Pet) UnmarshalJSON(data []byte) error {
+	var value pet
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Legs = value.Legs
+	body.Nick = value.Nick
+
+	if value.ID == nil {
+		return NewViolation("", "/id", "required", "is required")
+	}
+
+	body.ID = *value.ID
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	return nil
+}
+func (body Pet) Validate() error {
+	return nil
+}
+
+type petFilter struct {
+	Kind   Optional[Kind] `json:"kind,omitzero"`
+	MinAge Optional[int]  `json:"minAge,omitzero"`
+	Q      *string        `json:"q"`
+}
+
+type PetFilter struct {
+	Kind   Optional[Kind] `json:"kind,omitzero"`
+	MinAge Optional[int]  `json:"minAge,omitzero"`
+	Q      string         `json:"q"`
+}
+
+func (body *PetFilter) UnmarshalJSON(data []byte) error {
+	var value petFilter
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Kind = value.Kind
+	if !value.MinAge.IsPresent() {
+		if err := json.Unmarshal([]byte("1"), &value.MinAge); err != nil {
+			return err
+		}
+	}
+
+	body.MinAge = value.MinAge
+
+	if value.Q == nil {
+		return NewViolation("", "/q", "required", "is required")
+	}
+
+	body.Q = *value.Q
+
+	return nil
+}
+func (body PetFilter) Validate() error {
+	return nil
+}
+
+type petPatch struct {
+	Name  Optional[string] `json:"name,omitzero"`
+	Owner Optional[string] `json:"owner,omitzero"`
+}
+
+type PetPatch struct {
+	Name  Optional[string] `json:"name,omitzero"`
+	Owner Optional[string] `json:"owner,omitzero"`
+}
+
+func (body *PetPatch) UnmarshalJSON(data []byte) error {
+	var value petPatch
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Name = value.Name
+	body.Owner = value.Owner
+
+	return nil
+}
+func (body PetPatch) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Name, validation.RuneLength(1, 0), validation.RuneLength(0, 20)))
+}
+
+type Shape struct {
+	value interface{}
+}
+
+func ShapeFromCircle(value Circle) Shape {
+	return Shape{value: value}
+}
+
+func (union Shape) AsCircle() (Circle, bool) {
+	value, ok := union.value.(Circle)
+	return value, ok
+}
+
+func ShapeFromSquare(value Square) Shape {
+	return Shape{value: value}
+}
+
+func (union Shape) AsSquare() (Square, bool) {
+	value, ok := union.value.(Square)
+	return value, ok
+}
+
+func (union Shape) Value() interface{} {
+	return union.value
+}
+
+func (union Shape) MarshalJSON() ([]byte, error) {
+	return json.Marshal(union.value)
+}
+
+func (union *Shape) UnmarshalJSON(data []byte) error {
+	if value, err := unmarshalUnionVariant[Circle](data); err == nil {
+		union.value = value
+		return nil
+	}
+
+	if value, err := unmarshalUnionVariant[Square](data); err == nil {
+		union.value = value
+		return nil
+	}
+
+	return errors.New("data does not match any Shape variant")
+}
+
+func (union Shape) Validate() error {
+	if value, ok := union.value.(validation.Validatable); ok {
+		return value.Validate()
+	}
+
+	return nil
+}
+
+type square struct {
+	Side *int `json:"side"`
+}
+
+type Square struct {
+	Side int `json:"side"`
+}
+
+func (body *Square) UnmarshalJSON(data []byte) error {
+	var value square
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Side == nil {
+		return NewViolation("", "/side", "required", "is required")
+	}
+
+	body.Side = *value.Side
+
+	return nil
+}
+func (body Square) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Side, validation.By(Minimum(1, false))))
+}
+
+type postFormsRequestBody struct {
+	Age   Optional[int]   `json:"age,omitzero"`
+	Codes Optional[[]int] `json:"codes,omitzero"`
+	Kind  Optional[Kind]  `json:"kind,omitzero"`
+	Name  *string         `json:"name"`
+	Size  Optional[int]   `json:"size,omitzero"`
+}
+
+type PostFormsRequestBody struct {
+	Age   Optional[int]   `json:"age,omitzero"`
+	Codes Optional[[]int] `json:"codes,omitzero"`
+	Kind  Optional[Kind]  `json:"kind,omitzero"`
+	Name  string          `json:"name"`
+	Size  Optional[int]   `json:"size,omitzero"`
+}
+
+func (body *PostFormsRequestBody) UnmarshalJSON(data []byte) error {
+	var value postFormsRequestBody
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Age = value.Age
+	body.Codes = value.Codes
+	body.Kind = value.Kind
+	if !value.Size.IsPresent() {
+		if err := json.Unmarshal([]byte("3"), &value.Size); err != nil {
+			return err
+		}
+	}
+
+	body.Size = value.Size
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	return nil
+}
+func (body PostFormsRequestBody) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Age, validation.Max(30)),
+		validation.Field(&body.Name, validation.Required, validation.RuneLength(2, 0)))
+}
+
+type postPhotosRequestBody struct {
+	Photo **multipart.FileHeader `json:"photo"`
+	Title Optional[string]       `json:"title,omitzero"`
+}
+
+type PostPhotosRequestBody struct {
+	Photo *multipart.FileHeader `json:"photo"`
+	Title Optional[string]      `json:"title,omitzero"`
+}
+
+func (body *PostPhotosRequestBody) UnmarshalJSON(data []byte) error {
+	var value postPhotosRequestBody
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	body.Title = value.Title
+
+	if value.Photo == nil {
+		return NewViolation("", "/photo", "required", "is required")
+	}
+
+	body.Photo = *value.Photo
+
+	return nil
+}
+func (body PostPhotosRequestBody) Validate() error {
+	return nil
+}
+
+type GetPetsApplicationjson = []Pet
+
+type GetDownloadsNameApplicationoctetStream = []byte
+
+type Kind string
+
+var KindCat Kind = "cat"
+var KindDog Kind = "dog"
+
+func (enum Kind) Check() error {
+	switch enum {
+	case KindCat, KindDog:
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid Kind enum value")
+}
+
+func (enum *Kind) UnmarshalJSON(data []byte) error {
+	var strValue string
+	if err := json.Unmarshal(data, &strValue); err != nil {
+
+		return err
+	}
+	enumValue := Kind(strValue)
+	if err := enumValue.Check(); err != nil {
+
+		return NewViolation("", "", "enum", err.Error())
+	}
+	*enum = enumValue
+
+	return nil
+}
+
+func unmarshalUnionVariant[T any](data []byte) (T, error) {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return value, err
+	}
+
+	if validatable, ok := any(value).(validation.Validatable); ok {
+		return value, validatable.Validate()
+	}
+
+	return value, nil
+}
+func marshalAllOf(parts ...interface{}) ([]byte, error) {
+	merged := map[string]json.RawMessage{}
+	for _, part := range parts {
+		data, err := json.Marshal(part)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) == 0 || data[0] != byte(0x7b) {
+			continue
+		}
+
+		if err := json.Unmarshal(data, &merged); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(merged)
+}
+
+// Optional is a property that is either absent, null or set to a value. The zero Optional is absent,
+// absent properties are omitted when marshalling with the omitzero tag option.
+type Optional[T any] struct {
+	value   T
+	present bool
+	null    bool
+}
+
+func OptionalOf[T any](value T) Optional[T] {
+	return Optional[T]{value: value, present: true}
+}
+
+func OptionalNull[T any]() Optional[T] {
+	return Optional[T]{present: true, null: true}
+}
+
+func (optional Optional[T]) IsPresent() bool {
+	return optional.present
+}
+
+func (optional Optional[T]) IsNull() bool {
+	return optional.null
+}
+
+func (optional Optional[T]) IsZero() bool {
+	return !optional.present
+}
+
+func (optional Optional[T]) Get() (T, bool) {
+	return optional.value, optional.present && !optional.null
+}
+
+func (optional Optional[T]) OrElse(value T) T {
+	if v, ok := optional.Get(); ok {
+		return v
+	}
+
+	return value
+}
+
+func (optional Optional[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := optional.Get(); ok {
+		return json.Marshal(v)
+	}
+
+	return []byte("null"), nil
+}
+
+func (optional *Optional[T]) UnmarshalJSON(data []byte) error {
+	*optional = Optional[T]{present: true}
+	if string(data) == "null" {
+		optional.null = true
+		return nil
+	}
+
+	return json.Unmarshal(data, &optional.value)
+}
+
+// Value lets validation rules see the value of a set Optional and skip absent and null ones. The value is
+// returned as is and not converted to a driver type, so Optional can not be stored with database/sql.
+func (optional Optional[T]) Value() (driver.Value, error) {
+	if v, ok := optional.Get(); ok {
+		return v, nil
+	}
+
+	return nil, nil
+}
+
+func (optional Optional[T]) Validate() error {
+	if v, ok := optional.Get(); ok {
+		return validation.Validate(v)
+	}
+
+	return nil
+}
+
+// UniqueItems is a validation rule failing on arrays with equal items, items are compared by their JSON.
+func UniqueItems(value interface{}) error {
+	value, _ = validation.Indirect(value)
+	items := reflect.ValueOf(value)
+	if items.Kind() != reflect.Slice {
+		return nil
+	}
+
+	seen := map[string]struct{}{}
+	for i := 0; i < items.Len(); i++ {
+		data, err := json.Marshal(items.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+
+		if _, ok := seen[string(data)]; ok {
+			return validation.NewError("validation_unique_items", "must not contain duplicate items")
+		}
+
+		seen[string(data)] = struct{}{}
+	}
+
+	return nil
+}
+
+// Minimum returns a validation rule checking that a number is no less than threshold, or greater when exclusive, unlike ozzo's Min it checks
+// zero values.
+func Minimum(threshold float64, exclusive bool) validation.RuleFunc {
+	return func(value interface{}) error {
+		value, isNil := validation.Indirect(value)
+		if isNil {
+			return nil
+		}
+
+		number, err := numberOf(value)
+		if err != nil {
+			return err
+		}
+
+		params := map[string]interface{}{"threshold": threshold}
+		switch {
+		case exclusive && number <= threshold:
+			return validation.ErrMinGreaterThanRequired.SetParams(params)
+		case number < threshold:
+			return validation.ErrMinGreaterEqualThanRequired.SetParams(params)
+		}
+
+		return nil
+	}
+}
+
+// numberOf converts an integer or a floating point value for the numeric rules.
+func numberOf(value interface{}) (float64, error) {
+	number, err := validation.ToFloat(value)
+	if err == nil {
+		return number, nil
+	}
+
+	integer, intErr := validation.ToInt(value)
+	if intErr != nil {
+		return 0, err
+	}
+
+	return float64(integer), nil
+}
+
+// Violation is a failed constraint of a request. Pointer is the JSON pointer of the failed value in its location,
+// the body, query, header, path or cookie, and Keyword is the failed JSON Schema keyword.
+type Violation struct {
+	Pointer  string `json:"pointer"`
+	Location string `json:"location"`
+	Keyword  string `json:"keyword,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ValidationError lists the violations found while parsing and validating a request.
+type ValidationError struct {
+	Violations []Violation `json:"violations"`
+}
+
+var violationKeywords = map[string]string{
+	"validation_in_invalid":                      "enum",
+	"validation_is_dns_name":                     "format",
+	"validation_is_email":                        "format",
+	"validation_is_ipv4":                         "format",
+	"validation_is_request_url":                  "format",
+	"validation_length_too_long":                 "maxLength",
+	"validation_length_too_short":                "minLength",
+	"validation_match_invalid":                   "pattern",
+	"validation_max_items":                       "maxItems",
+	"validation_max_less_equal_than_required":    "maximum",
+	"validation_max_less_than_required":          "exclusiveMaximum",
+	"validation_max_properties":                  "maxProperties",
+	"validation_min_greater_equal_than_required": "minimum",
+	"validation_min_greater_than_required":       "exclusiveMinimum",
+	"validation_min_items":                       "minItems",
+	"validation_min_properties":                  "minProperties",
+	"validation_multiple_of_invalid":             "multipleOf",
+	"validation_nil_or_not_empty_required":       "required",
+	"validation_not_nil_required":                "required",
+	"validation_required":                        "required",
+	"validation_unique_items":                    "uniqueItems",
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func NewViolation(location, pointer, keyword, message string) ValidationError {
+	return ValidationError{Violations: []Violation{Violation{
+		Keyword:  keyword,
+		Location: location,
+		Message:  message,
+		Pointer:  pointer,
+	}}}
+}
+
+func (err ValidationError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		if violation.Pointer == "" {
+			messages = append(messages, violation.Message)
+			continue
+		}
+
+		messages = append(messages, violation.Pointer+": "+violation.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// ValidationErrorOf converts an error of a parser or a validator found in location into a ValidationError,
+// the violations of ozzo errors, encoding/json type errors and nested ValidationErrors are prefixed with pointer.
+// A nil err has no violations.
+func ValidationErrorOf(location, pointer string, err error) ValidationError {
+	if err == nil {
+		return ValidationError{}
+	}
+
+	var (
+		result     ValidationError
+		violations ValidationError
+		errs       validation.Errors
+		ruleErr    validation.Error
+		typeErr    *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &violations):
+		for _, violation := range violations.Violations {
+			violation.Pointer = pointer + violation.Pointer
+			if violation.Location == "" {
+				violation.Location = location
+			}
+			result.Violations = append(result.Violations, violation)
+		}
+	case errors.As(err, &errs):
+		keys := make([]string, 0, len(errs))
+		for key := range errs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			result.Violations = append(result.Violations, ValidationErrorOf(location, pointer+"/"+pointerEscaper.Replace(key), errs[key]).Violations...)
+		}
+	case errors.As(err, &ruleErr):
+		keyword, ok := violationKeywords[ruleErr.Code()]
+		if !ok {
+			keyword = ruleErr.Code()
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  keyword,
+			Location: location,
+			Message:  ruleErr.Error(),
+			Pointer:  pointer,
+		})
+	case errors.As(err, &typeErr):
+		if typeErr.Field != "" {
+			pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "type",
+			Location: location,
+			Message:  fmt.Sprintf("cannot unmarshal %s into %s", typeErr.Value, typeErr.Type),
+			Pointer:  pointer,
+		})
+	default:
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "",
+			Location: location,
+			Message:  err.Error(),
+			Pointer:  pointer,
+		})
+	}
+
+	return result
+}
+
+// unmarshalViolations locates the properties of data failing to unmarshal into the fields of the unmarshal helper
+// target, so the violations of nested components point at them. It is called once unmarshalling has failed.
+func unmarshalViolations(data []byte, target interface{}, err error) error {
+	var properties map[string]json.RawMessage
+	if json.Unmarshal(data, &properties) != nil {
+		return ValidationErrorOf("", "", err)
+	}
+
+	var result ValidationError
+	fields := reflect.TypeOf(target).Elem()
+	for i := 0; i < fields.NumField(); i++ {
+		name, _, _ := strings.Cut(fields.Field(i).Tag.Get("json"), ",")
+		if property, ok := properties[name]; ok {
+			result.Violations = append(result.Violations, propertyViolations("/"+pointerEscaper.Replace(name), property, fields.Field(i).Type)...)
+		}
+	}
+
+	if len(result.Violations) == 0 {
+		return ValidationErrorOf("", "", err)
+	}
+
+	return result
+}
+
+// propertyViolations unmarshals a property on its own, the items of failing arrays and maps are unmarshalled one by one.
+func propertyViolations(pointer string, data []byte, typ reflect.Type) []Violation {
+	err := json.Unmarshal(data, reflect.New(typ).Interface())
+	if err == nil {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var violations []Violation
+	switch typ.Kind() {
+	case reflect.Slice:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for i, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+strconv.Itoa(i), item, typ.Elem())...)
+			}
+		}
+	case reflect.Map:
+		var items map[string]json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for key, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+pointerEscaper.Replace(key), item, typ.Elem())...)
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return ValidationErrorOf("", pointer, err).Violations
+	}
+
+	return violations
+}
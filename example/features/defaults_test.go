@@ -0,0 +1,71 @@
+package features
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestBodyDefaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		jsonInput  string
+		expectNick string
+		expectLegs int
+	}{
+		{
+			name:       "Missing properties get their defaults",
+			jsonInput:  `{"name": "Rex"}`,
+			expectNick: "buddy",
+			expectLegs: 4,
+		},
+		{
+			name:       "Sent properties keep their values",
+			jsonInput:  `{"name": "Rex", "nick": "rexy", "legs": 3}`,
+			expectNick: "rexy",
+			expectLegs: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pet NewPet
+			if err := json.Unmarshal([]byte(tt.jsonInput), &pet); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if nick, _ := pet.Nick.Get(); nick != tt.expectNick {
+				t.Errorf("Expected nick %q, got %q", tt.expectNick, nick)
+			}
+
+			if legs, _ := pet.Legs.Get(); legs != tt.expectLegs {
+				t.Errorf("Expected %d legs, got %d", tt.expectLegs, legs)
+			}
+		})
+	}
+}
+
+func TestParameterDefaults(t *testing.T) {
+	request := listPets(t, "/pets?where[q]=tom", nil)
+
+	if err := request.ProcessingResult.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if request.Query.Limit != 20 {
+		t.Errorf("Expected the default limit 20, got %d", request.Query.Limit)
+	}
+
+	if !reflect.DeepEqual(request.Query.Tags, []string{"friendly", "small"}) {
+		t.Errorf("Expected the default tags, got %v", request.Query.Tags)
+	}
+
+	if request.Query.Where.MinAge.OrElse(0) != 1 {
+		t.Errorf("Expected the default minAge of the sent deepObject, got %+v", request.Query.Where.MinAge)
+	}
+
+	request = listPets(t, "/pets?limit=5&tags=big", nil)
+	if request.Query.Limit != 5 || !reflect.DeepEqual(request.Query.Tags, []string{"big"}) {
+		t.Errorf("Expected the sent values to win over the defaults, got %+v", request.Query)
+	}
+}
@@ -0,0 +1,74 @@
+package features
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestContentTypeDispatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expectCode  int
+		expectVia   string
+	}{
+		{
+			name:        "JSON body",
+			contentType: "application/json",
+			body:        `{"name": "Rex", "tags": ["big"]}`,
+			expectCode:  http.StatusCreated,
+			expectVia:   "application/json",
+		},
+		{
+			name:        "JSON body with parameters",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"name": "Rex", "tags": ["big"]}`,
+			expectCode:  http.StatusCreated,
+			expectVia:   "application/json",
+		},
+		{
+			name:        "Form body",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "name=Rex&tags=big",
+			expectCode:  http.StatusCreated,
+			expectVia:   "application/x-www-form-urlencoded",
+		},
+		{
+			name:        "Unsupported body",
+			contentType: "text/plain",
+			body:        "Rex",
+			expectCode:  http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &petsService{}
+			req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+			PetsHandler(service, chi.NewRouter(), nil).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectCode || service.via != tt.expectVia {
+				t.Fatalf("Expected %d through %q, got %d through %q", tt.expectCode, tt.expectVia, rec.Code, service.via)
+			}
+
+			if tt.expectVia == "" {
+				return
+			}
+
+			if err := service.result.Err(); err != nil {
+				t.Fatalf("Unexpected processing error: %v", err)
+			}
+
+			if tags, _ := service.created.Tags.Get(); service.created.Name != "Rex" || len(tags) != 1 || tags[0] != "big" {
+				t.Errorf("Expected the same pet from either media type, got %+v", service.created)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+// Package echorouter tests the routes generated with -router echo served by echo.
+package echorouter
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package echorouter -path . -router echo
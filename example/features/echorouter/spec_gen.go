@@ -0,0 +1,12 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package echorouter
+
+import "net/http"
+
+var spec = []byte("{\"components\":{\"schemas\":{\"Event\":{\"properties\":{\"id\":{\"type\":\"integer\"}},\"required\":[\"id\"],\"type\":\"object\"},\"Pet\":{\"properties\":{\"id\":{\"type\":\"integer\"}},\"required\":[\"id\"],\"type\":\"object\"}}},\"info\":{\"description\":\"A path parameter and an event stream served by the echo router backend\",\"title\":\"Routers\",\"version\":\"1.0.0\"},\"openapi\":\"3.0.3\",\"paths\":{\"/events\":{\"get\":{\"responses\":{\"200\":{\"content\":{\"text/event-stream\":{\"schema\":{\"$ref\":\"#/components/schemas/Event\"}}},\"description\":\"a stream of events\"}},\"tags\":[\"events\"]}},\"/pets/{petId}\":{\"get\":{\"parameters\":[{\"in\":\"path\",\"name\":\"petId\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Pet\"}}},\"description\":\"the pet\"}},\"tags\":[\"pets\"]}}}}")
+
+func Spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(spec)
+}
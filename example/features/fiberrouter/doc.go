@@ -0,0 +1,4 @@
+// Package fiberrouter tests the routes generated with -router fiber served by fiber.
+package fiberrouter
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package fiberrouter -path . -router fiber
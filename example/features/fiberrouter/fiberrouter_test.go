@@ -0,0 +1,85 @@
+package fiberrouter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type petsService struct {
+	result RequestProcessingResult
+}
+
+func (s *petsService) GetPetsPetID(ctx context.Context, request GetPetsPetIDRequest) GetPetsPetIDResponse {
+	s.result = request.ProcessingResult
+
+	return GetPetsPetIDResponseBuilder().StatusCode200().ApplicationJson().Body(Pet{ID: request.Path.PetID}).Build()
+}
+
+type eventsService struct{}
+
+func (s *eventsService) GetEvents(ctx context.Context, request GetEventsRequest) GetEventsResponse {
+	events := make(chan Event, 2)
+	events <- Event{ID: 1}
+	events <- Event{ID: 2}
+	close(events)
+
+	return GetEventsResponseBuilder().StatusCode200().TextEventStream().Events(events).Build()
+}
+
+func TestPathParameter(t *testing.T) {
+	service := &petsService{}
+	server := httptest.NewServer(PetsHandler(service, fiber.New(), nil))
+	t.Cleanup(server.Close)
+
+	response, err := http.Get(server.URL + "/pets/7")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer response.Body.Close()
+
+	var pet Pet
+	if err := json.NewDecoder(response.Body).Decode(&pet); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode != http.StatusOK || pet.ID != 7 {
+		t.Errorf("Expected the pet 7, got %d %+v: %v", response.StatusCode, pet, service.result.Err())
+	}
+
+	response, err = http.Get(server.URL + "/pets/seven")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	response.Body.Close()
+
+	if service.result.Type() != PathParseFailed {
+		t.Errorf("Expected the path parameter to fail parsing, got %d: %v", service.result.Type(), service.result.Err())
+	}
+}
+
+// fiber's net/http adaptor buffers the response, the events reach the client at once when the stream ends.
+func TestEventStreamBuffered(t *testing.T) {
+	server := httptest.NewServer(EventsHandler(&eventsService{}, fiber.New(), nil))
+	t.Cleanup(server.Close)
+
+	response, err := http.Get(server.URL + "/events")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.Header.Get("Content-Type") != "text/event-stream" || string(body) != "data: {\"id\":1}\n\ndata: {\"id\":2}\n\n" {
+		t.Errorf("Expected the events, got %v %q", response.Header, body)
+	}
+}
@@ -0,0 +1,164 @@
+package features
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type formsService struct {
+	form   PostFormsRequestBody
+	photo  PostPhotosRequestBody
+	result RequestProcessingResult
+}
+
+func (s *formsService) PostForms(ctx context.Context, request PostFormsRequest) PostFormsResponse {
+	s.form, s.result = request.Body, request.ProcessingResult
+
+	return PostFormsResponseBuilder().StatusCode204().Build()
+}
+
+func (s *formsService) PostPhotos(ctx context.Context, request PostPhotosRequest) PostPhotosResponse {
+	s.photo, s.result = request.Body, request.ProcessingResult
+
+	return PostPhotosResponseBuilder().StatusCode204().Build()
+}
+
+func postForm(t *testing.T, form url.Values) *formsService {
+	t.Helper()
+
+	service := &formsService{}
+	req := httptest.NewRequest(http.MethodPost, "/forms", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	FormsHandler(service, chi.NewRouter(), nil).ServeHTTP(httptest.NewRecorder(), req)
+
+	return service
+}
+
+func TestURLEncodedForms(t *testing.T) {
+	service := postForm(t, url.Values{"name": {"Rex"}, "age": {"3"}, "codes": {"1,2"}, "kind": {"dog"}})
+
+	if err := service.result.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	form := service.form
+	if form.Name != "Rex" || form.Age.OrElse(0) != 3 || form.Kind.OrElse("") != KindDog {
+		t.Errorf("Expected the scalar properties of the form, got %+v", form)
+	}
+
+	if codes, _ := form.Codes.Get(); !reflect.DeepEqual(codes, []int{1, 2}) {
+		t.Errorf("Expected comma separated codes [1 2], got %v", codes)
+	}
+
+	if form.Size.OrElse(0) != 3 {
+		t.Errorf("Expected the default size 3, got %+v", form.Size)
+	}
+}
+
+func TestURLEncodedFormFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		form          url.Values
+		expectType    requestProcessingResultType
+		expectPointer string
+	}{
+		{
+			name:          "Missing required property",
+			form:          url.Values{"age": {"3"}},
+			expectType:    BodyUnmarshalFailed,
+			expectPointer: "/name",
+		},
+		{
+			name:          "Property of the wrong type",
+			form:          url.Values{"name": {"Rex"}, "age": {"three"}},
+			expectType:    BodyUnmarshalFailed,
+			expectPointer: "/age",
+		},
+		{
+			name:          "Property out of its enum",
+			form:          url.Values{"name": {"Rex"}, "kind": {"cow"}},
+			expectType:    BodyUnmarshalFailed,
+			expectPointer: "/kind",
+		},
+		{
+			name:          "Property failing validation",
+			form:          url.Values{"name": {"R"}},
+			expectType:    BodyValidationFailed,
+			expectPointer: "/name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := postForm(t, tt.form).result
+
+			if result.Type() != tt.expectType {
+				t.Fatalf("Expected result type %d, got %d: %v", tt.expectType, result.Type(), result.Err())
+			}
+
+			violations, ok := result.ValidationError()
+			if !ok || len(violations.Violations) != 1 || violations.Violations[0].Pointer != tt.expectPointer {
+				t.Errorf("Expected a single violation at %s, got %+v", tt.expectPointer, violations)
+			}
+		})
+	}
+}
+
+func TestMultipartForms(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("title", "Rex at the beach")
+	part, _ := writer.CreateFormFile("photo", "rex.png")
+	part.Write([]byte("png bytes"))
+	writer.Close()
+
+	service := &formsService{}
+	req := httptest.NewRequest(http.MethodPost, "/photos", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	FormsHandler(service, chi.NewRouter(), nil).ServeHTTP(httptest.NewRecorder(), req)
+
+	if err := service.result.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if service.photo.Title.OrElse("") != "Rex at the beach" || service.photo.Photo == nil || service.photo.Photo.Filename != "rex.png" {
+		t.Fatalf("Expected the title and the photo, got %+v", service.photo)
+	}
+
+	file, err := service.photo.Photo.Open()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	if content, _ := io.ReadAll(file); string(content) != "png bytes" {
+		t.Errorf("Expected the uploaded content, got %q", content)
+	}
+}
+
+func TestMultipartFormWithoutRequiredFile(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("title", "no photo")
+	writer.Close()
+
+	service := &formsService{}
+	req := httptest.NewRequest(http.MethodPost, "/photos", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	FormsHandler(service, chi.NewRouter(), nil).ServeHTTP(httptest.NewRecorder(), req)
+
+	violations, ok := service.result.ValidationError()
+	if !ok || len(violations.Violations) != 1 || violations.Violations[0].Pointer != "/photo" {
+		t.Errorf("Expected a single violation at /photo, got %+v: %v", violations, service.result.Err())
+	}
+}
@@ -0,0 +1,4 @@
+// Package ginrouter tests the routes generated with -router gin served by gin.
+package ginrouter
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package ginrouter -path . -router gin
@@ -0,0 +1,130 @@
+package ginrouter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type petsService struct {
+	result RequestProcessingResult
+}
+
+func (s *petsService) GetPetsPetID(ctx context.Context, request GetPetsPetIDRequest) GetPetsPetIDResponse {
+	s.result = request.ProcessingResult
+
+	return GetPetsPetIDResponseBuilder().StatusCode200().ApplicationJson().Body(Pet{ID: request.Path.PetID}).Build()
+}
+
+type eventsService struct {
+	release chan struct{}
+}
+
+// GetEvents holds the second event back until the first one reached the client.
+func (s *eventsService) GetEvents(ctx context.Context, request GetEventsRequest) GetEventsResponse {
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+
+		events <- Event{ID: 1}
+		select {
+		case <-s.release:
+		case <-time.After(5 * time.Second):
+		}
+		events <- Event{ID: 2}
+	}()
+
+	return GetEventsResponseBuilder().StatusCode200().TextEventStream().Events(events).Build()
+}
+
+func TestPathParameter(t *testing.T) {
+	service := &petsService{}
+	server := httptest.NewServer(PetsHandler(service, gin.New(), nil))
+	t.Cleanup(server.Close)
+
+	response, err := http.Get(server.URL + "/pets/7")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer response.Body.Close()
+
+	var pet Pet
+	if err := json.NewDecoder(response.Body).Decode(&pet); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.StatusCode != http.StatusOK || pet.ID != 7 {
+		t.Errorf("Expected the pet 7, got %d %+v: %v", response.StatusCode, pet, service.result.Err())
+	}
+
+	response, err = http.Get(server.URL + "/pets/seven")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	response.Body.Close()
+
+	if service.result.Type() != PathParseFailed {
+		t.Errorf("Expected the path parameter to fail parsing, got %d: %v", service.result.Type(), service.result.Err())
+	}
+}
+
+func TestEventStreamFlush(t *testing.T) {
+	service := &eventsService{release: make(chan struct{})}
+	server := httptest.NewServer(EventsHandler(service, gin.New(), nil))
+	t.Cleanup(server.Close)
+
+	// the request is sent in the background as well, a buffered stream does not even answer with its headers
+	type result struct {
+		response *http.Response
+		reader   *bufio.Reader
+		line     string
+		err      error
+	}
+	first := make(chan result, 1)
+	go func() {
+		response, err := http.Get(server.URL + "/events")
+		if err != nil {
+			first <- result{err: err}
+			return
+		}
+
+		reader := bufio.NewReader(response.Body)
+		line, err := reader.ReadString('\n')
+		first <- result{response: response, reader: reader, line: line, err: err}
+	}()
+
+	var reader *bufio.Reader
+	select {
+	case first := <-first:
+		if first.err != nil {
+			t.Fatalf("Unexpected error: %v", first.err)
+		}
+		defer first.response.Body.Close()
+
+		if first.line != "data: {\"id\":1}\n" {
+			t.Errorf("Expected the first event, got %q", first.line)
+		}
+		reader = first.reader
+	case <-time.After(2 * time.Second):
+		close(service.release)
+		t.Fatal("Expected the first event to be flushed before the stream ends")
+	}
+
+	close(service.release)
+
+	rest, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(rest) != "\ndata: {\"id\":2}\n\n" {
+		t.Errorf("Expected the second event, got %q", rest)
+	}
+}
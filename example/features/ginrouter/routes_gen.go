@@ -0,0 +1,740 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package ginrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	gin "github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+type Hooks struct {
+	RequestSecurityParseFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestSecurityParseCompleted func(*http.Request, string)
+	RequestSecurityCheckFailed    func(*http.Request, string, string, RequestProcessingResult)
+	RequestSecurityCheckCompleted func(*http.Request, string, string)
+	RequestBodyUnmarshalFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
+	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestBodyUnmarshalCompleted func(*http.Request, string)
+	RequestHeaderParseCompleted   func(*http.Request, string)
+	RequestPathParseCompleted     func(*http.Request, string)
+	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
+	RequestParseCompleted         func(*http.Request, string)
+	RequestProcessingCompleted    func(*http.Request, string)
+	RequestRedirectStarted        func(*http.Request, string, string)
+	ResponseBodyMarshalCompleted  func(*http.Request, string)
+	ResponseBodyWriteCompleted    func(*http.Request, string, int)
+	ResponseBodyMarshalFailed     func(http.ResponseWriter, *http.Request, string, error)
+	ResponseBodyWriteFailed       func(*http.Request, string, int, error)
+	ServiceCompleted              func(*http.Request, string)
+}
+
+type requestProcessingResultType uint8
+
+const (
+	BodyUnmarshalFailed requestProcessingResultType = iota + 1
+	BodyValidationFailed
+	HeaderParseFailed
+	HeaderValidationFailed
+	QueryParseFailed
+	QueryValidationFailed
+	PathParseFailed
+	PathValidationFailed
+	SecurityParseFailed
+	SecurityCheckFailed
+	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
+)
+
+type RequestProcessingResult struct {
+	error error
+	typee requestProcessingResultType
+}
+
+func NewRequestProcessingResult(t requestProcessingResultType, err error) RequestProcessingResult {
+	return RequestProcessingResult{
+		error: err,
+		typee: t,
+	}
+}
+
+func (r RequestProcessingResult) Type() requestProcessingResultType {
+	return r.typee
+}
+
+func (r RequestProcessingResult) Err() error {
+	return r.error
+}
+
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
+func EventsHandler(impl EventsService, r *gin.Engine, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &eventsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type eventsRouter struct {
+	router  *gin.Engine
+	service EventsService
+	hooks   *Hooks
+}
+
+func (router *eventsRouter) mount() {
+	router.router.GET("/events", ginHandler(router.GetEvents))
+}
+
+func (router *eventsRouter) parseGetEventsRequest(r *http.Request) (request GetEventsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetEvents")
+	}
+
+	return
+}
+
+func (router *eventsRouter) GetEvents(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetEvents(r.Context(), router.parseGetEventsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetEvents", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetEvents")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetEvents")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if next := response.events(); next != nil {
+		controller := http.NewResponseController(w)
+		_ = controller.Flush()
+
+		for {
+			event, ok := next(r.Context())
+			if !ok {
+				break
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				if router.hooks.ResponseBodyMarshalFailed != nil {
+					router.hooks.ResponseBodyMarshalFailed(w, r, "GetEvents", err)
+				}
+				break
+			}
+
+			count, err := writeEvent(w, data)
+			if err != nil {
+				if router.hooks.ResponseBodyWriteFailed != nil {
+					router.hooks.ResponseBodyWriteFailed(r, "GetEvents", count, err)
+				}
+				break
+			}
+
+			_ = controller.Flush()
+		}
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetEvents")
+		}
+
+		return
+	}
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetEvents", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetEvents")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetEvents", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetEvents", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetEvents", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetEvents")
+	}
+}
+
+func PetsHandler(impl PetsService, r *gin.Engine, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &petsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type petsRouter struct {
+	router  *gin.Engine
+	service PetsService
+	hooks   *Hooks
+}
+
+func (router *petsRouter) mount() {
+	router.router.GET("/pets/:petId", ginHandler(router.GetPetsPetID))
+}
+
+func (router *petsRouter) parseGetPetsPetIDRequest(r *http.Request) (request GetPetsPetIDRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	pathPetID := r.PathValue("petId")
+	if pathPetID != "" {
+		parsed, err := strconv.Atoi(pathPetID)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/petId", "type", err.Error()), typee: PathParseFailed}
+			if router.hooks.RequestPathParseFailed != nil {
+				router.hooks.RequestPathParseFailed(r, "GetPetsPetID", "petId", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Path.PetID = int(parsed)
+	} else {
+		err := fmt.Errorf("is empty")
+
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/petId", "required", err.Error()), typee: PathParseFailed}
+		if router.hooks.RequestPathParseFailed != nil {
+			router.hooks.RequestPathParseFailed(r, "GetPetsPetID", "petId", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if err := request.Path.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
+		if router.hooks.RequestPathValidationFailed != nil {
+			router.hooks.RequestPathValidationFailed(r, "GetPetsPetID", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestPathParseCompleted != nil {
+		router.hooks.RequestPathParseCompleted(r, "GetPetsPetID")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetPetsPetID")
+	}
+
+	return
+}
+
+func (router *petsRouter) GetPetsPetID(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetPetsPetID(r.Context(), router.parseGetPetsPetIDRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetPetsPetID", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetPetsPetID")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetPetsPetID")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetPetsPetID", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetPetsPetID")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetPetsPetID", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetPetsPetID", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetPetsPetID", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetPetsPetID")
+	}
+}
+
+func ginHandler(handler http.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, param := range c.Params {
+			c.Request.SetPathValue(param.Key, param.Value)
+		}
+
+		handler(c.Writer, c.Request)
+	}
+}
+
+// writeEvent writes data as a Server-Sent Event, every line of data is a data field.
+func writeEvent(w io.Writer, data []byte) (int, error) {
+	var event bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		event.WriteString("data: ")
+		event.Write(line)
+		event.WriteByte('\n')
+	}
+	event.WriteByte('\n')
+
+	return w.Write(event.Bytes())
+}
+
+type response struct {
+	statusCode  int
+	body        interface{}
+	bodyRaw     []byte
+	events      func(context.Context) (any, bool)
+	contentType string
+	redirectURL string
+	headers     map[string]string
+	cookies     []http.Cookie
+}
+
+type responseInterface interface {
+	statusCode() int
+	body() interface{}
+	bodyRaw() []byte
+	events() func(context.Context) (any, bool)
+	contentType() string
+	redirectURL() string
+	cookies() []http.Cookie
+	headers() map[string]string
+}
+
+type GetEventsResponse interface {
+	responseInterface
+	getEventsResponse()
+}
+
+type getEventsResponse struct {
+	response
+}
+
+func (getEventsResponse) getEventsResponse() {}
+
+func (response getEventsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getEventsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getEventsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getEventsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getEventsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getEventsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getEventsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getEventsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetPetsPetIDResponse interface {
+	responseInterface
+	getPetsPetIDResponse()
+}
+
+type getPetsPetIDResponse struct {
+	response
+}
+
+func (getPetsPetIDResponse) getPetsPetIDResponse() {}
+
+func (response getPetsPetIDResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getPetsPetIDResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getPetsPetIDResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getPetsPetIDResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getPetsPetIDResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getPetsPetIDResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getPetsPetIDResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getPetsPetIDResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type getEventsStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetEventsResponseBuilder() *getEventsStatusCodeResponseBuilder {
+	return new(getEventsStatusCodeResponseBuilder)
+}
+
+func (builder *getEventsStatusCodeResponseBuilder) StatusCode200() *getEvents200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getEvents200ContentTypeBuilder{response: builder.response}
+}
+
+type getEvents200ContentTypeBuilder struct {
+	response
+}
+
+type GetEvents200TextEventStreamResponseBuilder struct {
+	response
+}
+
+func (builder *GetEvents200TextEventStreamResponseBuilder) Build() GetEventsResponse {
+	return getEventsResponse{response: builder.response}
+}
+
+func (builder *getEvents200ContentTypeBuilder) TextEventStream() *getEvents200TextEventStreamBodyBuilder {
+	builder.response.contentType = "text/event-stream"
+
+	return &getEvents200TextEventStreamBodyBuilder{response: builder.response}
+}
+
+type getEvents200TextEventStreamBodyBuilder struct {
+	response
+}
+
+func (builder *getEvents200TextEventStreamBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+func (builder *getEvents200TextEventStreamBodyBuilder) BodyBytes(body []byte) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+func (builder *getEvents200TextEventStreamBodyBuilder) Body(body Event) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.body = body
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+// Events sends every event received from events until it is closed or the request is canceled.
+func (builder *getEvents200TextEventStreamBodyBuilder) Events(events <-chan Event) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.events = func(ctx context.Context) (any, bool) {
+		select {
+		case event, ok := <-events:
+			return event, ok
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Cache-Control"] = "no-cache"
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+type getPetsPetIDStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetPetsPetIDResponseBuilder() *getPetsPetIDStatusCodeResponseBuilder {
+	return new(getPetsPetIDStatusCodeResponseBuilder)
+}
+
+func (builder *getPetsPetIDStatusCodeResponseBuilder) StatusCode200() *getPetsPetID200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getPetsPetID200ContentTypeBuilder{response: builder.response}
+}
+
+type getPetsPetID200ContentTypeBuilder struct {
+	response
+}
+
+type GetPetsPetID200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetPetsPetID200ApplicationJsonResponseBuilder) Build() GetPetsPetIDResponse {
+	return getPetsPetIDResponse{response: builder.response}
+}
+
+func (builder *getPetsPetID200ContentTypeBuilder) ApplicationJson() *getPetsPetID200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getPetsPetID200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getPetsPetID200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getPetsPetID200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetPetsPetID200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetPetsPetID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetID200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetPetsPetID200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetPetsPetID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPetsPetID200ApplicationJsonBodyBuilder) Body(body Pet) *GetPetsPetID200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetPetsPetID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type EventsService interface {
+	GetEvents(context.Context, GetEventsRequest) GetEventsResponse
+}
+
+type PetsService interface {
+	GetPetsPetID(context.Context, GetPetsPetIDRequest) GetPetsPetIDResponse
+}
+
+type GetEventsRequest struct {
+	ProcessingResult RequestProcessingResult
+}
+
+type GetPetsPetIDRequestPath struct {
+	PetID int `json:"petId"`
+}
+
+func (path GetPetsPetIDRequestPath) GetPetID() int {
+	return path.PetID
+}
+
+func (path GetPetsPetIDRequestPath) Validate() error {
+	return nil
+}
+
+type GetPetsPetIDRequest struct {
+	Path             GetPetsPetIDRequestPath
+	ProcessingResult RequestProcessingResult
+}
+
+type SecurityScheme string
+
+const ()
+
+type securityProcessor struct {
+	scheme  SecurityScheme
+	extract func(r *http.Request) (string, string, bool)
+	handle  func(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+var securityExtractorsFuncs = map[SecurityScheme]func(r *http.Request) (string, string, bool){}
+
+type SecuritySchemas interface{}
+
+type SecurityCheckResult struct {
+	Scheme SecurityScheme
+	Value  string
+}
@@ -0,0 +1,97 @@
+package features
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type reportsService struct {
+	negotiated string
+}
+
+func (s *reportsService) GetReportsID(ctx context.Context, request GetReportsIDRequest) GetReportsIDResponse {
+	s.negotiated = request.NegotiatedContentType
+
+	return GetReportsIDResponseBuilder().StatusCode200().Negotiated(request.Accept).Body(Event{ID: request.Path.ID}).Build()
+}
+
+func TestContentNegotiation(t *testing.T) {
+	tests := []struct {
+		name              string
+		accept            string
+		expectCode        int
+		expectContentType string
+		expectBody        string
+	}{
+		{
+			name:              "No Accept header takes the first media type",
+			expectCode:        http.StatusOK,
+			expectContentType: "application/json",
+			expectBody:        `{"id":7}`,
+		},
+		{
+			name:              "Exact media type",
+			accept:            "application/xml",
+			expectCode:        http.StatusOK,
+			expectContentType: "application/xml",
+			expectBody:        "<Event><ID>7</ID>",
+		},
+		{
+			name:              "Highest quality wins",
+			accept:            "application/json;q=0.5, application/xml",
+			expectCode:        http.StatusOK,
+			expectContentType: "application/xml",
+			expectBody:        "<Event><ID>7</ID>",
+		},
+		{
+			name:              "Wildcard",
+			accept:            "*/*",
+			expectCode:        http.StatusOK,
+			expectContentType: "application/json",
+			expectBody:        `{"id":7}`,
+		},
+		{
+			name:       "Nothing acceptable",
+			accept:     "text/html",
+			expectCode: http.StatusNotAcceptable,
+		},
+		{
+			name:       "Every declared media type refused",
+			accept:     "application/json;q=0, application/xml;q=0",
+			expectCode: http.StatusNotAcceptable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &reportsService{}
+			req := httptest.NewRequest(http.MethodGet, "/reports/7", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			ReportsHandler(service, chi.NewRouter(), nil).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectCode {
+				t.Fatalf("Expected %d, got %d %s", tt.expectCode, rec.Code, rec.Body.String())
+			}
+
+			if tt.expectCode != http.StatusOK {
+				return
+			}
+
+			if rec.Header().Get("Content-Type") != tt.expectContentType || service.negotiated != tt.expectContentType {
+				t.Errorf("Expected %s, got %s negotiated as %s", tt.expectContentType, rec.Header().Get("Content-Type"), service.negotiated)
+			}
+
+			if !strings.HasPrefix(strings.TrimSpace(rec.Body.String()), tt.expectBody) {
+				t.Errorf("Expected a body starting with %s, got %s", tt.expectBody, rec.Body.String())
+			}
+		})
+	}
+}
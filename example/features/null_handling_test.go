@@ -0,0 +1,100 @@
+package features
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestOptionalNullHandling(t *testing.T) {
+	// the three states of a PATCH property: missing keeps, null clears and a value sets the owner
+	tests := []struct {
+		name          string
+		jsonInput     string
+		expectPresent bool
+		expectNull    bool
+		expectOwner   string
+	}{
+		{
+			name:      "Owner missing",
+			jsonInput: `{"name": "Rex"}`,
+		},
+		{
+			name:          "Owner null",
+			jsonInput:     `{"owner": null}`,
+			expectPresent: true,
+			expectNull:    true,
+		},
+		{
+			name:          "Owner empty",
+			jsonInput:     `{"owner": ""}`,
+			expectPresent: true,
+		},
+		{
+			name:          "Owner set",
+			jsonInput:     `{"owner": "Ann"}`,
+			expectPresent: true,
+			expectOwner:   "Ann",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch PetPatch
+			if err := json.Unmarshal([]byte(tt.jsonInput), &patch); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if patch.Owner.IsPresent() != tt.expectPresent || patch.Owner.IsNull() != tt.expectNull {
+				t.Errorf("Expected present %v and null %v, got %+v", tt.expectPresent, tt.expectNull, patch.Owner)
+			}
+
+			if owner, _ := patch.Owner.Get(); owner != tt.expectOwner {
+				t.Errorf("Expected owner %q, got %q", tt.expectOwner, owner)
+			}
+
+			data, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var roundTrip PetPatch
+			if err := json.Unmarshal(data, &roundTrip); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if roundTrip != patch {
+				t.Errorf("Expected %s to round trip, got %+v", data, roundTrip)
+			}
+		})
+	}
+}
+
+func TestOptionalValidation(t *testing.T) {
+	if err := (PetPatch{Name: OptionalNull[string]()}).Validate(); err != nil {
+		t.Errorf("Expected a null name to skip its rules, got: %v", err)
+	}
+
+	if err := (PetPatch{Name: OptionalOf(strings.Repeat("x", 21))}).Validate(); err == nil {
+		t.Error("Expected a long name to fail its maxLength")
+	}
+}
+
+func TestOptionalBodies(t *testing.T) {
+	service := &petsService{}
+	req := httptest.NewRequest(http.MethodPatch, "/pets/7", strings.NewReader(`{"owner": null}`))
+	req.Header.Set("Content-Type", "application/json")
+	PetsHandler(service, chi.NewRouter(), nil).ServeHTTP(httptest.NewRecorder(), req)
+
+	if err := service.result.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if !service.patch.Owner.IsNull() || service.patch.Name.IsPresent() {
+		t.Errorf("Expected a null owner and a missing name, got %+v", service.patch)
+	}
+}
@@ -0,0 +1,183 @@
+package features
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type petsService struct {
+	list    GetPetsRequest
+	created NewPet
+	patch   PetPatch
+	via     string
+	result  RequestProcessingResult
+}
+
+func (s *petsService) GetPets(ctx context.Context, request GetPetsRequest) GetPetsResponse {
+	s.list, s.result = request, request.ProcessingResult
+
+	return GetPetsResponseBuilder().StatusCode200().ApplicationJson().Body(GetPetsApplicationjson{}).Build()
+}
+
+func (s *petsService) PostPetsApplicationJson(ctx context.Context, request PostPetsApplicationJsonRequest) PostPetsResponse {
+	s.created, s.via, s.result = request.Body, "application/json", request.ProcessingResult
+
+	return s.createdResponse()
+}
+
+func (s *petsService) PostPetsApplicationXWwwFormUrlencoded(ctx context.Context, request PostPetsApplicationXWwwFormUrlencodedRequest) PostPetsResponse {
+	s.created, s.via, s.result = request.Body, "application/x-www-form-urlencoded", request.ProcessingResult
+
+	return s.createdResponse()
+}
+
+func (s *petsService) createdResponse() PostPetsResponse {
+	return PostPetsResponseBuilder().
+		StatusCode201().
+		ApplicationJson().
+		Body(Pet{ID: 1, Name: s.created.Name, Nick: s.created.Nick, Legs: s.created.Legs}).
+		Build()
+}
+
+func (s *petsService) PatchPetsPetID(ctx context.Context, request PatchPetsPetIDRequest) PatchPetsPetIDResponse {
+	s.patch, s.result = request.Body, request.ProcessingResult
+
+	return PatchPetsPetIDResponseBuilder().StatusCode204().Build()
+}
+
+func listPets(t *testing.T, target string, header http.Header) GetPetsRequest {
+	t.Helper()
+
+	service := &petsService{}
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	PetsHandler(service, chi.NewRouter(), nil).ServeHTTP(httptest.NewRecorder(), req)
+
+	return service.list
+}
+
+func TestArrayParameters(t *testing.T) {
+	request := listPets(t, "/pets?ids=1&ids=2&tags=red,small&kinds=cat|dog", http.Header{"X-Flags": {"true,false"}})
+
+	if err := request.ProcessingResult.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if !reflect.DeepEqual(request.Query.Ids, []int{1, 2}) {
+		t.Errorf("Expected exploded ids [1 2], got %v", request.Query.Ids)
+	}
+
+	if !reflect.DeepEqual(request.Query.Tags, []string{"red", "small"}) {
+		t.Errorf("Expected comma separated tags [red small], got %v", request.Query.Tags)
+	}
+
+	if !reflect.DeepEqual(request.Query.Kinds, []Kind{KindCat, KindDog}) {
+		t.Errorf("Expected pipe delimited kinds [cat dog], got %v", request.Query.Kinds)
+	}
+
+	if !reflect.DeepEqual(request.Header.XFlags, []bool{true, false}) {
+		t.Errorf("Expected header flags [true false], got %v", request.Header.XFlags)
+	}
+}
+
+func TestRepeatedHeaderArrayParameter(t *testing.T) {
+	request := listPets(t, "/pets", http.Header{"X-Flags": {"true", "false,true"}})
+
+	if err := request.ProcessingResult.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if !reflect.DeepEqual(request.Header.XFlags, []bool{true, false, true}) {
+		t.Errorf("Expected the flags of every header line [true false true], got %v", request.Header.XFlags)
+	}
+}
+
+func TestArrayParameterFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		expectType    requestProcessingResultType
+		expectPointer string
+	}{
+		{
+			name:          "Item out of the enum",
+			target:        "/pets?kinds=cat|cow",
+			expectType:    QueryParseFailed,
+			expectPointer: "/kinds/1",
+		},
+		{
+			name:          "Item of the wrong type",
+			target:        "/pets?ids=one",
+			expectType:    QueryParseFailed,
+			expectPointer: "/ids/0",
+		},
+		{
+			name:          "More items than maxItems",
+			target:        "/pets?ids=1&ids=2&ids=3&ids=4",
+			expectType:    QueryValidationFailed,
+			expectPointer: "/ids",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := listPets(t, tt.target, nil).ProcessingResult
+
+			if result.Type() != tt.expectType {
+				t.Fatalf("Expected result type %d, got %d: %v", tt.expectType, result.Type(), result.Err())
+			}
+
+			violations, ok := result.ValidationError()
+			if !ok || len(violations.Violations) == 0 || violations.Violations[0].Pointer != tt.expectPointer {
+				t.Errorf("Expected a violation at %s, got %+v", tt.expectPointer, violations)
+			}
+		})
+	}
+}
+
+func TestDeepObjectParameters(t *testing.T) {
+	request := listPets(t, "/pets?where[q]=tom&where[kind]=cat&where[minAge]=3", nil)
+
+	if err := request.ProcessingResult.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	where := request.Query.Where
+	if where.Q != "tom" || where.Kind.OrElse("") != KindCat || where.MinAge.OrElse(0) != 3 {
+		t.Errorf("Expected the where[...] properties, got %+v", where)
+	}
+
+	request = listPets(t, "/pets?where[kind]=cat", nil)
+	if request.ProcessingResult.Err() == nil {
+		t.Error("Expected a deepObject without its required property to fail")
+	}
+
+	request = listPets(t, "/pets", nil)
+	if err := request.ProcessingResult.Err(); err != nil || request.Query.Where != (PetFilter{}) {
+		t.Errorf("Expected an absent deepObject to be skipped, got %+v: %v", request.Query.Where, err)
+	}
+}
+
+func TestDeepObjectMapParameter(t *testing.T) {
+	request := listPets(t, "/pets?labels[color]=red&labels[size]=big&where[q]=tom", nil)
+
+	if err := request.ProcessingResult.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if !reflect.DeepEqual(request.Query.Labels, map[string]string{"color": "red", "size": "big"}) {
+		t.Errorf("Expected every labels[...] key, got %v", request.Query.Labels)
+	}
+
+	request = listPets(t, "/pets", nil)
+	if err := request.ProcessingResult.Err(); err != nil || request.Query.Labels != nil {
+		t.Errorf("Expected an absent deepObject map to be skipped, got %v: %v", request.Query.Labels, err)
+	}
+}
@@ -0,0 +1,4 @@
+// Package problem tests the problem details answers generated with -problem-details.
+package problem
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package problem -path . -problem-details
@@ -0,0 +1,140 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type petsService struct {
+	called bool
+}
+
+func (s *petsService) PostPets(ctx context.Context, request PostPetsRequest) PostPetsResponse {
+	s.called = true
+
+	return PostPetsResponseBuilder().StatusCode201().Build()
+}
+
+type securitySchemas struct{}
+
+func (securitySchemas) SecuritySchemeApiKey(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	if value != "secret" {
+		return errors.New("unknown api key")
+	}
+
+	return nil
+}
+
+func TestProblemDetails(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		apiKey        string
+		contentType   string
+		body          string
+		expectStatus  int
+		expectPointer string
+	}{
+		{
+			name:         "Valid request",
+			target:       "/pets?limit=5",
+			apiKey:       "secret",
+			contentType:  "application/json",
+			body:         `{"name": "Rex"}`,
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name:          "Query parameter of the wrong type",
+			target:        "/pets?limit=five",
+			apiKey:        "secret",
+			contentType:   "application/json",
+			body:          `{"name": "Rex"}`,
+			expectStatus:  http.StatusBadRequest,
+			expectPointer: "/limit",
+		},
+		{
+			name:          "Body failing validation",
+			target:        "/pets",
+			apiKey:        "secret",
+			contentType:   "application/json",
+			body:          `{"name": ""}`,
+			expectStatus:  http.StatusBadRequest,
+			expectPointer: "/name",
+		},
+		{
+			name:         "Body of another content type",
+			target:       "/pets",
+			apiKey:       "secret",
+			contentType:  "text/plain",
+			body:         `{"name": "Rex"}`,
+			expectStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "Missing credentials",
+			target:       "/pets",
+			contentType:  "application/json",
+			body:         `{"name": "Rex"}`,
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "Rejected credentials",
+			target:       "/pets",
+			apiKey:       "guess",
+			contentType:  "application/json",
+			body:         `{"name": "Rex"}`,
+			expectStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &petsService{}
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
+			recorder := httptest.NewRecorder()
+			PetsHandler(service, chi.NewRouter(), nil, nil, securitySchemas{}).ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.expectStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectStatus, recorder.Code, recorder.Body)
+			}
+
+			if tt.expectStatus == http.StatusCreated {
+				if !service.called {
+					t.Error("Expected the service to be called")
+				}
+				return
+			}
+
+			if service.called {
+				t.Error("Expected a failed request not to reach the service")
+			}
+
+			if contentType := recorder.Header().Get("Content-Type"); contentType != "application/problem+json" {
+				t.Errorf("Expected an application/problem+json answer, got %s", contentType)
+			}
+
+			var problem ProblemDetails
+			if err := json.NewDecoder(recorder.Body).Decode(&problem); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if problem.Status != tt.expectStatus || problem.Title != http.StatusText(tt.expectStatus) || problem.Instance != "/pets" {
+				t.Errorf("Expected the problem of a %d answer, got %+v", tt.expectStatus, problem)
+			}
+
+			if tt.expectPointer != "" && (len(problem.Violations) != 1 || problem.Violations[0].Pointer != tt.expectPointer) {
+				t.Errorf("Expected a single violation at %s, got %+v", tt.expectPointer, problem.Violations)
+			}
+		})
+	}
+}
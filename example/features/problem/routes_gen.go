@@ -0,0 +1,533 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	chi "github.com/go-chi/chi/v5"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Hooks struct {
+	RequestSecurityParseFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestSecurityParseCompleted func(*http.Request, string)
+	RequestSecurityCheckFailed    func(*http.Request, string, string, RequestProcessingResult)
+	RequestSecurityCheckCompleted func(*http.Request, string, string)
+	RequestBodyUnmarshalFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
+	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestBodyUnmarshalCompleted func(*http.Request, string)
+	RequestHeaderParseCompleted   func(*http.Request, string)
+	RequestPathParseCompleted     func(*http.Request, string)
+	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
+	RequestParseCompleted         func(*http.Request, string)
+	RequestProcessingCompleted    func(*http.Request, string)
+	RequestRedirectStarted        func(*http.Request, string, string)
+	ResponseBodyMarshalCompleted  func(*http.Request, string)
+	ResponseBodyWriteCompleted    func(*http.Request, string, int)
+	ResponseBodyMarshalFailed     func(http.ResponseWriter, *http.Request, string, error)
+	ResponseBodyWriteFailed       func(*http.Request, string, int, error)
+	ServiceCompleted              func(*http.Request, string)
+}
+
+type requestProcessingResultType uint8
+
+const (
+	BodyUnmarshalFailed requestProcessingResultType = iota + 1
+	BodyValidationFailed
+	HeaderParseFailed
+	HeaderValidationFailed
+	QueryParseFailed
+	QueryValidationFailed
+	PathParseFailed
+	PathValidationFailed
+	SecurityParseFailed
+	SecurityCheckFailed
+	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
+)
+
+type RequestProcessingResult struct {
+	error error
+	typee requestProcessingResultType
+}
+
+func NewRequestProcessingResult(t requestProcessingResultType, err error) RequestProcessingResult {
+	return RequestProcessingResult{
+		error: err,
+		typee: t,
+	}
+}
+
+func (r RequestProcessingResult) Type() requestProcessingResultType {
+	return r.typee
+}
+
+func (r RequestProcessingResult) Err() error {
+	return r.error
+}
+
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
+func PetsHandler(impl PetsService, r chi.Router, hooks *Hooks, problems ProblemResponder, securitySchemas SecuritySchemas) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	if problems == nil {
+		problems = DefaultProblemResponder{}
+	}
+
+	router := &petsRouter{router: r, service: impl, hooks: hooks, problems: problems}
+
+	router.securityHandlers = map[SecurityScheme]securityProcessor{
+		SecuritySchemeApiKey: {
+			scheme:  SecuritySchemeApiKey,
+			extract: securityExtractorsFuncs[SecuritySchemeApiKey],
+			handle:  securitySchemas.SecuritySchemeApiKey,
+		},
+	}
+
+	router.mount()
+
+	return router.router
+}
+
+type petsRouter struct {
+	router           chi.Router
+	service          PetsService
+	hooks            *Hooks
+	problems         ProblemResponder
+	securityHandlers map[SecurityScheme]securityProcessor
+}
+
+func (router *petsRouter) mount() {
+	router.router.Post("/pets", router.PostPets)
+}
+
+func (router *petsRouter) parsePostPetsRequest(r *http.Request) (request PostPetsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	isSecurityCheckPassed := false
+	var securityCheckErr error
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeApiKey]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "PostPets", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				securityCheckErr = err
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "PostPets", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+		// credentials were given but rejected
+		if securityCheckErr != nil {
+			request.ProcessingResult = RequestProcessingResult{error: fmt.Errorf("failed passing security checks: %w", securityCheckErr), typee: SecurityCheckFailed}
+		}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "PostPets")
+	}
+
+	if !isContentTypeSupported(r, "application/json") {
+		request.ProcessingResult = RequestProcessingResult{error: fmt.Errorf("content type %q is not supported", r.Header.Get("Content-Type")), typee: BodyContentTypeUnsupported}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	queryLimit := r.URL.Query().Get("limit")
+	if queryLimit != "" {
+		parsed, err := strconv.Atoi(queryLimit)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/limit", "type", err.Error()), typee: QueryParseFailed}
+			if router.hooks.RequestQueryParseFailed != nil {
+				router.hooks.RequestQueryParseFailed(r, "PostPets", "limit", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Query.Limit = int(parsed)
+	}
+
+	if err := request.Query.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("query", "", err), typee: QueryValidationFailed}
+		if router.hooks.RequestQueryValidationFailed != nil {
+			router.hooks.RequestQueryValidationFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestQueryParseCompleted != nil {
+		router.hooks.RequestQueryParseCompleted(r, "PostPets")
+	}
+
+	var (
+		body      NewPet
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostPets")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostPets")
+	}
+
+	return
+}
+
+func (router *petsRouter) PostPets(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	request := router.parsePostPetsRequest(r)
+	if request.ProcessingResult.typee != ParseSucceed {
+		router.problems.RespondProblem(w, r, "PostPets", request.ProcessingResult)
+		return
+	}
+
+	response := router.service.PostPets(r.Context(), request)
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostPets", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostPets")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostPets")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostPets")
+	}
+}
+
+// isContentTypeSupported reports whether the body of r is of contentType, a request without a Content-Type is accepted.
+func isContentTypeSupported(r *http.Request, contentType string) bool {
+	header := r.Header.Get("Content-Type")
+	if header == "" || contentType == "*/*" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return false
+	}
+
+	if prefix, ok := strings.CutSuffix(contentType, "/*"); ok {
+		return strings.HasPrefix(mediaType, prefix+"/")
+	}
+
+	return strings.EqualFold(mediaType, contentType)
+}
+
+// ProblemDetails is an RFC 7807 problem details body, Violations lists the violations of failed parsing and validation.
+type ProblemDetails struct {
+	Type       string      `json:"type"`
+	Title      string      `json:"title"`
+	Status     int         `json:"status"`
+	Detail     string      `json:"detail,omitempty"`
+	Instance   string      `json:"instance,omitempty"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// ProblemResponder answers requests failing parsing, validation or security checks, the service is not called for them.
+type ProblemResponder interface {
+	RespondProblem(w http.ResponseWriter, r *http.Request, operation string, result RequestProcessingResult)
+}
+
+// DefaultProblemResponder writes the ProblemDetails of the result as application/problem+json.
+type DefaultProblemResponder struct{}
+
+func (DefaultProblemResponder) RespondProblem(w http.ResponseWriter, r *http.Request, _ string, result RequestProcessingResult) {
+	problem := result.ProblemDetails(r)
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+// NewProblemDetails describes a request to r answered with status, the detail is the message of err if any.
+func NewProblemDetails(r *http.Request, status int, err error) ProblemDetails {
+	problem := ProblemDetails{
+		Instance: r.URL.Path,
+		Status:   status,
+		Title:    http.StatusText(status),
+		Type:     "about:blank",
+	}
+
+	if err != nil {
+		problem.Detail = err.Error()
+	}
+
+	return problem
+}
+
+// ProblemDetails describes the failed processing result of request, with the violations of a ValidationError.
+func (r RequestProcessingResult) ProblemDetails(request *http.Request) ProblemDetails {
+	problem := NewProblemDetails(request, r.Status(), r.Err())
+	if validationErr, ok := r.ValidationError(); ok {
+		problem.Violations = validationErr.Violations
+	}
+
+	return problem
+}
+
+// Status is the HTTP status a failed processing result is answered with: 401 for missing credentials, 403 for
+// rejected ones, 415 for an unsupported content type and 400 for parse and validation failures.
+func (r RequestProcessingResult) Status() int {
+	switch r.typee {
+	case ParseSucceed:
+		return http.StatusOK
+	case SecurityParseFailed:
+		return http.StatusUnauthorized
+	case SecurityCheckFailed:
+		return http.StatusForbidden
+	case BodyContentTypeUnsupported:
+		return http.StatusUnsupportedMediaType
+	}
+
+	return http.StatusBadRequest
+}
+
+type response struct {
+	statusCode  int
+	body        interface{}
+	bodyRaw     []byte
+	contentType string
+	redirectURL string
+	headers     map[string]string
+	cookies     []http.Cookie
+}
+
+type responseInterface interface {
+	statusCode() int
+	body() interface{}
+	bodyRaw() []byte
+	contentType() string
+	redirectURL() string
+	cookies() []http.Cookie
+	headers() map[string]string
+}
+
+type PostPetsResponse interface {
+	responseInterface
+	postPetsResponse()
+}
+
+type postPetsResponse struct {
+	response
+}
+
+func (postPetsResponse) postPetsResponse() {}
+
+func (response postPetsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postPetsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postPetsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postPetsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postPetsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postPetsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postPetsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type postPetsStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostPetsResponseBuilder() *postPetsStatusCodeResponseBuilder {
+	return new(postPetsStatusCodeResponseBuilder)
+}
+
+func (builder *postPetsStatusCodeResponseBuilder) StatusCode201() *PostPets201ResponseBuilder {
+	builder.response.statusCode = 201
+
+	return &PostPets201ResponseBuilder{response: builder.response}
+}
+
+type PostPets201ResponseBuilder struct {
+	response
+}
+
+func (builder *PostPets201ResponseBuilder) Build() PostPetsResponse {
+	return postPetsResponse{response: builder.response}
+}
+
+type PetsService interface {
+	PostPets(context.Context, PostPetsRequest) PostPetsResponse
+}
+
+type PostPetsRequestQuery struct {
+	Limit int `json:"limit"`
+}
+
+func (query PostPetsRequestQuery) GetLimit() int {
+	return query.Limit
+}
+
+func (query PostPetsRequestQuery) Validate() error {
+	return nil
+}
+
+type PostPetsRequest struct {
+	Body                 NewPet
+	Query                PostPetsRequestQuery
+	ProcessingResult     RequestProcessingResult
+	SecurityCheckResults map[SecurityScheme]string
+}
+
+type SecurityScheme string
+
+const (
+	SecuritySchemeApiKey SecurityScheme = "ApiKey"
+)
+
+type securityProcessor struct {
+	scheme  SecurityScheme
+	extract func(r *http.Request) (string, string, bool)
+	handle  func(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+var securityExtractorsFuncs = map[SecurityScheme]func(r *http.Request) (string, string, bool){
+	SecuritySchemeApiKey: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("X-API-Key")
+
+		return "X-API-Key", value, value != ""
+	},
+}
+
+type SecuritySchemas interface {
+	SecuritySchemeApiKey(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+type SecurityCheckResult struct {
+	Scheme SecurityScheme
+	Value  string
+}
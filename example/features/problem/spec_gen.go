@@ -0,0 +1,12 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package problem
+
+import "net/http"
+
+var spec = []byte("{\"components\":{\"schemas\":{\"NewPet\":{\"properties\":{\"name\":{\"minLength\":1,\"type\":\"string\"}},\"required\":[\"name\"],\"type\":\"object\"}},\"securitySchemes\":{\"ApiKey\":{\"in\":\"header\",\"name\":\"X-API-Key\",\"type\":\"apiKey\"}}},\"info\":{\"description\":\"Failed requests answered with application/problem+json before the service is called\",\"title\":\"Problem Details\",\"version\":\"1.0.0\"},\"openapi\":\"3.0.3\",\"paths\":{\"/pets\":{\"post\":{\"parameters\":[{\"in\":\"query\",\"name\":\"limit\",\"schema\":{\"type\":\"integer\"}}],\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/NewPet\"}}},\"required\":true},\"responses\":{\"201\":{\"description\":\"created\"}},\"security\":[{\"ApiKey\":[]}],\"tags\":[\"pets\"]}}}}")
+
+func Spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(spec)
+}
@@ -0,0 +1,5070 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package features
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	chi "github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Hooks struct {
+	RequestSecurityParseFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestSecurityParseCompleted func(*http.Request, string)
+	RequestSecurityCheckFailed    func(*http.Request, string, string, RequestProcessingResult)
+	RequestSecurityCheckCompleted func(*http.Request, string, string)
+	RequestBodyUnmarshalFailed    func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
+	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
+	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
+	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
+	RequestBodyUnmarshalCompleted func(*http.Request, string)
+	RequestHeaderParseCompleted   func(*http.Request, string)
+	RequestPathParseCompleted     func(*http.Request, string)
+	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
+	RequestNegotiationFailed      func(*http.Request, string, RequestProcessingResult)
+	RequestParseCompleted         func(*http.Request, string)
+	RequestProcessingCompleted    func(*http.Request, string)
+	RequestRedirectStarted        func(*http.Request, string, string)
+	ResponseBodyMarshalCompleted  func(*http.Request, string)
+	ResponseBodyWriteCompleted    func(*http.Request, string, int)
+	ResponseBodyMarshalFailed     func(http.ResponseWriter, *http.Request, string, error)
+	ResponseBodyWriteFailed       func(*http.Request, string, int, error)
+	ServiceCompleted              func(*http.Request, string)
+}
+
+type requestProcessingResultType uint8
+
+const (
+	BodyUnmarshalFailed requestProcessingResultType = iota + 1
+	BodyValidationFailed
+	HeaderParseFailed
+	HeaderValidationFailed
+	QueryParseFailed
+	QueryValidationFailed
+	PathParseFailed
+	PathValidationFailed
+	SecurityParseFailed
+	SecurityCheckFailed
+	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
+	NegotiationFailed
+)
+
+type RequestProcessingResult struct {
+	error error
+	typee requestProcessingResultType
+}
+
+func NewRequestProcessingResult(t requestProcessingResultType, err error) RequestProcessingResult {
+	return RequestProcessingResult{
+		error: err,
+		typee: t,
+	}
+}
+
+func (r RequestProcessingResult) Type() requestProcessingResultType {
+	return r.typee
+}
+
+func (r RequestProcessingResult) Err() error {
+	return r.error
+}
+
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
+func AnimalsHandler(impl AnimalsService, r chi.Router, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &animalsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type animalsRouter struct {
+	router  chi.Router
+	service AnimalsService
+	hooks   *Hooks
+}
+
+func (router *animalsRouter) mount() {
+	router.router.Post("/animals", router.PostAnimals)
+	router.router.Post("/employees", router.PostEmployees)
+}
+
+func (router *animalsRouter) parsePostAnimalsRequest(r *http.Request) (request PostAnimalsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/json")
+
+	var (
+		body      Animal
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostAnimals", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostAnimals")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostAnimals", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostAnimals")
+	}
+
+	return
+}
+
+func (router *animalsRouter) PostAnimals(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/json"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "PostAnimals", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.PostAnimals(r.Context(), router.parsePostAnimalsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostAnimals", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostAnimals")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostAnimals")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "PostAnimals", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "PostAnimals")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostAnimals", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "PostAnimals", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostAnimals", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostAnimals")
+	}
+}
+
+func (router *animalsRouter) parsePostEmployeesRequest(r *http.Request) (request PostEmployeesRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/json")
+
+	var (
+		body      Employee
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostEmployees", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostEmployees")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostEmployees", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostEmployees")
+	}
+
+	return
+}
+
+func (router *animalsRouter) PostEmployees(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/json"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "PostEmployees", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.PostEmployees(r.Context(), router.parsePostEmployeesRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostEmployees", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostEmployees")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostEmployees")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "PostEmployees", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "PostEmployees")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostEmployees", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "PostEmployees", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostEmployees", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostEmployees")
+	}
+}
+
+func EventsHandler(impl EventsService, r chi.Router, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &eventsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type eventsRouter struct {
+	router  chi.Router
+	service EventsService
+	hooks   *Hooks
+}
+
+func (router *eventsRouter) mount() {
+	router.router.Get("/downloads/{name}", router.GetDownloadsName)
+	router.router.Get("/events", router.GetEvents)
+}
+
+func (router *eventsRouter) parseGetDownloadsNameRequest(r *http.Request) (request GetDownloadsNameRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/octet-stream")
+
+	pathName := chi.URLParam(r, "name")
+	if pathName == "" {
+		err := errors.New("is empty")
+
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/name", "required", err.Error()), typee: PathParseFailed}
+		if router.hooks.RequestPathParseFailed != nil {
+			router.hooks.RequestPathParseFailed(r, "GetDownloadsName", "name", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Path.Name = pathName
+
+	if err := request.Path.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
+		if router.hooks.RequestPathValidationFailed != nil {
+			router.hooks.RequestPathValidationFailed(r, "GetDownloadsName", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestPathParseCompleted != nil {
+		router.hooks.RequestPathParseCompleted(r, "GetDownloadsName")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetDownloadsName")
+	}
+
+	return
+}
+
+func (router *eventsRouter) GetDownloadsName(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/octet-stream") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/octet-stream"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "GetDownloadsName", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.GetDownloadsName(r.Context(), router.parseGetDownloadsNameRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetDownloadsName", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetDownloadsName")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetDownloadsName")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if stream := response.bodyStream(); stream != nil {
+		if closer, ok := stream.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		count, err := io.Copy(w, contextReader{ctx: r.Context(), reader: stream})
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetDownloadsName", int(count), err)
+			}
+		} else if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetDownloadsName", int(count))
+		}
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetDownloadsName")
+		}
+
+		return
+	}
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetDownloadsName", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetDownloadsName")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetDownloadsName", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetDownloadsName", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetDownloadsName", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetDownloadsName")
+	}
+}
+
+func (router *eventsRouter) parseGetEventsRequest(r *http.Request) (request GetEventsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("text/event-stream")
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetEvents")
+	}
+
+	return
+}
+
+func (router *eventsRouter) GetEvents(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("text/event-stream") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"text/event-stream"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "GetEvents", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.GetEvents(r.Context(), router.parseGetEventsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetEvents", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetEvents")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetEvents")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if next := response.events(); next != nil {
+		controller := http.NewResponseController(w)
+		_ = controller.Flush()
+
+		for {
+			event, ok := next(r.Context())
+			if !ok {
+				break
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				if router.hooks.ResponseBodyMarshalFailed != nil {
+					router.hooks.ResponseBodyMarshalFailed(w, r, "GetEvents", err)
+				}
+				break
+			}
+
+			count, err := writeEvent(w, data)
+			if err != nil {
+				if router.hooks.ResponseBodyWriteFailed != nil {
+					router.hooks.ResponseBodyWriteFailed(r, "GetEvents", count, err)
+				}
+				break
+			}
+
+			_ = controller.Flush()
+		}
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetEvents")
+		}
+
+		return
+	}
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetEvents", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetEvents")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetEvents", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetEvents", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetEvents", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetEvents")
+	}
+}
+
+func FormsHandler(impl FormsService, r chi.Router, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &formsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type formsRouter struct {
+	router  chi.Router
+	service FormsService
+	hooks   *Hooks
+}
+
+func (router *formsRouter) mount() {
+	router.router.Post("/forms", router.PostForms)
+	router.router.Post("/photos", router.PostPhotos)
+}
+
+func (router *formsRouter) parsePostFormsRequest(r *http.Request) (request PostFormsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	var (
+		body      PostFormsRequestBody
+		decodeErr error
+	)
+	decodeErr = decodePostFormsRequestBodyApplicationXWwwFormUrlencoded(r, &body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostForms", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostForms")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostForms", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostForms")
+	}
+
+	return
+}
+
+func (router *formsRouter) PostForms(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.PostForms(r.Context(), router.parsePostFormsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostForms", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostForms")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostForms")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostForms")
+	}
+}
+
+func (router *formsRouter) parsePostPhotosRequest(r *http.Request) (request PostPhotosRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	var (
+		body      PostPhotosRequestBody
+		decodeErr error
+	)
+	decodeErr = decodePostPhotosRequestBodyMultipartFormData(r, &body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPhotos", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostPhotos")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostPhotos", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostPhotos")
+	}
+
+	return
+}
+
+func (router *formsRouter) PostPhotos(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.PostPhotos(r.Context(), router.parsePostPhotosRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostPhotos", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostPhotos")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostPhotos")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostPhotos")
+	}
+}
+
+func PetsHandler(impl PetsService, r chi.Router, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &petsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type petsRouter struct {
+	router  chi.Router
+	service PetsService
+	hooks   *Hooks
+}
+
+func (router *petsRouter) mount() {
+	router.router.Get("/pets", router.GetPets)
+	router.router.Post("/pets", router.PostPets)
+	router.router.Patch("/pets/{petId}", router.PatchPetsPetID)
+}
+
+func (router *petsRouter) parseGetPetsRequest(r *http.Request) (request GetPetsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/json")
+
+	var headerXFlagsValues []string
+	for _, value := range r.Header.Values("x-flags") {
+		if value != "" {
+			headerXFlagsValues = append(headerXFlagsValues, strings.Split(value, ",")...)
+		}
+	}
+
+	for i, value := range headerXFlagsValues {
+		parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-flags/"+strconv.Itoa(i), "type", err.Error()), typee: HeaderParseFailed}
+			if router.hooks.RequestHeaderParseFailed != nil {
+				router.hooks.RequestHeaderParseFailed(r, "GetPets", "x-flags", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Header.XFlags = append(request.Header.XFlags, bool(parsed))
+	}
+
+	if err := request.Header.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("header", "", err), typee: HeaderValidationFailed}
+		if router.hooks.RequestHeaderValidationFailed != nil {
+			router.hooks.RequestHeaderValidationFailed(r, "GetPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestHeaderParseCompleted != nil {
+		router.hooks.RequestHeaderParseCompleted(r, "GetPets")
+	}
+
+	queryLimit := valueOrDefault(r.URL.Query().Get("limit"), "20")
+	if queryLimit != "" {
+		parsed, err := strconv.Atoi(queryLimit)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/limit", "type", err.Error()), typee: QueryParseFailed}
+			if router.hooks.RequestQueryParseFailed != nil {
+				router.hooks.RequestQueryParseFailed(r, "GetPets", "limit", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Query.Limit = int(parsed)
+	}
+
+	queryIdsValues := r.URL.Query()["ids"]
+
+	for i, value := range queryIdsValues {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/ids/"+strconv.Itoa(i), "type", err.Error()), typee: QueryParseFailed}
+			if router.hooks.RequestQueryParseFailed != nil {
+				router.hooks.RequestQueryParseFailed(r, "GetPets", "ids", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Query.Ids = append(request.Query.Ids, int(parsed))
+	}
+
+	var queryTagsValues []string
+	if value := valueOrDefault(r.URL.Query().Get("tags"), "friendly,small"); value != "" {
+		queryTagsValues = strings.Split(value, ",")
+	}
+
+	for _, value := range queryTagsValues {
+		request.Query.Tags = append(request.Query.Tags, value)
+	}
+
+	var queryKindsValues []string
+	if value := r.URL.Query().Get("kinds"); value != "" {
+		queryKindsValues = strings.Split(value, "|")
+	}
+
+	for i, value := range queryKindsValues {
+		parsed := Kind(value)
+		if err := parsed.Check(); err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/kinds/"+strconv.Itoa(i), "enum", err.Error()), typee: QueryParseFailed}
+			if router.hooks.RequestQueryParseFailed != nil {
+				router.hooks.RequestQueryParseFailed(r, "GetPets", "kinds", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Query.Kinds = append(request.Query.Kinds, parsed)
+	}
+
+	if r.URL.Query().Has("where[kind]") || r.URL.Query().Has("where[minAge]") || r.URL.Query().Has("where[q]") {
+		if value := r.URL.Query().Get("where[kind]"); value != "" {
+			parsed := Kind(value)
+			if err := parsed.Check(); err != nil {
+				request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/where/kind", "enum", err.Error()), typee: QueryParseFailed}
+				if router.hooks.RequestQueryParseFailed != nil {
+					router.hooks.RequestQueryParseFailed(r, "GetPets", "where[kind]", request.ProcessingResult)
+				}
+
+				return
+			}
+
+			request.Query.Where.Kind = OptionalOf(parsed)
+		}
+
+		if value := valueOrDefault(r.URL.Query().Get("where[minAge]"), "1"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/where/minAge", "type", err.Error()), typee: QueryParseFailed}
+				if router.hooks.RequestQueryParseFailed != nil {
+					router.hooks.RequestQueryParseFailed(r, "GetPets", "where[minAge]", request.ProcessingResult)
+				}
+
+				return
+			}
+
+			request.Query.Where.MinAge = OptionalOf(int(parsed))
+		}
+
+		if value := r.URL.Query().Get("where[q]"); value != "" {
+			request.Query.Where.Q = value
+		} else {
+			err := fmt.Errorf("is empty")
+
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/where/q", "required", err.Error()), typee: QueryParseFailed}
+			if router.hooks.RequestQueryParseFailed != nil {
+				router.hooks.RequestQueryParseFailed(r, "GetPets", "where[q]", request.ProcessingResult)
+			}
+
+			return
+		}
+
+	}
+
+	for key, values := range r.URL.Query() {
+		property, ok := strings.CutPrefix(key, "labels[")
+		if !ok || !strings.HasSuffix(property, "]") {
+			continue
+		}
+		property = strings.TrimSuffix(property, "]")
+
+		if request.Query.Labels == nil {
+			request.Query.Labels = make(map[string]string)
+		}
+
+		request.Query.Labels[property] = values[0]
+	}
+
+	if err := request.Query.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("query", "", err), typee: QueryValidationFailed}
+		if router.hooks.RequestQueryValidationFailed != nil {
+			router.hooks.RequestQueryValidationFailed(r, "GetPets", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestQueryParseCompleted != nil {
+		router.hooks.RequestQueryParseCompleted(r, "GetPets")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetPets")
+	}
+
+	return
+}
+
+func (router *petsRouter) GetPets(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/json"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "GetPets", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.GetPets(r.Context(), router.parseGetPetsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetPets", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetPets")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetPets")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetPets", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetPets")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetPets", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetPets", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetPets", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetPets")
+	}
+}
+
+func (router *petsRouter) PostPets(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case isContentTypeSupported(r, "application/json"):
+		router.PostPetsApplicationJson(w, r)
+	case isContentTypeSupported(r, "application/x-www-form-urlencoded"):
+		router.PostPetsApplicationXWwwFormUrlencoded(w, r)
+	default:
+		result := RequestProcessingResult{error: fmt.Errorf("content type %q is not supported", r.Header.Get("Content-Type")), typee: BodyContentTypeUnsupported}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPets", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+	}
+}
+
+func (router *petsRouter) parsePostPetsApplicationJsonRequest(r *http.Request) (request PostPetsApplicationJsonRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/json")
+
+	var (
+		body      NewPet
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPetsApplicationJson", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostPetsApplicationJson")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostPetsApplicationJson", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostPetsApplicationJson")
+	}
+
+	return
+}
+
+func (router *petsRouter) PostPetsApplicationJson(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/json"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "PostPetsApplicationJson", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.PostPetsApplicationJson(r.Context(), router.parsePostPetsApplicationJsonRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostPetsApplicationJson", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostPetsApplicationJson")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostPetsApplicationJson")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "PostPetsApplicationJson", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "PostPetsApplicationJson")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostPetsApplicationJson", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "PostPetsApplicationJson", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostPetsApplicationJson", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostPetsApplicationJson")
+	}
+}
+
+func (router *petsRouter) parsePostPetsApplicationXWwwFormUrlencodedRequest(r *http.Request) (request PostPetsApplicationXWwwFormUrlencodedRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/json")
+
+	var (
+		body      NewPet
+		decodeErr error
+	)
+	decodeErr = decodeNewPetApplicationXWwwFormUrlencoded(r, &body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PostPetsApplicationXWwwFormUrlencoded", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PostPetsApplicationXWwwFormUrlencoded")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PostPetsApplicationXWwwFormUrlencoded", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PostPetsApplicationXWwwFormUrlencoded")
+	}
+
+	return
+}
+
+func (router *petsRouter) PostPetsApplicationXWwwFormUrlencoded(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/json") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/json"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "PostPetsApplicationXWwwFormUrlencoded", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.PostPetsApplicationXWwwFormUrlencoded(r.Context(), router.parsePostPetsApplicationXWwwFormUrlencodedRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PostPetsApplicationXWwwFormUrlencoded", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostPetsApplicationXWwwFormUrlencoded")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PostPetsApplicationXWwwFormUrlencoded")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "PostPetsApplicationXWwwFormUrlencoded", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "PostPetsApplicationXWwwFormUrlencoded")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostPetsApplicationXWwwFormUrlencoded", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "PostPetsApplicationXWwwFormUrlencoded", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostPetsApplicationXWwwFormUrlencoded", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PostPetsApplicationXWwwFormUrlencoded")
+	}
+}
+
+func (router *petsRouter) parsePatchPetsPetIDRequest(r *http.Request) (request PatchPetsPetIDRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	pathPetID := chi.URLParam(r, "petId")
+	if pathPetID != "" {
+		parsed, err := strconv.Atoi(pathPetID)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/petId", "type", err.Error()), typee: PathParseFailed}
+			if router.hooks.RequestPathParseFailed != nil {
+				router.hooks.RequestPathParseFailed(r, "PatchPetsPetID", "petId", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Path.PetID = int(parsed)
+	} else {
+		err := fmt.Errorf("is empty")
+
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/petId", "required", err.Error()), typee: PathParseFailed}
+		if router.hooks.RequestPathParseFailed != nil {
+			router.hooks.RequestPathParseFailed(r, "PatchPetsPetID", "petId", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if err := request.Path.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
+		if router.hooks.RequestPathValidationFailed != nil {
+			router.hooks.RequestPathValidationFailed(r, "PatchPetsPetID", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestPathParseCompleted != nil {
+		router.hooks.RequestPathParseCompleted(r, "PatchPetsPetID")
+	}
+
+	var (
+		body      PetPatch
+		decodeErr error
+	)
+	decodeErr = json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
+		if router.hooks.RequestBodyUnmarshalFailed != nil {
+			router.hooks.RequestBodyUnmarshalFailed(r, "PatchPetsPetID", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	request.Body = body
+
+	if router.hooks.RequestBodyUnmarshalCompleted != nil {
+		router.hooks.RequestBodyUnmarshalCompleted(r, "PatchPetsPetID")
+	}
+
+	if err := request.Body.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
+		if router.hooks.RequestBodyValidationFailed != nil {
+			router.hooks.RequestBodyValidationFailed(r, "PatchPetsPetID", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "PatchPetsPetID")
+	}
+
+	return
+}
+
+func (router *petsRouter) PatchPetsPetID(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.PatchPetsPetID(r.Context(), router.parsePatchPetsPetIDRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "PatchPetsPetID", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PatchPetsPetID")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "PatchPetsPetID")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "PatchPetsPetID")
+	}
+}
+
+func ReportsHandler(impl ReportsService, r chi.Router, hooks *Hooks) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &reportsRouter{router: r, service: impl, hooks: hooks}
+
+	router.mount()
+
+	return router.router
+}
+
+type reportsRouter struct {
+	router  chi.Router
+	service ReportsService
+	hooks   *Hooks
+}
+
+func (router *reportsRouter) mount() {
+	router.router.Get("/reports/{id}", router.GetReportsID)
+}
+
+func (router *reportsRouter) parseGetReportsIDRequest(r *http.Request) (request GetReportsIDRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+	request.NegotiatedContentType = request.Accept.Negotiate("application/json", "application/xml")
+
+	pathID := chi.URLParam(r, "id")
+	if pathID != "" {
+		parsed, err := strconv.Atoi(pathID)
+		if err != nil {
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/id", "type", err.Error()), typee: PathParseFailed}
+			if router.hooks.RequestPathParseFailed != nil {
+				router.hooks.RequestPathParseFailed(r, "GetReportsID", "id", request.ProcessingResult)
+			}
+
+			return
+		}
+
+		request.Path.ID = int(parsed)
+	} else {
+		err := fmt.Errorf("is empty")
+
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/id", "required", err.Error()), typee: PathParseFailed}
+		if router.hooks.RequestPathParseFailed != nil {
+			router.hooks.RequestPathParseFailed(r, "GetReportsID", "id", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if err := request.Path.Validate(); err != nil {
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
+		if router.hooks.RequestPathValidationFailed != nil {
+			router.hooks.RequestPathValidationFailed(r, "GetReportsID", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestPathParseCompleted != nil {
+		router.hooks.RequestPathParseCompleted(r, "GetReportsID")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetReportsID")
+	}
+
+	return
+}
+
+func (router *reportsRouter) GetReportsID(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if Accept(r.Header.Get("Accept")).Negotiate("application/json", "application/xml") == "" {
+		result := RequestProcessingResult{error: fmt.Errorf("none of %q is acceptable", []string{"application/json", "application/xml"}), typee: NegotiationFailed}
+		if router.hooks.RequestNegotiationFailed != nil {
+			router.hooks.RequestNegotiationFailed(r, "GetReportsID", result)
+		}
+
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	response := router.service.GetReportsID(r.Context(), router.parseGetReportsIDRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetReportsID", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetReportsID")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetReportsID")
+	}
+
+	if len(response.contentType()) > 0 {
+		w.Header().Set("content-type", response.contentType())
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	var body []byte
+
+	if response.body() != nil {
+		var err error
+
+		switch response.contentType() {
+		case "application/xml":
+			body, err = xml.Marshal(response.body())
+		case "application/octet-stream":
+			var ok bool
+			if body, ok = (response.body()).([]byte); !ok {
+				err = errors.New("body is not []byte")
+			}
+		case "text/html":
+			body = []byte(fmt.Sprint(response.body()))
+		case "application/json":
+			fallthrough
+		default:
+			body, err = json.Marshal(response.body())
+		}
+
+		if err != nil {
+			if router.hooks.ResponseBodyMarshalFailed != nil {
+				router.hooks.ResponseBodyMarshalFailed(w, r, "GetReportsID", err)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyMarshalCompleted != nil {
+			router.hooks.ResponseBodyMarshalCompleted(r, "GetReportsID")
+		}
+	} else if len(response.bodyRaw()) > 0 {
+		body = response.bodyRaw()
+	}
+
+	if len(body) > 0 {
+		count, err := w.Write(body)
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "GetReportsID", count, err)
+			}
+
+			if router.hooks.ResponseBodyWriteCompleted != nil {
+				router.hooks.ResponseBodyWriteCompleted(r, "GetReportsID", count)
+			}
+
+			return
+		}
+
+		if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "GetReportsID", count)
+		}
+	}
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetReportsID")
+	}
+}
+
+func SecureHandler(impl SecureService, r chi.Router, hooks *Hooks, securitySchemas SecuritySchemas) http.Handler {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	router := &secureRouter{router: r, service: impl, hooks: hooks}
+
+	router.securityHandlers = map[SecurityScheme]securityProcessor{
+		SecuritySchemeBasic: {
+			scheme:  SecuritySchemeBasic,
+			extract: securityExtractorsFuncs[SecuritySchemeBasic],
+			handle:  securitySchemas.SecuritySchemeBasic,
+		},
+		SecuritySchemeBearer: {
+			scheme:  SecuritySchemeBearer,
+			extract: securityExtractorsFuncs[SecuritySchemeBearer],
+			handle:  securitySchemas.SecuritySchemeBearer,
+		},
+		SecuritySchemeSession: {
+			scheme:  SecuritySchemeSession,
+			extract: securityExtractorsFuncs[SecuritySchemeSession],
+			handle:  securitySchemas.SecuritySchemeSession,
+		},
+		SecuritySchemeApiKey: {
+			scheme:  SecuritySchemeApiKey,
+			extract: securityExtractorsFuncs[SecuritySchemeApiKey],
+			handle:  securitySchemas.SecuritySchemeApiKey,
+		},
+		SecuritySchemeMtls: {
+			scheme:  SecuritySchemeMtls,
+			extract: securityExtractorsFuncs[SecuritySchemeMtls],
+			handle: func(r *http.Request, scheme SecurityScheme, _ string, _ string) error {
+				return securitySchemas.SecuritySchemeMtls(r, scheme, r.TLS.PeerCertificates[0])
+			},
+		},
+		SecuritySchemeOauth: {
+			scheme:    SecuritySchemeOauth,
+			extract:   securityExtractorsFuncs[SecuritySchemeOauth],
+			authorize: securitySchemas.SecuritySchemeOauth,
+		},
+		SecuritySchemeOidc: {
+			scheme:    SecuritySchemeOidc,
+			extract:   securityExtractorsFuncs[SecuritySchemeOidc],
+			authorize: securitySchemas.SecuritySchemeOidc,
+		},
+		SecuritySchemeToken: {
+			scheme:  SecuritySchemeToken,
+			extract: securityExtractorsFuncs[SecuritySchemeToken],
+			handle:  securitySchemas.SecuritySchemeToken,
+		},
+	}
+
+	router.mount()
+
+	return router.router
+}
+
+type secureRouter struct {
+	router           chi.Router
+	service          SecureService
+	hooks            *Hooks
+	securityHandlers map[SecurityScheme]securityProcessor
+}
+
+func (router *secureRouter) mount() {
+	router.router.Get("/secure/basic", router.GetSecureBasic)
+	router.router.Get("/secure/bearer", router.GetSecureBearer)
+	router.router.Get("/secure/cookie", router.GetSecureCookie)
+	router.router.Get("/secure/header", router.GetSecureHeader)
+	router.router.Get("/secure/mtls", router.GetSecureMtls)
+	router.router.Get("/secure/oauth", router.GetSecureOauth)
+	router.router.Get("/secure/oidc", router.GetSecureOidc)
+	router.router.Get("/secure/query", router.GetSecureQuery)
+}
+
+func (router *secureRouter) parseGetSecureBasicRequest(r *http.Request) (request GetSecureBasicRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeBasic]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureBasic", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureBasic", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureBasic", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureBasic")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureBasic")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureBasic(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureBasic(r.Context(), router.parseGetSecureBasicRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureBasic", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureBasic")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureBasic")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureBasic")
+	}
+}
+
+func (router *secureRouter) parseGetSecureBearerRequest(r *http.Request) (request GetSecureBearerRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeBearer]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureBearer", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureBearer", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureBearer", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureBearer")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureBearer")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureBearer(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureBearer(r.Context(), router.parseGetSecureBearerRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureBearer", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureBearer")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureBearer")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureBearer")
+	}
+}
+
+func (router *secureRouter) parseGetSecureCookieRequest(r *http.Request) (request GetSecureCookieRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeSession]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureCookie", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureCookie", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureCookie", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureCookie")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureCookie")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureCookie(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureCookie(r.Context(), router.parseGetSecureCookieRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureCookie", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureCookie")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureCookie")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureCookie")
+	}
+}
+
+func (router *secureRouter) parseGetSecureHeaderRequest(r *http.Request) (request GetSecureHeaderRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeApiKey]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureHeader", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureHeader", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureHeader", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureHeader")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureHeader")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureHeader(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureHeader(r.Context(), router.parseGetSecureHeaderRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureHeader", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureHeader")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureHeader")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureHeader")
+	}
+}
+
+func (router *secureRouter) parseGetSecureMtlsRequest(r *http.Request) (request GetSecureMtlsRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeMtls]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureMtls", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureMtls", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureMtls", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureMtls")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureMtls")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureMtls(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureMtls(r.Context(), router.parseGetSecureMtlsRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureMtls", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureMtls")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureMtls")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureMtls")
+	}
+}
+
+func (router *secureRouter) parseGetSecureOauthRequest(r *http.Request) (request GetSecureOauthRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeOauth].withScopes("pets:read", "pets:write")}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			granted, err := processor.check(r, name, value)
+			if err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureOauth", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureOauth", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+
+			if processor.authorize != nil {
+				if request.SecurityScopes == nil {
+					request.SecurityScopes = map[SecurityScheme][]string{}
+				}
+
+				request.SecurityScopes[processor.scheme] = granted
+			}
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureOauth", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureOauth")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureOauth")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureOauth(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureOauth(r.Context(), router.parseGetSecureOauthRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureOauth", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureOauth")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureOauth")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureOauth")
+	}
+}
+
+func (router *secureRouter) parseGetSecureOidcRequest(r *http.Request) (request GetSecureOidcRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeOidc].withScopes("openid")}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			granted, err := processor.check(r, name, value)
+			if err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureOidc", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureOidc", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+
+			if processor.authorize != nil {
+				if request.SecurityScopes == nil {
+					request.SecurityScopes = map[SecurityScheme][]string{}
+				}
+
+				request.SecurityScopes[processor.scheme] = granted
+			}
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureOidc", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureOidc")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureOidc")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureOidc(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureOidc(r.Context(), router.parseGetSecureOidcRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureOidc", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureOidc")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureOidc")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureOidc")
+	}
+}
+
+func (router *secureRouter) parseGetSecureQueryRequest(r *http.Request) (request GetSecureQueryRequest) {
+	request.ProcessingResult = RequestProcessingResult{typee: ParseSucceed}
+
+	request.Accept = Accept(r.Header.Get("Accept"))
+
+	isSecurityCheckPassed := false
+	for _, processors := range [][]securityProcessor{{router.securityHandlers[SecuritySchemeToken]}} {
+		isLinkedChecksValid := true
+
+		for _, processor := range processors {
+			name, value, isExtracted := processor.extract(r)
+
+			if !isExtracted {
+				isLinkedChecksValid = false
+				break
+			}
+
+			if err := processor.handle(r, processor.scheme, name, value); err != nil {
+				if router.hooks.RequestSecurityCheckFailed != nil {
+					router.hooks.RequestSecurityCheckFailed(r, "GetSecureQuery", string(processor.scheme), RequestProcessingResult{error: err, typee: SecurityCheckFailed})
+				}
+
+				isLinkedChecksValid = false
+
+				break
+			}
+
+			if router.hooks.RequestSecurityCheckCompleted != nil {
+				router.hooks.RequestSecurityCheckCompleted(r, "GetSecureQuery", string(processor.scheme))
+			}
+
+			if len(request.SecurityCheckResults) == 0 {
+				request.SecurityCheckResults = map[SecurityScheme]string{}
+			}
+
+			request.SecurityCheckResults[processor.scheme] = value
+		}
+
+		if isLinkedChecksValid {
+			isSecurityCheckPassed = true
+			break
+		}
+	}
+
+	if !isSecurityCheckPassed {
+		err := fmt.Errorf("failed passing security checks")
+
+		request.ProcessingResult = RequestProcessingResult{error: err, typee: SecurityParseFailed}
+
+		if router.hooks.RequestSecurityParseFailed != nil {
+			router.hooks.RequestSecurityParseFailed(r, "GetSecureQuery", request.ProcessingResult)
+		}
+
+		return
+	}
+
+	if router.hooks.RequestSecurityParseCompleted != nil {
+		router.hooks.RequestSecurityParseCompleted(r, "GetSecureQuery")
+	}
+
+	if router.hooks.RequestParseCompleted != nil {
+		router.hooks.RequestParseCompleted(r, "GetSecureQuery")
+	}
+
+	return
+}
+
+func (router *secureRouter) GetSecureQuery(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	response := router.service.GetSecureQuery(r.Context(), router.parseGetSecureQueryRequest(r))
+
+	for header, value := range response.headers() {
+		w.Header().Set(header, value)
+	}
+
+	for _, c := range response.cookies() {
+		cookie := c
+		http.SetCookie(w, &cookie)
+	}
+
+	if slices.Contains([]int{301, 302, 303, 307, 308}, response.statusCode()) && response.redirectURL() != "" {
+		if router.hooks.RequestRedirectStarted != nil {
+			router.hooks.RequestRedirectStarted(r, "GetSecureQuery", response.redirectURL())
+		}
+
+		http.Redirect(w, r, response.redirectURL(), response.statusCode())
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "GetSecureQuery")
+		}
+
+		return
+	}
+
+	if router.hooks.RequestProcessingCompleted != nil {
+		router.hooks.RequestProcessingCompleted(r, "GetSecureQuery")
+	}
+
+	w.WriteHeader(response.statusCode())
+
+	if router.hooks.ServiceCompleted != nil {
+		router.hooks.ServiceCompleted(r, "GetSecureQuery")
+	}
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+// multipartMaxMemory is the number of bytes of a multipart body kept in memory, larger files are stored on disk.
+const multipartMaxMemory = 33554432
+
+func decodeNewPetApplicationXWwwFormUrlencoded(r *http.Request, body *NewPet) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	if value := valueOrDefault(r.PostForm.Get("legs"), "4"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return NewViolation("", "/legs", "type", err.Error())
+		}
+
+		body.Legs = OptionalOf(int(parsed))
+	}
+
+	if value := r.PostForm.Get("name"); value != "" {
+		body.Name = value
+	} else {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	if value := valueOrDefault(r.PostForm.Get("nick"), "buddy"); value != "" {
+		body.Nick = OptionalOf(value)
+	}
+
+	tagsValues := r.PostForm["tags"]
+
+	var tagsItems []string
+	for _, value := range tagsValues {
+		tagsItems = append(tagsItems, value)
+	}
+
+	if len(tagsItems) > 0 {
+		body.Tags = OptionalOf(tagsItems)
+	}
+
+	if value := r.PostForm.Get("traits"); value != "" {
+		var parsed map[string]string
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			return ValidationErrorOf("", "/traits", err)
+		}
+
+		body.Traits = OptionalOf(parsed)
+	}
+
+	return nil
+}
+
+func decodePostFormsRequestBodyApplicationXWwwFormUrlencoded(r *http.Request, body *PostFormsRequestBody) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	if value := r.PostForm.Get("age"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return NewViolation("", "/age", "type", err.Error())
+		}
+
+		body.Age = OptionalOf(int(parsed))
+	}
+
+	codesValues := r.PostForm["codes"]
+	if len(codesValues) == 1 {
+		codesValues = strings.Split(codesValues[0], ",")
+	}
+
+	var codesItems []int
+	for i, value := range codesValues {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return NewViolation("", "/codes/"+strconv.Itoa(i), "type", err.Error())
+		}
+
+		codesItems = append(codesItems, int(parsed))
+	}
+
+	if len(codesItems) > 0 {
+		body.Codes = OptionalOf(codesItems)
+	}
+
+	if value := r.PostForm.Get("kind"); value != "" {
+		parsed := Kind(value)
+		if err := parsed.Check(); err != nil {
+			return NewViolation("", "/kind", "enum", err.Error())
+		}
+
+		body.Kind = OptionalOf(parsed)
+	}
+
+	if value := r.PostForm.Get("name"); value != "" {
+		body.Name = value
+	} else {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	if value := valueOrDefault(r.PostForm.Get("size"), "3"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return NewViolation("", "/size", "type", err.Error())
+		}
+
+		body.Size = OptionalOf(int(parsed))
+	}
+
+	return nil
+}
+
+func decodePostPhotosRequestBodyMultipartFormData(r *http.Request, body *PostPhotosRequestBody) error {
+	if err := r.ParseMultipartForm(multipartMaxMemory); err != nil {
+		return err
+	}
+
+	if files := r.MultipartForm.File["photo"]; len(files) > 0 {
+		body.Photo = files[0]
+	} else {
+		return NewViolation("", "/photo", "required", "is required")
+	}
+
+	if value := r.PostForm.Get("title"); value != "" {
+		body.Title = OptionalOf(value)
+	}
+
+	return nil
+}
+
+// isContentTypeSupported reports whether the body of r is of contentType, a request without a Content-Type is accepted.
+func isContentTypeSupported(r *http.Request, contentType string) bool {
+	header := r.Header.Get("Content-Type")
+	if header == "" || contentType == "*/*" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return false
+	}
+
+	if prefix, ok := strings.CutSuffix(contentType, "/*"); ok {
+		return strings.HasPrefix(mediaType, prefix+"/")
+	}
+
+	return strings.EqualFold(mediaType, contentType)
+}
+
+// Accept is the Accept header of a request.
+type Accept string
+
+// Negotiate is the media type of contentTypes with the highest quality in accept, the quality of a media type
+// is the one of its most specific media range. Ties go to the earlier media type, a request without an Accept
+// header takes the first one and "" is returned when none is acceptable.
+func (accept Accept) Negotiate(contentTypes ...string) string {
+	if accept == "" && len(contentTypes) > 0 {
+		return contentTypes[0]
+	}
+
+	var (
+		best        string
+		bestQuality float64
+	)
+	for _, contentType := range contentTypes {
+		quality, specificity := 0.0, -1
+		for _, value := range strings.Split(string(accept), ",") {
+			mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(value))
+			if err != nil {
+				continue
+			}
+
+			rangeSpecificity := 2
+			switch {
+			case mediaRange == "*/*":
+				rangeSpecificity = 0
+			case strings.HasSuffix(mediaRange, "/*"):
+				if !strings.HasPrefix(strings.ToLower(contentType), strings.TrimSuffix(mediaRange, "*")) {
+					continue
+				}
+				rangeSpecificity = 1
+			case !strings.EqualFold(mediaRange, contentType):
+				continue
+			}
+
+			if rangeSpecificity <= specificity {
+				continue
+			}
+
+			specificity, quality = rangeSpecificity, 1.0
+			if q, ok := params["q"]; ok {
+				if quality, err = strconv.ParseFloat(q, 64); err != nil {
+					quality = 0.0
+				}
+			}
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = contentType, quality
+		}
+	}
+
+	return best
+}
+
+// contextReader stops reading a streamed body once the request is canceled.
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (reader contextReader) Read(p []byte) (int, error) {
+	if err := reader.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return reader.reader.Read(p)
+}
+
+// writeEvent writes data as a Server-Sent Event, every line of data is a data field.
+func writeEvent(w io.Writer, data []byte) (int, error) {
+	var event bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		event.WriteString("data: ")
+		event.Write(line)
+		event.WriteByte('\n')
+	}
+	event.WriteByte('\n')
+
+	return w.Write(event.Bytes())
+}
+
+type response struct {
+	statusCode  int
+	body        interface{}
+	bodyRaw     []byte
+	bodyStream  io.Reader
+	events      func(context.Context) (any, bool)
+	contentType string
+	redirectURL string
+	headers     map[string]string
+	cookies     []http.Cookie
+}
+
+type responseInterface interface {
+	statusCode() int
+	body() interface{}
+	bodyRaw() []byte
+	bodyStream() io.Reader
+	events() func(context.Context) (any, bool)
+	contentType() string
+	redirectURL() string
+	cookies() []http.Cookie
+	headers() map[string]string
+}
+
+type PostAnimalsResponse interface {
+	responseInterface
+	postAnimalsResponse()
+}
+
+type postAnimalsResponse struct {
+	response
+}
+
+func (postAnimalsResponse) postAnimalsResponse() {}
+
+func (response postAnimalsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postAnimalsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postAnimalsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postAnimalsResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response postAnimalsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response postAnimalsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postAnimalsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postAnimalsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postAnimalsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetDownloadsNameResponse interface {
+	responseInterface
+	getDownloadsNameResponse()
+}
+
+type getDownloadsNameResponse struct {
+	response
+}
+
+func (getDownloadsNameResponse) getDownloadsNameResponse() {}
+
+func (response getDownloadsNameResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getDownloadsNameResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getDownloadsNameResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getDownloadsNameResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getDownloadsNameResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getDownloadsNameResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getDownloadsNameResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getDownloadsNameResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getDownloadsNameResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type PostEmployeesResponse interface {
+	responseInterface
+	postEmployeesResponse()
+}
+
+type postEmployeesResponse struct {
+	response
+}
+
+func (postEmployeesResponse) postEmployeesResponse() {}
+
+func (response postEmployeesResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postEmployeesResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postEmployeesResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postEmployeesResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response postEmployeesResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response postEmployeesResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postEmployeesResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postEmployeesResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postEmployeesResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetEventsResponse interface {
+	responseInterface
+	getEventsResponse()
+}
+
+type getEventsResponse struct {
+	response
+}
+
+func (getEventsResponse) getEventsResponse() {}
+
+func (response getEventsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getEventsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getEventsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getEventsResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getEventsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getEventsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getEventsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getEventsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getEventsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type PostFormsResponse interface {
+	responseInterface
+	postFormsResponse()
+}
+
+type postFormsResponse struct {
+	response
+}
+
+func (postFormsResponse) postFormsResponse() {}
+
+func (response postFormsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postFormsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postFormsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postFormsResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response postFormsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response postFormsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postFormsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postFormsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postFormsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetPetsResponse interface {
+	responseInterface
+	getPetsResponse()
+}
+
+type getPetsResponse struct {
+	response
+}
+
+func (getPetsResponse) getPetsResponse() {}
+
+func (response getPetsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getPetsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getPetsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getPetsResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getPetsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getPetsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getPetsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getPetsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getPetsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type PostPetsResponse interface {
+	responseInterface
+	postPetsResponse()
+}
+
+type postPetsResponse struct {
+	response
+}
+
+func (postPetsResponse) postPetsResponse() {}
+
+func (response postPetsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postPetsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postPetsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postPetsResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response postPetsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response postPetsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postPetsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postPetsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postPetsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type PatchPetsPetIDResponse interface {
+	responseInterface
+	patchPetsPetIDResponse()
+}
+
+type patchPetsPetIDResponse struct {
+	response
+}
+
+func (patchPetsPetIDResponse) patchPetsPetIDResponse() {}
+
+func (response patchPetsPetIDResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response patchPetsPetIDResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response patchPetsPetIDResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response patchPetsPetIDResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response patchPetsPetIDResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response patchPetsPetIDResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response patchPetsPetIDResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response patchPetsPetIDResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response patchPetsPetIDResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type PostPhotosResponse interface {
+	responseInterface
+	postPhotosResponse()
+}
+
+type postPhotosResponse struct {
+	response
+}
+
+func (postPhotosResponse) postPhotosResponse() {}
+
+func (response postPhotosResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response postPhotosResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response postPhotosResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response postPhotosResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response postPhotosResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response postPhotosResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response postPhotosResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response postPhotosResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response postPhotosResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetReportsIDResponse interface {
+	responseInterface
+	getReportsIDResponse()
+}
+
+type getReportsIDResponse struct {
+	response
+}
+
+func (getReportsIDResponse) getReportsIDResponse() {}
+
+func (response getReportsIDResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getReportsIDResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getReportsIDResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getReportsIDResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getReportsIDResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getReportsIDResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getReportsIDResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getReportsIDResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getReportsIDResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureBasicResponse interface {
+	responseInterface
+	getSecureBasicResponse()
+}
+
+type getSecureBasicResponse struct {
+	response
+}
+
+func (getSecureBasicResponse) getSecureBasicResponse() {}
+
+func (response getSecureBasicResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureBasicResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureBasicResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureBasicResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureBasicResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureBasicResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureBasicResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureBasicResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureBasicResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureBearerResponse interface {
+	responseInterface
+	getSecureBearerResponse()
+}
+
+type getSecureBearerResponse struct {
+	response
+}
+
+func (getSecureBearerResponse) getSecureBearerResponse() {}
+
+func (response getSecureBearerResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureBearerResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureBearerResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureBearerResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureBearerResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureBearerResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureBearerResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureBearerResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureBearerResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureCookieResponse interface {
+	responseInterface
+	getSecureCookieResponse()
+}
+
+type getSecureCookieResponse struct {
+	response
+}
+
+func (getSecureCookieResponse) getSecureCookieResponse() {}
+
+func (response getSecureCookieResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureCookieResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureCookieResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureCookieResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureCookieResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureCookieResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureCookieResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureCookieResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureCookieResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureHeaderResponse interface {
+	responseInterface
+	getSecureHeaderResponse()
+}
+
+type getSecureHeaderResponse struct {
+	response
+}
+
+func (getSecureHeaderResponse) getSecureHeaderResponse() {}
+
+func (response getSecureHeaderResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureHeaderResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureHeaderResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureHeaderResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureHeaderResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureHeaderResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureHeaderResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureHeaderResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureHeaderResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureMtlsResponse interface {
+	responseInterface
+	getSecureMtlsResponse()
+}
+
+type getSecureMtlsResponse struct {
+	response
+}
+
+func (getSecureMtlsResponse) getSecureMtlsResponse() {}
+
+func (response getSecureMtlsResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureMtlsResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureMtlsResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureMtlsResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureMtlsResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureMtlsResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureMtlsResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureMtlsResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureMtlsResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureOauthResponse interface {
+	responseInterface
+	getSecureOauthResponse()
+}
+
+type getSecureOauthResponse struct {
+	response
+}
+
+func (getSecureOauthResponse) getSecureOauthResponse() {}
+
+func (response getSecureOauthResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureOauthResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureOauthResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureOauthResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureOauthResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureOauthResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureOauthResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureOauthResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureOauthResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureOidcResponse interface {
+	responseInterface
+	getSecureOidcResponse()
+}
+
+type getSecureOidcResponse struct {
+	response
+}
+
+func (getSecureOidcResponse) getSecureOidcResponse() {}
+
+func (response getSecureOidcResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureOidcResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureOidcResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureOidcResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureOidcResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureOidcResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureOidcResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureOidcResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureOidcResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type GetSecureQueryResponse interface {
+	responseInterface
+	getSecureQueryResponse()
+}
+
+type getSecureQueryResponse struct {
+	response
+}
+
+func (getSecureQueryResponse) getSecureQueryResponse() {}
+
+func (response getSecureQueryResponse) statusCode() int {
+	return response.response.statusCode
+}
+
+func (response getSecureQueryResponse) body() interface{} {
+	return response.response.body
+}
+
+func (response getSecureQueryResponse) bodyRaw() []byte {
+	return response.response.bodyRaw
+}
+
+func (response getSecureQueryResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
+func (response getSecureQueryResponse) events() func(context.Context) (any, bool) {
+	return response.response.events
+}
+
+func (response getSecureQueryResponse) contentType() string {
+	return response.response.contentType
+}
+
+func (response getSecureQueryResponse) redirectURL() string {
+	return response.response.redirectURL
+}
+
+func (response getSecureQueryResponse) headers() map[string]string {
+	return response.response.headers
+}
+
+func (response getSecureQueryResponse) cookies() []http.Cookie {
+	return response.response.cookies
+}
+
+type postAnimalsStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostAnimalsResponseBuilder() *postAnimalsStatusCodeResponseBuilder {
+	return new(postAnimalsStatusCodeResponseBuilder)
+}
+
+func (builder *postAnimalsStatusCodeResponseBuilder) StatusCode200() *postAnimals200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &postAnimals200ContentTypeBuilder{response: builder.response}
+}
+
+type postAnimals200ContentTypeBuilder struct {
+	response
+}
+
+type PostAnimals200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *PostAnimals200ApplicationJsonResponseBuilder) Build() PostAnimalsResponse {
+	return postAnimalsResponse{response: builder.response}
+}
+
+func (builder *postAnimals200ContentTypeBuilder) ApplicationJson() *postAnimals200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &postAnimals200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type postAnimals200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *postAnimals200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *PostAnimals200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &PostAnimals200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postAnimals200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *PostAnimals200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &PostAnimals200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postAnimals200ApplicationJsonBodyBuilder) Body(body Shape) *PostAnimals200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &PostAnimals200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type getDownloadsNameStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetDownloadsNameResponseBuilder() *getDownloadsNameStatusCodeResponseBuilder {
+	return new(getDownloadsNameStatusCodeResponseBuilder)
+}
+
+func (builder *getDownloadsNameStatusCodeResponseBuilder) StatusCode200() *getDownloadsName200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getDownloadsName200ContentTypeBuilder{response: builder.response}
+}
+
+type getDownloadsName200ContentTypeBuilder struct {
+	response
+}
+
+type GetDownloadsName200ApplicationOctetStreamResponseBuilder struct {
+	response
+}
+
+func (builder *GetDownloadsName200ApplicationOctetStreamResponseBuilder) Build() GetDownloadsNameResponse {
+	return getDownloadsNameResponse{response: builder.response}
+}
+
+func (builder *getDownloadsName200ContentTypeBuilder) ApplicationOctetStream() *getDownloadsName200ApplicationOctetStreamBodyBuilder {
+	builder.response.contentType = "application/octet-stream"
+
+	return &getDownloadsName200ApplicationOctetStreamBodyBuilder{response: builder.response}
+}
+
+type getDownloadsName200ApplicationOctetStreamBodyBuilder struct {
+	response
+}
+
+func (builder *getDownloadsName200ApplicationOctetStreamBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetDownloadsName200ApplicationOctetStreamResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetDownloadsName200ApplicationOctetStreamResponseBuilder{response: builder.response}
+}
+
+func (builder *getDownloadsName200ApplicationOctetStreamBodyBuilder) BodyBytes(body []byte) *GetDownloadsName200ApplicationOctetStreamResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetDownloadsName200ApplicationOctetStreamResponseBuilder{response: builder.response}
+}
+
+func (builder *getDownloadsName200ApplicationOctetStreamBodyBuilder) Body(body GetDownloadsNameApplicationoctetStream) *GetDownloadsName200ApplicationOctetStreamResponseBuilder {
+	builder.response.body = body
+
+	return &GetDownloadsName200ApplicationOctetStreamResponseBuilder{response: builder.response}
+}
+
+// BodyStream copies body into the response, an io.Closer body is closed after it is sent.
+func (builder *getDownloadsName200ApplicationOctetStreamBodyBuilder) BodyStream(body io.Reader) *GetDownloadsName200ApplicationOctetStreamResponseBuilder {
+	builder.response.bodyStream = body
+
+	return &GetDownloadsName200ApplicationOctetStreamResponseBuilder{response: builder.response}
+}
+
+type postEmployeesStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostEmployeesResponseBuilder() *postEmployeesStatusCodeResponseBuilder {
+	return new(postEmployeesStatusCodeResponseBuilder)
+}
+
+func (builder *postEmployeesStatusCodeResponseBuilder) StatusCode201() *postEmployees201ContentTypeBuilder {
+	builder.response.statusCode = 201
+
+	return &postEmployees201ContentTypeBuilder{response: builder.response}
+}
+
+type postEmployees201ContentTypeBuilder struct {
+	response
+}
+
+type PostEmployees201ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *PostEmployees201ApplicationJsonResponseBuilder) Build() PostEmployeesResponse {
+	return postEmployeesResponse{response: builder.response}
+}
+
+func (builder *postEmployees201ContentTypeBuilder) ApplicationJson() *postEmployees201ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &postEmployees201ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type postEmployees201ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *postEmployees201ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *PostEmployees201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &PostEmployees201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postEmployees201ApplicationJsonBodyBuilder) BodyBytes(body []byte) *PostEmployees201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &PostEmployees201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postEmployees201ApplicationJsonBodyBuilder) Body(body Employee) *PostEmployees201ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &PostEmployees201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type getEventsStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetEventsResponseBuilder() *getEventsStatusCodeResponseBuilder {
+	return new(getEventsStatusCodeResponseBuilder)
+}
+
+func (builder *getEventsStatusCodeResponseBuilder) StatusCode200() *getEvents200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getEvents200ContentTypeBuilder{response: builder.response}
+}
+
+type getEvents200ContentTypeBuilder struct {
+	response
+}
+
+type GetEvents200TextEventStreamResponseBuilder struct {
+	response
+}
+
+func (builder *GetEvents200TextEventStreamResponseBuilder) Build() GetEventsResponse {
+	return getEventsResponse{response: builder.response}
+}
+
+func (builder *getEvents200ContentTypeBuilder) TextEventStream() *getEvents200TextEventStreamBodyBuilder {
+	builder.response.contentType = "text/event-stream"
+
+	return &getEvents200TextEventStreamBodyBuilder{response: builder.response}
+}
+
+type getEvents200TextEventStreamBodyBuilder struct {
+	response
+}
+
+func (builder *getEvents200TextEventStreamBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+func (builder *getEvents200TextEventStreamBodyBuilder) BodyBytes(body []byte) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+func (builder *getEvents200TextEventStreamBodyBuilder) Body(body Event) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.body = body
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+// Events sends every event received from events until it is closed or the request is canceled.
+func (builder *getEvents200TextEventStreamBodyBuilder) Events(events <-chan Event) *GetEvents200TextEventStreamResponseBuilder {
+	builder.response.events = func(ctx context.Context) (any, bool) {
+		select {
+		case event, ok := <-events:
+			return event, ok
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Cache-Control"] = "no-cache"
+
+	return &GetEvents200TextEventStreamResponseBuilder{response: builder.response}
+}
+
+type postFormsStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostFormsResponseBuilder() *postFormsStatusCodeResponseBuilder {
+	return new(postFormsStatusCodeResponseBuilder)
+}
+
+func (builder *postFormsStatusCodeResponseBuilder) StatusCode204() *PostForms204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &PostForms204ResponseBuilder{response: builder.response}
+}
+
+type PostForms204ResponseBuilder struct {
+	response
+}
+
+func (builder *PostForms204ResponseBuilder) Build() PostFormsResponse {
+	return postFormsResponse{response: builder.response}
+}
+
+type getPetsStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetPetsResponseBuilder() *getPetsStatusCodeResponseBuilder {
+	return new(getPetsStatusCodeResponseBuilder)
+}
+
+func (builder *getPetsStatusCodeResponseBuilder) StatusCode200() *getPets200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getPets200ContentTypeBuilder{response: builder.response}
+}
+
+type getPets200ContentTypeBuilder struct {
+	response
+}
+
+type GetPets200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetPets200ApplicationJsonResponseBuilder) Build() GetPetsResponse {
+	return getPetsResponse{response: builder.response}
+}
+
+func (builder *getPets200ContentTypeBuilder) ApplicationJson() *getPets200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getPets200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getPets200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getPets200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetPets200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetPets200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPets200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetPets200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetPets200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getPets200ApplicationJsonBodyBuilder) Body(body GetPetsApplicationjson) *GetPets200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetPets200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type postPetsStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostPetsResponseBuilder() *postPetsStatusCodeResponseBuilder {
+	return new(postPetsStatusCodeResponseBuilder)
+}
+
+func (builder *postPetsStatusCodeResponseBuilder) StatusCode201() *postPets201ContentTypeBuilder {
+	builder.response.statusCode = 201
+
+	return &postPets201ContentTypeBuilder{response: builder.response}
+}
+
+type postPets201ContentTypeBuilder struct {
+	response
+}
+
+type PostPets201ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *PostPets201ApplicationJsonResponseBuilder) Build() PostPetsResponse {
+	return postPetsResponse{response: builder.response}
+}
+
+func (builder *postPets201ContentTypeBuilder) ApplicationJson() *postPets201ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &postPets201ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type postPets201ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *postPets201ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *PostPets201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &PostPets201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postPets201ApplicationJsonBodyBuilder) BodyBytes(body []byte) *PostPets201ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &PostPets201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *postPets201ApplicationJsonBodyBuilder) Body(body Pet) *PostPets201ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &PostPets201ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+type patchPetsPetIDStatusCodeResponseBuilder struct {
+	response
+}
+
+func PatchPetsPetIDResponseBuilder() *patchPetsPetIDStatusCodeResponseBuilder {
+	return new(patchPetsPetIDStatusCodeResponseBuilder)
+}
+
+func (builder *patchPetsPetIDStatusCodeResponseBuilder) StatusCode204() *PatchPetsPetID204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &PatchPetsPetID204ResponseBuilder{response: builder.response}
+}
+
+type PatchPetsPetID204ResponseBuilder struct {
+	response
+}
+
+func (builder *PatchPetsPetID204ResponseBuilder) Build() PatchPetsPetIDResponse {
+	return patchPetsPetIDResponse{response: builder.response}
+}
+
+type postPhotosStatusCodeResponseBuilder struct {
+	response
+}
+
+func PostPhotosResponseBuilder() *postPhotosStatusCodeResponseBuilder {
+	return new(postPhotosStatusCodeResponseBuilder)
+}
+
+func (builder *postPhotosStatusCodeResponseBuilder) StatusCode204() *PostPhotos204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &PostPhotos204ResponseBuilder{response: builder.response}
+}
+
+type PostPhotos204ResponseBuilder struct {
+	response
+}
+
+func (builder *PostPhotos204ResponseBuilder) Build() PostPhotosResponse {
+	return postPhotosResponse{response: builder.response}
+}
+
+type getReportsIDStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetReportsIDResponseBuilder() *getReportsIDStatusCodeResponseBuilder {
+	return new(getReportsIDStatusCodeResponseBuilder)
+}
+
+func (builder *getReportsIDStatusCodeResponseBuilder) StatusCode200() *getReportsID200ContentTypeBuilder {
+	builder.response.statusCode = 200
+
+	return &getReportsID200ContentTypeBuilder{response: builder.response}
+}
+
+type getReportsID200ContentTypeBuilder struct {
+	response
+}
+
+type GetReportsID200ApplicationJsonResponseBuilder struct {
+	response
+}
+
+func (builder *GetReportsID200ApplicationJsonResponseBuilder) Build() GetReportsIDResponse {
+	return getReportsIDResponse{response: builder.response}
+}
+
+type GetReportsID200ApplicationXmlResponseBuilder struct {
+	response
+}
+
+func (builder *GetReportsID200ApplicationXmlResponseBuilder) Build() GetReportsIDResponse {
+	return getReportsIDResponse{response: builder.response}
+}
+
+func (builder *getReportsID200ContentTypeBuilder) ApplicationJson() *getReportsID200ApplicationJsonBodyBuilder {
+	builder.response.contentType = "application/json"
+
+	return &getReportsID200ApplicationJsonBodyBuilder{response: builder.response}
+}
+
+type getReportsID200ApplicationJsonBodyBuilder struct {
+	response
+}
+
+func (builder *getReportsID200ApplicationJsonBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetReportsID200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetReportsID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getReportsID200ApplicationJsonBodyBuilder) BodyBytes(body []byte) *GetReportsID200ApplicationJsonResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetReportsID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getReportsID200ApplicationJsonBodyBuilder) Body(body Event) *GetReportsID200ApplicationJsonResponseBuilder {
+	builder.response.body = body
+
+	return &GetReportsID200ApplicationJsonResponseBuilder{response: builder.response}
+}
+
+func (builder *getReportsID200ContentTypeBuilder) ApplicationXml() *getReportsID200ApplicationXmlBodyBuilder {
+	builder.response.contentType = "application/xml"
+
+	return &getReportsID200ApplicationXmlBodyBuilder{response: builder.response}
+}
+
+type getReportsID200ApplicationXmlBodyBuilder struct {
+	response
+}
+
+func (builder *getReportsID200ApplicationXmlBodyBuilder) BodyBytesWithEncoding(encoding string, body []byte) *GetReportsID200ApplicationXmlResponseBuilder {
+	builder.response.bodyRaw = body
+	if builder.response.headers == nil {
+		builder.response.headers = make(map[string]string)
+	}
+	builder.response.headers["Content-Encoding"] = encoding
+
+	return &GetReportsID200ApplicationXmlResponseBuilder{response: builder.response}
+}
+
+func (builder *getReportsID200ApplicationXmlBodyBuilder) BodyBytes(body []byte) *GetReportsID200ApplicationXmlResponseBuilder {
+	builder.response.bodyRaw = body
+
+	return &GetReportsID200ApplicationXmlResponseBuilder{response: builder.response}
+}
+
+func (builder *getReportsID200ApplicationXmlBodyBuilder) Body(body Event) *GetReportsID200ApplicationXmlResponseBuilder {
+	builder.response.body = body
+
+	return &GetReportsID200ApplicationXmlResponseBuilder{response: builder.response}
+}
+
+// Negotiated picks the media type preferred by accept, the first declared one when none is acceptable.
+func (builder *getReportsID200ContentTypeBuilder) Negotiated(accept Accept) *getReportsID200NegotiatedBodyBuilder {
+	builder.response.contentType = accept.Negotiate("application/json", "application/xml")
+	if builder.response.contentType == "" {
+		builder.response.contentType = "application/json"
+	}
+
+	return &getReportsID200NegotiatedBodyBuilder{response: builder.response}
+}
+
+type getReportsID200NegotiatedBodyBuilder struct {
+	response
+}
+
+func (builder *getReportsID200NegotiatedBodyBuilder) Body(body Event) *GetReportsID200NegotiatedResponseBuilder {
+	builder.response.body = body
+
+	return &GetReportsID200NegotiatedResponseBuilder{response: builder.response}
+}
+
+type GetReportsID200NegotiatedResponseBuilder struct {
+	response
+}
+
+func (builder *GetReportsID200NegotiatedResponseBuilder) Build() GetReportsIDResponse {
+	return getReportsIDResponse{response: builder.response}
+}
+
+type getSecureBasicStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureBasicResponseBuilder() *getSecureBasicStatusCodeResponseBuilder {
+	return new(getSecureBasicStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureBasicStatusCodeResponseBuilder) StatusCode204() *GetSecureBasic204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureBasic204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureBasic204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureBasic204ResponseBuilder) Build() GetSecureBasicResponse {
+	return getSecureBasicResponse{response: builder.response}
+}
+
+type getSecureBearerStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureBearerResponseBuilder() *getSecureBearerStatusCodeResponseBuilder {
+	return new(getSecureBearerStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureBearerStatusCodeResponseBuilder) StatusCode204() *GetSecureBearer204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureBearer204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureBearer204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureBearer204ResponseBuilder) Build() GetSecureBearerResponse {
+	return getSecureBearerResponse{response: builder.response}
+}
+
+type getSecureCookieStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureCookieResponseBuilder() *getSecureCookieStatusCodeResponseBuilder {
+	return new(getSecureCookieStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureCookieStatusCodeResponseBuilder) StatusCode204() *GetSecureCookie204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureCookie204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureCookie204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureCookie204ResponseBuilder) Build() GetSecureCookieResponse {
+	return getSecureCookieResponse{response: builder.response}
+}
+
+type getSecureHeaderStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureHeaderResponseBuilder() *getSecureHeaderStatusCodeResponseBuilder {
+	return new(getSecureHeaderStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureHeaderStatusCodeResponseBuilder) StatusCode204() *GetSecureHeader204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureHeader204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureHeader204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureHeader204ResponseBuilder) Build() GetSecureHeaderResponse {
+	return getSecureHeaderResponse{response: builder.response}
+}
+
+type getSecureMtlsStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureMtlsResponseBuilder() *getSecureMtlsStatusCodeResponseBuilder {
+	return new(getSecureMtlsStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureMtlsStatusCodeResponseBuilder) StatusCode204() *GetSecureMtls204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureMtls204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureMtls204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureMtls204ResponseBuilder) Build() GetSecureMtlsResponse {
+	return getSecureMtlsResponse{response: builder.response}
+}
+
+type getSecureOauthStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureOauthResponseBuilder() *getSecureOauthStatusCodeResponseBuilder {
+	return new(getSecureOauthStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureOauthStatusCodeResponseBuilder) StatusCode204() *GetSecureOauth204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureOauth204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureOauth204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureOauth204ResponseBuilder) Build() GetSecureOauthResponse {
+	return getSecureOauthResponse{response: builder.response}
+}
+
+type getSecureOidcStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureOidcResponseBuilder() *getSecureOidcStatusCodeResponseBuilder {
+	return new(getSecureOidcStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureOidcStatusCodeResponseBuilder) StatusCode204() *GetSecureOidc204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureOidc204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureOidc204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureOidc204ResponseBuilder) Build() GetSecureOidcResponse {
+	return getSecureOidcResponse{response: builder.response}
+}
+
+type getSecureQueryStatusCodeResponseBuilder struct {
+	response
+}
+
+func GetSecureQueryResponseBuilder() *getSecureQueryStatusCodeResponseBuilder {
+	return new(getSecureQueryStatusCodeResponseBuilder)
+}
+
+func (builder *getSecureQueryStatusCodeResponseBuilder) StatusCode204() *GetSecureQuery204ResponseBuilder {
+	builder.response.statusCode = 204
+
+	return &GetSecureQuery204ResponseBuilder{response: builder.response}
+}
+
+type GetSecureQuery204ResponseBuilder struct {
+	response
+}
+
+func (builder *GetSecureQuery204ResponseBuilder) Build() GetSecureQueryResponse {
+	return getSecureQueryResponse{response: builder.response}
+}
+
+type AnimalsService interface {
+	PostAnimals(context.Context, PostAnimalsRequest) PostAnimalsResponse
+	PostEmployees(context.Context, PostEmployeesRequest) PostEmployeesResponse
+}
+
+type EventsService interface {
+	GetDownloadsName(context.Context, GetDownloadsNameRequest) GetDownloadsNameResponse
+	GetEvents(context.Context, GetEventsRequest) GetEventsResponse
+}
+
+type FormsService interface {
+	PostForms(context.Context, PostFormsRequest) PostFormsResponse
+	PostPhotos(context.Context, PostPhotosRequest) PostPhotosResponse
+}
+
+type PetsService interface {
+	GetPets(context.Context, GetPetsRequest) GetPetsResponse
+	PostPetsApplicationJson(context.Context, PostPetsApplicationJsonRequest) PostPetsResponse
+	PostPetsApplicationXWwwFormUrlencoded(context.Context, PostPetsApplicationXWwwFormUrlencodedRequest) PostPetsResponse
+	PatchPetsPetID(context.Context, PatchPetsPetIDRequest) PatchPetsPetIDResponse
+}
+
+type ReportsService interface {
+	GetReportsID(context.Context, GetReportsIDRequest) GetReportsIDResponse
+}
+
+type SecureService interface {
+	GetSecureBasic(context.Context, GetSecureBasicRequest) GetSecureBasicResponse
+	GetSecureBearer(context.Context, GetSecureBearerRequest) GetSecureBearerResponse
+	GetSecureCookie(context.Context, GetSecureCookieRequest) GetSecureCookieResponse
+	GetSecureHeader(context.Context, GetSecureHeaderRequest) GetSecureHeaderResponse
+	GetSecureMtls(context.Context, GetSecureMtlsRequest) GetSecureMtlsResponse
+	GetSecureOauth(context.Context, GetSecureOauthRequest) GetSecureOauthResponse
+	GetSecureOidc(context.Context, GetSecureOidcRequest) GetSecureOidcResponse
+	GetSecureQuery(context.Context, GetSecureQueryRequest) GetSecureQueryResponse
+}
+
+type PostAnimalsRequest struct {
+	Body                  Animal
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type PostEmployeesRequest struct {
+	Body                  Employee
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type GetDownloadsNameRequestPath struct {
+	Name string `json:"name"`
+}
+
+func (path GetDownloadsNameRequestPath) GetName() string {
+	return path.Name
+}
+
+func (path GetDownloadsNameRequestPath) Validate() error {
+	return nil
+}
+
+type GetDownloadsNameRequest struct {
+	Path                  GetDownloadsNameRequestPath
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type GetEventsRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type PostFormsRequest struct {
+	Body                  PostFormsRequestBody
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type PostPhotosRequest struct {
+	Body                  PostPhotosRequestBody
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type GetPetsRequestHeader struct {
+	XFlags []bool `json:"x-flags"`
+}
+
+func (header GetPetsRequestHeader) GetXFlags() []bool {
+	return header.XFlags
+}
+
+func (header GetPetsRequestHeader) Validate() error {
+	return nil
+}
+
+type GetPetsRequestQuery struct {
+	Ids    []int             `json:"ids"`
+	Kinds  []Kind            `json:"kinds"`
+	Labels map[string]string `json:"labels"`
+	Limit  int               `json:"limit"`
+	Tags   []string          `json:"tags"`
+	Where  PetFilter         `json:"where"`
+}
+
+func (query GetPetsRequestQuery) GetIds() []int {
+	return query.Ids
+}
+
+func (query GetPetsRequestQuery) GetKinds() []Kind {
+	return query.Kinds
+}
+
+func (query GetPetsRequestQuery) GetLabels() map[string]string {
+	return query.Labels
+}
+
+func (query GetPetsRequestQuery) GetLimit() int {
+	return query.Limit
+}
+
+func (query GetPetsRequestQuery) GetTags() []string {
+	return query.Tags
+}
+
+func (query GetPetsRequestQuery) GetWhere() PetFilter {
+	return query.Where
+}
+
+func (query GetPetsRequestQuery) Validate() error {
+	return validation.ValidateStruct(&query,
+		validation.Field(&query.Ids, validation.Length(0, 3).ErrorObject(validation.NewError("validation_max_items", "must contain no more than 3 items"))),
+		validation.Field(&query.Limit, validation.By(Minimum(1, false)), validation.Max(100)),
+		validation.Field(&query.Where, validation.Skip.When(reflect.ValueOf(query.Where).IsZero())))
+}
+
+type GetPetsRequest struct {
+	Header                GetPetsRequestHeader
+	Query                 GetPetsRequestQuery
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type PostPetsApplicationJsonRequest struct {
+	Body                  NewPet
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type PostPetsApplicationXWwwFormUrlencodedRequest struct {
+	Body                  NewPet
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type PatchPetsPetIDRequestPath struct {
+	PetID int `json:"petId"`
+}
+
+func (path PatchPetsPetIDRequestPath) GetPetID() int {
+	return path.PetID
+}
+
+func (path PatchPetsPetIDRequestPath) Validate() error {
+	return nil
+}
+
+type PatchPetsPetIDRequest struct {
+	Body                  PetPatch
+	Path                  PatchPetsPetIDRequestPath
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type GetReportsIDRequestPath struct {
+	ID int `json:"id"`
+}
+
+func (path GetReportsIDRequestPath) GetID() int {
+	return path.ID
+}
+
+func (path GetReportsIDRequestPath) Validate() error {
+	return nil
+}
+
+type GetReportsIDRequest struct {
+	Path                  GetReportsIDRequestPath
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+}
+
+type GetSecureBasicRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+}
+
+type GetSecureBearerRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+}
+
+type GetSecureCookieRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+}
+
+type GetSecureHeaderRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+}
+
+type GetSecureMtlsRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+}
+
+type GetSecureOauthRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+	SecurityScopes        map[SecurityScheme][]string
+}
+
+type GetSecureOidcRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+	SecurityScopes        map[SecurityScheme][]string
+}
+
+type GetSecureQueryRequest struct {
+	ProcessingResult      RequestProcessingResult
+	Accept                Accept
+	NegotiatedContentType string
+	SecurityCheckResults  map[SecurityScheme]string
+}
+
+type SecurityScheme string
+
+const (
+	SecuritySchemeApiKey  SecurityScheme = "ApiKey"
+	SecuritySchemeBasic   SecurityScheme = "Basic"
+	SecuritySchemeBearer  SecurityScheme = "Bearer"
+	SecuritySchemeMtls    SecurityScheme = "Mtls"
+	SecuritySchemeOauth   SecurityScheme = "Oauth"
+	SecuritySchemeOidc    SecurityScheme = "Oidc"
+	SecuritySchemeSession SecurityScheme = "Session"
+	SecuritySchemeToken   SecurityScheme = "Token"
+)
+
+type securityProcessor struct {
+	scheme    SecurityScheme
+	extract   func(r *http.Request) (string, string, bool)
+	handle    func(r *http.Request, scheme SecurityScheme, name string, value string) error
+	authorize func(r *http.Request, scheme SecurityScheme, name string, value string, scopes []string) ([]string, error)
+	scopes    []string
+}
+
+// withScopes is the processor of a security requirement requiring scopes.
+func (processor securityProcessor) withScopes(scopes ...string) securityProcessor {
+	processor.scopes = scopes
+
+	return processor
+}
+
+// check runs the SecuritySchemas method of the processor, the granted scopes have to contain the required ones.
+func (processor securityProcessor) check(r *http.Request, name string, value string) ([]string, error) {
+	if processor.authorize == nil {
+		return nil, processor.handle(r, processor.scheme, name, value)
+	}
+
+	granted, err := processor.authorize(r, processor.scheme, name, value, processor.scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, scope := range processor.scopes {
+		if !slices.Contains(granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing scopes %q", missing)
+	}
+
+	return granted, nil
+}
+
+var securityExtractorsFuncs = map[SecurityScheme]func(r *http.Request) (string, string, bool){
+	SecuritySchemeApiKey: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("X-API-Key")
+
+		return "X-API-Key", value, value != ""
+	},
+	SecuritySchemeBasic: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(value, "Basic ") {
+			return "", "", false
+		}
+
+		value = value[6:]
+
+		return "", value, value != ""
+	},
+	SecuritySchemeBearer: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(value, "Bearer ") {
+			return "", "", false
+		}
+
+		value = value[7:]
+
+		return "", value, value != ""
+	},
+	SecuritySchemeMtls: func(r *http.Request) (string, string, bool) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 || len(r.TLS.VerifiedChains) == 0 {
+			return "", "", false
+		}
+
+		return "", r.TLS.PeerCertificates[0].Subject.String(), true
+	},
+	SecuritySchemeOauth: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(value, "Bearer ") {
+			return "", "", false
+		}
+
+		value = value[7:]
+
+		return "", value, value != ""
+	},
+	SecuritySchemeOidc: func(r *http.Request) (string, string, bool) {
+		value := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(value, "Bearer ") {
+			return "", "", false
+		}
+
+		value = value[7:]
+
+		return "", value, value != ""
+	},
+	SecuritySchemeSession: func(r *http.Request) (string, string, bool) {
+		cookie, err := r.Cookie("session")
+
+		if err != nil {
+			return "", "", false
+		}
+
+		return cookie.Name, cookie.Value, true
+	},
+	SecuritySchemeToken: func(r *http.Request) (string, string, bool) {
+		value := r.URL.Query().Get("api_key")
+		return "api_key", value, value != ""
+	},
+}
+
+type SecuritySchemas interface {
+	SecuritySchemeApiKey(r *http.Request, scheme SecurityScheme, name string, value string) error
+	SecuritySchemeBasic(r *http.Request, scheme SecurityScheme, name string, value string) error
+	SecuritySchemeBearer(r *http.Request, scheme SecurityScheme, name string, value string) error
+	SecuritySchemeMtls(r *http.Request, scheme SecurityScheme, certificate *x509.Certificate) error
+	SecuritySchemeOauth(r *http.Request, scheme SecurityScheme, name string, value string, scopes []string) (granted []string, err error)
+	SecuritySchemeOidc(r *http.Request, scheme SecurityScheme, name string, value string, scopes []string) (granted []string, err error)
+	SecuritySchemeSession(r *http.Request, scheme SecurityScheme, name string, value string) error
+	SecuritySchemeToken(r *http.Request, scheme SecurityScheme, name string, value string) error
+}
+
+type SecurityCheckResult struct {
+	Scheme SecurityScheme
+	Value  string
+}
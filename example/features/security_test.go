@@ -0,0 +1,336 @@
+package features
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type secureService struct {
+	result  RequestProcessingResult
+	checks  map[SecurityScheme]string
+	granted map[SecurityScheme][]string
+}
+
+func (s *secureService) GetSecureBasic(ctx context.Context, request GetSecureBasicRequest) GetSecureBasicResponse {
+	s.result, s.checks = request.ProcessingResult, request.SecurityCheckResults
+
+	return GetSecureBasicResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureBearer(ctx context.Context, request GetSecureBearerRequest) GetSecureBearerResponse {
+	s.result, s.checks = request.ProcessingResult, request.SecurityCheckResults
+
+	return GetSecureBearerResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureCookie(ctx context.Context, request GetSecureCookieRequest) GetSecureCookieResponse {
+	s.result, s.checks = request.ProcessingResult, request.SecurityCheckResults
+
+	return GetSecureCookieResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureHeader(ctx context.Context, request GetSecureHeaderRequest) GetSecureHeaderResponse {
+	s.result, s.checks = request.ProcessingResult, request.SecurityCheckResults
+
+	return GetSecureHeaderResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureMtls(ctx context.Context, request GetSecureMtlsRequest) GetSecureMtlsResponse {
+	s.result, s.checks = request.ProcessingResult, request.SecurityCheckResults
+
+	return GetSecureMtlsResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureOauth(ctx context.Context, request GetSecureOauthRequest) GetSecureOauthResponse {
+	s.result, s.checks, s.granted = request.ProcessingResult, request.SecurityCheckResults, request.SecurityScopes
+
+	return GetSecureOauthResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureOidc(ctx context.Context, request GetSecureOidcRequest) GetSecureOidcResponse {
+	s.result, s.checks, s.granted = request.ProcessingResult, request.SecurityCheckResults, request.SecurityScopes
+
+	return GetSecureOidcResponseBuilder().StatusCode204().Build()
+}
+
+func (s *secureService) GetSecureQuery(ctx context.Context, request GetSecureQueryRequest) GetSecureQueryResponse {
+	s.result, s.checks = request.ProcessingResult, request.SecurityCheckResults
+
+	return GetSecureQueryResponseBuilder().StatusCode204().Build()
+}
+
+var errRejected = errors.New("rejected")
+
+// securitySchemas accepts the "secret" credential of every scheme.
+type securitySchemas struct{}
+
+func (securitySchemas) secret(value string) error {
+	if value != "secret" {
+		return errRejected
+	}
+
+	return nil
+}
+
+func (s securitySchemas) SecuritySchemeApiKey(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return s.secret(value)
+}
+
+func (s securitySchemas) SecuritySchemeBasic(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	if _, password, ok := r.BasicAuth(); !ok || password != "secret" {
+		return errRejected
+	}
+
+	return nil
+}
+
+func (s securitySchemas) SecuritySchemeBearer(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return s.secret(value)
+}
+
+func (s securitySchemas) SecuritySchemeMtls(r *http.Request, scheme SecurityScheme, certificate *x509.Certificate) error {
+	if certificate.Subject.CommonName != "partner" {
+		return errRejected
+	}
+
+	return nil
+}
+
+func (s securitySchemas) SecuritySchemeOauth(r *http.Request, scheme SecurityScheme, name string, value string, scopes []string) ([]string, error) {
+	switch value {
+	case "reader":
+		return []string{"pets:read"}, nil
+	case "writer":
+		return []string{"pets:read", "pets:write"}, nil
+	}
+
+	return nil, errRejected
+}
+
+func (s securitySchemas) SecuritySchemeOidc(r *http.Request, scheme SecurityScheme, name string, value string, scopes []string) ([]string, error) {
+	return []string{"openid"}, s.secret(value)
+}
+
+func (s securitySchemas) SecuritySchemeSession(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return s.secret(value)
+}
+
+func (s securitySchemas) SecuritySchemeToken(r *http.Request, scheme SecurityScheme, name string, value string) error {
+	return s.secret(value)
+}
+
+func clientCertificate(commonName string) *tls.ConnectionState {
+	certificate := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+
+	return &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{certificate},
+		VerifiedChains:   [][]*x509.Certificate{{certificate}},
+	}
+}
+
+func TestSecuritySchemes(t *testing.T) {
+	basic := func(password string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte("user:"+password))
+	}
+
+	tests := []struct {
+		name           string
+		target         string
+		prepare        func(*http.Request)
+		expectType     requestProcessingResultType
+		expectRejected bool
+		expectScheme   SecurityScheme
+		expectGranted  []string
+	}{
+		{
+			name:         "Bearer token",
+			target:       "/secure/bearer",
+			prepare:      func(r *http.Request) { r.Header.Set("Authorization", "Bearer secret") },
+			expectType:   ParseSucceed,
+			expectScheme: SecuritySchemeBearer,
+		},
+		{
+			name:           "Bearer token rejected",
+			target:         "/secure/bearer",
+			prepare:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer guess") },
+			expectType:     SecurityParseFailed,
+			expectRejected: true,
+		},
+		{
+			name:       "Bearer token missing",
+			target:     "/secure/bearer",
+			prepare:    func(r *http.Request) { r.Header.Set("Authorization", basic("secret")) },
+			expectType: SecurityParseFailed,
+		},
+		{
+			name:         "Basic credentials",
+			target:       "/secure/basic",
+			prepare:      func(r *http.Request) { r.Header.Set("Authorization", basic("secret")) },
+			expectType:   ParseSucceed,
+			expectScheme: SecuritySchemeBasic,
+		},
+		{
+			name:           "Basic credentials rejected",
+			target:         "/secure/basic",
+			prepare:        func(r *http.Request) { r.Header.Set("Authorization", basic("guess")) },
+			expectType:     SecurityParseFailed,
+			expectRejected: true,
+		},
+		{
+			name:         "API key header",
+			target:       "/secure/header",
+			prepare:      func(r *http.Request) { r.Header.Set("X-API-Key", "secret") },
+			expectType:   ParseSucceed,
+			expectScheme: SecuritySchemeApiKey,
+		},
+		{
+			name:       "API key header missing",
+			target:     "/secure/header",
+			prepare:    func(r *http.Request) {},
+			expectType: SecurityParseFailed,
+		},
+		{
+			name:         "API key cookie",
+			target:       "/secure/cookie",
+			prepare:      func(r *http.Request) { r.AddCookie(&http.Cookie{Name: "session", Value: "secret"}) },
+			expectType:   ParseSucceed,
+			expectScheme: SecuritySchemeSession,
+		},
+		{
+			name:           "API key cookie rejected",
+			target:         "/secure/cookie",
+			prepare:        func(r *http.Request) { r.AddCookie(&http.Cookie{Name: "session", Value: "guess"}) },
+			expectType:     SecurityParseFailed,
+			expectRejected: true,
+		},
+		{
+			name:         "API key query",
+			target:       "/secure/query?api_key=secret",
+			prepare:      func(r *http.Request) {},
+			expectType:   ParseSucceed,
+			expectScheme: SecuritySchemeToken,
+		},
+		{
+			name:       "API key query missing",
+			target:     "/secure/query",
+			prepare:    func(r *http.Request) {},
+			expectType: SecurityParseFailed,
+		},
+		{
+			name:          "OAuth2 token granting the required scopes",
+			target:        "/secure/oauth",
+			prepare:       func(r *http.Request) { r.Header.Set("Authorization", "Bearer writer") },
+			expectType:    ParseSucceed,
+			expectScheme:  SecuritySchemeOauth,
+			expectGranted: []string{"pets:read", "pets:write"},
+		},
+		{
+			name:           "OAuth2 token missing a required scope",
+			target:         "/secure/oauth",
+			prepare:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer reader") },
+			expectType:     SecurityParseFailed,
+			expectRejected: true,
+		},
+		{
+			name:          "OpenID Connect token",
+			target:        "/secure/oidc",
+			prepare:       func(r *http.Request) { r.Header.Set("Authorization", "Bearer secret") },
+			expectType:    ParseSucceed,
+			expectScheme:  SecuritySchemeOidc,
+			expectGranted: []string{"openid"},
+		},
+		{
+			name:           "OpenID Connect token rejected",
+			target:         "/secure/oidc",
+			prepare:        func(r *http.Request) { r.Header.Set("Authorization", "Bearer guess") },
+			expectType:     SecurityParseFailed,
+			expectRejected: true,
+		},
+		{
+			name:         "Mutual TLS client certificate",
+			target:       "/secure/mtls",
+			prepare:      func(r *http.Request) { r.TLS = clientCertificate("partner") },
+			expectType:   ParseSucceed,
+			expectScheme: SecuritySchemeMtls,
+		},
+		{
+			name:           "Mutual TLS unknown client certificate",
+			target:         "/secure/mtls",
+			prepare:        func(r *http.Request) { r.TLS = clientCertificate("stranger") },
+			expectType:     SecurityParseFailed,
+			expectRejected: true,
+		},
+		{
+			name:       "Mutual TLS without a client certificate",
+			target:     "/secure/mtls",
+			prepare:    func(r *http.Request) { r.TLS = &tls.ConnectionState{} },
+			expectType: SecurityParseFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rejected bool
+			hooks := &Hooks{
+				RequestSecurityCheckFailed: func(r *http.Request, operation string, scheme string, result RequestProcessingResult) {
+					rejected = true
+				},
+			}
+
+			service := &secureService{}
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			tt.prepare(req)
+			SecureHandler(service, chi.NewRouter(), hooks, securitySchemas{}).ServeHTTP(httptest.NewRecorder(), req)
+
+			if service.result.Type() != tt.expectType {
+				t.Fatalf("Expected result type %d, got %d: %v", tt.expectType, service.result.Type(), service.result.Err())
+			}
+
+			if rejected != tt.expectRejected {
+				t.Errorf("Expected rejected credentials %v, got: %v", tt.expectRejected, service.result.Err())
+			}
+
+			if tt.expectType != ParseSucceed {
+				return
+			}
+
+			if _, ok := service.checks[tt.expectScheme]; !ok || len(service.checks) != 1 {
+				t.Errorf("Expected the check result of %s only, got %v", tt.expectScheme, service.checks)
+			}
+
+			if !reflect.DeepEqual(service.granted[tt.expectScheme], tt.expectGranted) {
+				t.Errorf("Expected the granted scopes %v, got %v", tt.expectGranted, service.granted)
+			}
+		})
+	}
+}
+
+func TestSecurityHooks(t *testing.T) {
+	var failedScheme string
+	hooks := &Hooks{
+		RequestSecurityCheckFailed: func(r *http.Request, operation string, scheme string, result RequestProcessingResult) {
+			failedScheme = scheme
+			if !errors.Is(result.Err(), errRejected) {
+				t.Errorf("Expected the error of the security schema, got: %v", result.Err())
+			}
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/secure/header", nil)
+	req.Header.Set("X-API-Key", "guess")
+	SecureHandler(&secureService{}, chi.NewRouter(), hooks, securitySchemas{}).ServeHTTP(httptest.NewRecorder(), req)
+
+	if failedScheme != string(SecuritySchemeApiKey) {
+		t.Errorf("Expected the %s check to be reported as failed, got %q", SecuritySchemeApiKey, failedScheme)
+	}
+}
@@ -0,0 +1,12 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package features
+
+import "net/http"
+
+var spec = []byte("{\"components\":{\"schemas\":{\"Animal\":{\"discriminator\":{\"mapping\":{\"cat\":\"#/components/schemas/Cat\",\"dog\":\"#/components/schemas/Dog\"},\"propertyName\":\"petType\"},\"oneOf\":[{\"$ref\":\"#/components/schemas/Cat\"},{\"$ref\":\"#/components/schemas/Dog\"}]},\"Audited\":{\"properties\":{\"createdBy\":{\"type\":\"string\"}},\"type\":\"object\"},\"Cat\":{\"properties\":{\"name\":{\"minLength\":1,\"type\":\"string\"},\"petType\":{\"type\":\"string\"}},\"required\":[\"petType\",\"name\"],\"type\":\"object\"},\"Circle\":{\"properties\":{\"radius\":{\"minimum\":1,\"type\":\"integer\"}},\"required\":[\"radius\"],\"type\":\"object\"},\"Dog\":{\"properties\":{\"bark\":{\"type\":\"boolean\"},\"petType\":{\"type\":\"string\"}},\"required\":[\"petType\",\"bark\"],\"type\":\"object\"},\"Employee\":{\"allOf\":[{\"$ref\":\"#/components/schemas/Person\"},{\"$ref\":\"#/components/schemas/Audited\"},{\"properties\":{\"salary\":{\"minimum\":1,\"type\":\"integer\"}},\"required\":[\"salary\"],\"type\":\"object\"}]},\"Event\":{\"properties\":{\"id\":{\"type\":\"integer\"},\"text\":{\"type\":\"string\"}},\"required\":[\"id\"],\"type\":\"object\"},\"Kind\":{\"enum\":[\"cat\",\"dog\"],\"type\":\"string\"},\"NewPet\":{\"properties\":{\"legs\":{\"default\":4,\"type\":\"integer\"},\"name\":{\"maxLength\":20,\"minLength\":1,\"type\":\"string\"},\"nick\":{\"default\":\"buddy\",\"type\":\"string\"},\"tags\":{\"items\":{\"pattern\":\"^[a-z]+$\",\"type\":\"string\"},\"maxItems\":3,\"type\":\"array\",\"uniqueItems\":true},\"traits\":{\"additionalProperties\":{\"minLength\":1,\"pattern\":\"^[a-z]+$\",\"type\":\"string\"},\"maxProperties\":3,\"type\":\"object\"}},\"required\":[\"name\"],\"type\":\"object\"},\"Person\":{\"properties\":{\"name\":{\"minLength\":1,\"type\":\"string\"}},\"required\":[\"name\"],\"type\":\"object\"},\"Pet\":{\"properties\":{\"id\":{\"type\":\"integer\"},\"legs\":{\"type\":\"integer\"},\"name\":{\"type\":\"string\"},\"nick\":{\"type\":\"string\"}},\"required\":[\"id\",\"name\"],\"type\":\"object\"},\"PetFilter\":{\"properties\":{\"kind\":{\"$ref\":\"#/components/schemas/Kind\"},\"minAge\":{\"default\":1,\"type\":\"integer\"},\"q\":{\"type\":\"string\"}},\"required\":[\"q\"],\"type\":\"object\"},\"PetPatch\":{\"properties\":{\"name\":{\"maxLength\":20,\"minLength\":1,\"type\":\"string\"},\"owner\":{\"nullable\":true,\"type\":\"string\"}},\"type\":\"object\"},\"Shape\":{\"anyOf\":[{\"$ref\":\"#/components/schemas/Circle\"},{\"$ref\":\"#/components/schemas/Square\"}]},\"Square\":{\"properties\":{\"side\":{\"minimum\":1,\"type\":\"integer\"}},\"required\":[\"side\"],\"type\":\"object\"}},\"securitySchemes\":{\"apiKey\":{\"in\":\"header\",\"name\":\"X-API-Key\",\"type\":\"apiKey\"},\"basic\":{\"scheme\":\"basic\",\"type\":\"http\"},\"bearer\":{\"scheme\":\"bearer\",\"type\":\"http\"},\"mtls\":{\"type\":\"mutualTLS\"},\"oauth\":{\"flows\":{\"clientCredentials\":{\"scopes\":{\"pets:read\":\"read pets\",\"pets:write\":\"write pets\"},\"tokenUrl\":\"https://example.com/token\"}},\"type\":\"oauth2\"},\"oidc\":{\"openIdConnectUrl\":\"https://example.com/.well-known/openid-configuration\",\"type\":\"openIdConnect\"},\"session\":{\"in\":\"cookie\",\"name\":\"session\",\"type\":\"apiKey\"},\"token\":{\"in\":\"query\",\"name\":\"api_key\",\"type\":\"apiKey\"}}},\"info\":{\"description\":\"Exercises the generator features, generated with -optional and -content-negotiation\",\"title\":\"Features\",\"version\":\"1.0.0\"},\"openapi\":\"3.0.3\",\"paths\":{\"/animals\":{\"post\":{\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Animal\"}}},\"required\":true},\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Shape\"}}},\"description\":\"the footprint of the animal\"}},\"tags\":[\"animals\"]}},\"/downloads/{name}\":{\"get\":{\"parameters\":[{\"in\":\"path\",\"name\":\"name\",\"required\":true,\"schema\":{\"type\":\"string\"}}],\"responses\":{\"200\":{\"content\":{\"application/octet-stream\":{\"schema\":{\"format\":\"binary\",\"type\":\"string\"}}},\"description\":\"the file\"}},\"tags\":[\"events\"]}},\"/employees\":{\"post\":{\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Employee\"}}},\"required\":true},\"responses\":{\"201\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Employee\"}}},\"description\":\"created\"}},\"tags\":[\"animals\"]}},\"/events\":{\"get\":{\"responses\":{\"200\":{\"content\":{\"text/event-stream\":{\"schema\":{\"$ref\":\"#/components/schemas/Event\"}}},\"description\":\"a stream of events\"}},\"tags\":[\"events\"]}},\"/forms\":{\"post\":{\"requestBody\":{\"content\":{\"application/x-www-form-urlencoded\":{\"encoding\":{\"codes\":{\"explode\":false,\"style\":\"form\"}},\"schema\":{\"properties\":{\"age\":{\"maximum\":30,\"type\":\"integer\"},\"codes\":{\"items\":{\"type\":\"integer\"},\"type\":\"array\"},\"kind\":{\"$ref\":\"#/components/schemas/Kind\"},\"name\":{\"minLength\":2,\"type\":\"string\"},\"size\":{\"default\":3,\"type\":\"integer\"}},\"required\":[\"name\"],\"type\":\"object\"}}},\"required\":true},\"responses\":{\"204\":{\"description\":\"ok\"}},\"tags\":[\"forms\"]}},\"/pets\":{\"get\":{\"parameters\":[{\"in\":\"query\",\"name\":\"limit\",\"schema\":{\"default\":20,\"maximum\":100,\"minimum\":1,\"type\":\"integer\"}},{\"in\":\"query\",\"name\":\"ids\",\"schema\":{\"items\":{\"type\":\"integer\"},\"maxItems\":3,\"type\":\"array\"}},{\"explode\":false,\"in\":\"query\",\"name\":\"tags\",\"schema\":{\"default\":[\"friendly\",\"small\"],\"items\":{\"type\":\"string\"},\"type\":\"array\"}},{\"explode\":false,\"in\":\"query\",\"name\":\"kinds\",\"schema\":{\"items\":{\"$ref\":\"#/components/schemas/Kind\"},\"type\":\"array\"},\"style\":\"pipeDelimited\"},{\"in\":\"header\",\"name\":\"x-flags\",\"schema\":{\"items\":{\"type\":\"boolean\"},\"type\":\"array\"}},{\"explode\":true,\"in\":\"query\",\"name\":\"where\",\"schema\":{\"$ref\":\"#/components/schemas/PetFilter\"},\"style\":\"deepObject\"},{\"explode\":true,\"in\":\"query\",\"name\":\"labels\",\"schema\":{\"additionalProperties\":{\"type\":\"string\"},\"type\":\"object\"},\"style\":\"deepObject\"}],\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"items\":{\"$ref\":\"#/components/schemas/Pet\"},\"type\":\"array\"}}},\"description\":\"ok\"}},\"tags\":[\"pets\"]},\"post\":{\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/NewPet\"}},\"application/x-www-form-urlencoded\":{\"schema\":{\"$ref\":\"#/components/schemas/NewPet\"}}},\"required\":true},\"responses\":{\"201\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Pet\"}}},\"description\":\"created\"}},\"tags\":[\"pets\"]}},\"/pets/{petId}\":{\"patch\":{\"parameters\":[{\"in\":\"path\",\"name\":\"petId\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/PetPatch\"}}},\"required\":true},\"responses\":{\"204\":{\"description\":\"updated\"}},\"tags\":[\"pets\"]}},\"/photos\":{\"post\":{\"requestBody\":{\"content\":{\"multipart/form-data\":{\"schema\":{\"properties\":{\"photo\":{\"format\":\"binary\",\"type\":\"string\"},\"title\":{\"type\":\"string\"}},\"required\":[\"photo\"],\"type\":\"object\"}}},\"required\":true},\"responses\":{\"204\":{\"description\":\"ok\"}},\"tags\":[\"forms\"]}},\"/reports/{id}\":{\"get\":{\"parameters\":[{\"in\":\"path\",\"name\":\"id\",\"required\":true,\"schema\":{\"type\":\"integer\"}}],\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Event\"}},\"application/xml\":{\"schema\":{\"$ref\":\"#/components/schemas/Event\"}}},\"description\":\"the report\"}},\"tags\":[\"reports\"]}},\"/secure/basic\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"basic\":[]}],\"tags\":[\"secure\"]}},\"/secure/bearer\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"bearer\":[]}],\"tags\":[\"secure\"]}},\"/secure/cookie\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"session\":[]}],\"tags\":[\"secure\"]}},\"/secure/header\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"apiKey\":[]}],\"tags\":[\"secure\"]}},\"/secure/mtls\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"mtls\":[]}],\"tags\":[\"secure\"]}},\"/secure/oauth\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"oauth\":[\"pets:read\",\"pets:write\"]}],\"tags\":[\"secure\"]}},\"/secure/oidc\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"oidc\":[\"openid\"]}],\"tags\":[\"secure\"]}},\"/secure/query\":{\"get\":{\"responses\":{\"204\":{\"description\":\"ok\"}},\"security\":[{\"token\":[]}],\"tags\":[\"secure\"]}}}}")
+
+func Spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(spec)
+}
@@ -0,0 +1,77 @@
+package features
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type eventsService struct {
+	closed bool
+}
+
+func (s *eventsService) GetEvents(ctx context.Context, request GetEventsRequest) GetEventsResponse {
+	events := make(chan Event, 2)
+	events <- Event{ID: 1, Text: OptionalOf("first")}
+	events <- Event{ID: 2}
+	close(events)
+
+	return GetEventsResponseBuilder().StatusCode200().TextEventStream().Events(events).Build()
+}
+
+func (s *eventsService) GetDownloadsName(ctx context.Context, request GetDownloadsNameRequest) GetDownloadsNameResponse {
+	body := &closeRecorder{Reader: strings.NewReader("contents of " + request.Path.Name), closed: &s.closed}
+
+	return GetDownloadsNameResponseBuilder().StatusCode200().ApplicationOctetStream().BodyStream(body).Build()
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed *bool
+}
+
+func (c *closeRecorder) Close() error {
+	*c.closed = true
+
+	return nil
+}
+
+func TestServerSentEvents(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/events", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	rec := httptest.NewRecorder()
+	EventsHandler(&eventsService{}, chi.NewRouter(), nil).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "text/event-stream" || rec.Header().Get("Cache-Control") != "no-cache" {
+		t.Fatalf("Expected a 200 event stream, got %d %v", rec.Code, rec.Header())
+	}
+
+	expected := "data: {\"id\":1,\"text\":\"first\"}\n\ndata: {\"id\":2}\n\n"
+	if rec.Body.String() != expected {
+		t.Errorf("Expected the events %q, got %q", expected, rec.Body.String())
+	}
+}
+
+func TestStreamedBody(t *testing.T) {
+	service := &eventsService{}
+	req := httptest.NewRequest(http.MethodGet, "/downloads/report.txt", nil)
+	rec := httptest.NewRecorder()
+	EventsHandler(service, chi.NewRouter(), nil).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/octet-stream" {
+		t.Fatalf("Expected a 200 octet stream, got %d %v", rec.Code, rec.Header())
+	}
+
+	if rec.Body.String() != "contents of report.txt" {
+		t.Errorf("Expected the streamed contents, got %q", rec.Body.String())
+	}
+
+	if !service.closed {
+		t.Error("Expected the streamed body to be closed")
+	}
+}
@@ -0,0 +1,504 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package unions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Animal struct {
+	value interface{}
+}
+
+func AnimalFromCat(value Cat) Animal {
+	return Animal{value: value}
+}
+
+func (union Animal) AsCat() (Cat, bool) {
+	value, ok := union.value.(Cat)
+	return value, ok
+}
+
+func AnimalFromDog(value Dog) Animal {
+	return Animal{value: value}
+}
+
+func (union Animal) AsDog() (Dog, bool) {
+	value, ok := union.value.(Dog)
+	return value, ok
+}
+
+func (union Animal) Value() interface{} {
+	return union.value
+}
+
+func (union Animal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(union.value)
+}
+
+func (union *Animal) UnmarshalJSON(data []byte) error {
+	var discriminator struct {
+		Value string `json:"petType"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return err
+	}
+
+	switch discriminator.Value {
+	case "cat":
+		var value Cat
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		union.value = value
+	case "dog":
+		var value Dog
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		union.value = value
+	default:
+		return fmt.Errorf("unknown petType discriminator value '%s'", discriminator.Value)
+	}
+
+	return nil
+}
+
+func (union Animal) Validate() error {
+	if value, ok := union.value.(validation.Validatable); ok {
+		return value.Validate()
+	}
+
+	return nil
+}
+
+type cat struct {
+	Name    *string `json:"name"`
+	PetType *string `json:"petType"`
+}
+
+type Cat struct {
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+func (body *Cat) UnmarshalJSON(data []byte) error {
+	var value cat
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Name == nil {
+		return NewViolation("", "/name", "required", "is required")
+	}
+
+	body.Name = *value.Name
+
+	if value.PetType == nil {
+		return NewViolation("", "/petType", "required", "is required")
+	}
+
+	body.PetType = *value.PetType
+
+	return nil
+}
+func (body Cat) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Name, validation.Required, validation.RuneLength(1, 0)))
+}
+
+type circle struct {
+	Radius *int `json:"radius"`
+}
+
+type Circle struct {
+	Radius int `json:"radius"`
+}
+
+func (body *Circle) UnmarshalJSON(data []byte) error {
+	var value circle
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Radius == nil {
+		return NewViolation("", "/radius", "required", "is required")
+	}
+
+	body.Radius = *value.Radius
+
+	return nil
+}
+func (body Circle) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Radius, validation.By(Minimum(1, false))))
+}
+
+type dog struct {
+	Bark    *bool   `json:"bark"`
+	PetType *string `json:"petType"`
+}
+
+type Dog struct {
+	Bark    bool   `json:"bark"`
+	PetType string `json:"petType"`
+}
+
+func (body *Dog) UnmarshalJSON(data []byte) error {
+	var value dog
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Bark == nil {
+		return NewViolation("", "/bark", "required", "is required")
+	}
+
+	body.Bark = *value.Bark
+
+	if value.PetType == nil {
+		return NewViolation("", "/petType", "required", "is required")
+	}
+
+	body.PetType = *value.PetType
+
+	return nil
+}
+func (body Dog) Validate() error {
+	return nil
+}
+
+type Shape struct {
+	value interface{}
+}
+
+func ShapeFromCircle(value Circle) Shape {
+	return Shape{value: value}
+}
+
+func (union Shape) AsCircle() (Circle, bool) {
+	value, ok := union.value.(Circle)
+	return value, ok
+}
+
+func ShapeFromSquare(value Square) Shape {
+	return Shape{value: value}
+}
+
+func (union Shape) AsSquare() (Square, bool) {
+	value, ok := union.value.(Square)
+	return value, ok
+}
+
+func (union Shape) Value() interface{} {
+	return union.value
+}
+
+func (union Shape) MarshalJSON() ([]byte, error) {
+	return json.Marshal(union.value)
+}
+
+func (union *Shape) UnmarshalJSON(data []byte) error {
+	if value, err := unmarshalUnionVariant[Circle](data); err == nil {
+		union.value = value
+		return nil
+	}
+
+	if value, err := unmarshalUnionVariant[Square](data); err == nil {
+		union.value = value
+		return nil
+	}
+
+	return errors.New("data does not match any Shape variant")
+}
+
+func (union Shape) Validate() error {
+	if value, ok := union.value.(validation.Validatable); ok {
+		return value.Validate()
+	}
+
+	return nil
+}
+
+type square struct {
+	Side *int `json:"side"`
+}
+
+type Square struct {
+	Side int `json:"side"`
+}
+
+func (body *Square) UnmarshalJSON(data []byte) error {
+	var value square
+	if err := json.Unmarshal(data, &value); err != nil {
+		return unmarshalViolations(data, &value, err)
+	}
+
+	if value.Side == nil {
+		return NewViolation("", "/side", "required", "is required")
+	}
+
+	body.Side = *value.Side
+
+	return nil
+}
+func (body Square) Validate() error {
+	return validation.ValidateStruct(&body,
+		validation.Field(&body.Side, validation.By(Minimum(1, false))))
+}
+
+func unmarshalUnionVariant[T any](data []byte) (T, error) {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return value, err
+	}
+
+	if validatable, ok := any(value).(validation.Validatable); ok {
+		return value, validatable.Validate()
+	}
+
+	return value, nil
+}
+
+// Minimum returns a validation rule checking that a number is no less than threshold, or greater when exclusive, unlike ozzo's Min it checks
+// zero values.
+func Minimum(threshold float64, exclusive bool) validation.RuleFunc {
+	return func(value interface{}) error {
+		value, isNil := validation.Indirect(value)
+		if isNil {
+			return nil
+		}
+
+		number, err := numberOf(value)
+		if err != nil {
+			return err
+		}
+
+		params := map[string]interface{}{"threshold": threshold}
+		switch {
+		case exclusive && number <= threshold:
+			return validation.ErrMinGreaterThanRequired.SetParams(params)
+		case number < threshold:
+			return validation.ErrMinGreaterEqualThanRequired.SetParams(params)
+		}
+
+		return nil
+	}
+}
+
+// numberOf converts an integer or a floating point value for the numeric rules.
+func numberOf(value interface{}) (float64, error) {
+	number, err := validation.ToFloat(value)
+	if err == nil {
+		return number, nil
+	}
+
+	integer, intErr := validation.ToInt(value)
+	if intErr != nil {
+		return 0, err
+	}
+
+	return float64(integer), nil
+}
+
+// Violation is a failed constraint of a request. Pointer is the JSON pointer of the failed value in its location,
+// the body, query, header, path or cookie, and Keyword is the failed JSON Schema keyword.
+type Violation struct {
+	Pointer  string `json:"pointer"`
+	Location string `json:"location"`
+	Keyword  string `json:"keyword,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ValidationError lists the violations found while parsing and validating a request.
+type ValidationError struct {
+	Violations []Violation `json:"violations"`
+}
+
+var violationKeywords = map[string]string{
+	"validation_in_invalid":                      "enum",
+	"validation_is_dns_name":                     "format",
+	"validation_is_email":                        "format",
+	"validation_is_ipv4":                         "format",
+	"validation_is_request_url":                  "format",
+	"validation_length_too_long":                 "maxLength",
+	"validation_length_too_short":                "minLength",
+	"validation_match_invalid":                   "pattern",
+	"validation_max_items":                       "maxItems",
+	"validation_max_less_equal_than_required":    "maximum",
+	"validation_max_less_than_required":          "exclusiveMaximum",
+	"validation_max_properties":                  "maxProperties",
+	"validation_min_greater_equal_than_required": "minimum",
+	"validation_min_greater_than_required":       "exclusiveMinimum",
+	"validation_min_items":                       "minItems",
+	"validation_min_properties":                  "minProperties",
+	"validation_multiple_of_invalid":             "multipleOf",
+	"validation_nil_or_not_empty_required":       "required",
+	"validation_not_nil_required":                "required",
+	"validation_required":                        "required",
+	"validation_unique_items":                    "uniqueItems",
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func NewViolation(location, pointer, keyword, message string) ValidationError {
+	return ValidationError{Violations: []Violation{Violation{
+		Keyword:  keyword,
+		Location: location,
+		Message:  message,
+		Pointer:  pointer,
+	}}}
+}
+
+func (err ValidationError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		if violation.Pointer == "" {
+			messages = append(messages, violation.Message)
+			continue
+		}
+
+		messages = append(messages, violation.Pointer+": "+violation.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// ValidationErrorOf converts an error of a parser or a validator found in location into a ValidationError,
+// the violations of ozzo errors, encoding/json type errors and nested ValidationErrors are prefixed with pointer.
+// A nil err has no violations.
+func ValidationErrorOf(location, pointer string, err error) ValidationError {
+	if err == nil {
+		return ValidationError{}
+	}
+
+	var (
+		result     ValidationError
+		violations ValidationError
+		errs       validation.Errors
+		ruleErr    validation.Error
+		typeErr    *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &violations):
+		for _, violation := range violations.Violations {
+			violation.Pointer = pointer + violation.Pointer
+			if violation.Location == "" {
+				violation.Location = location
+			}
+			result.Violations = append(result.Violations, violation)
+		}
+	case errors.As(err, &errs):
+		keys := make([]string, 0, len(errs))
+		for key := range errs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			result.Violations = append(result.Violations, ValidationErrorOf(location, pointer+"/"+pointerEscaper.Replace(key), errs[key]).Violations...)
+		}
+	case errors.As(err, &ruleErr):
+		keyword, ok := violationKeywords[ruleErr.Code()]
+		if !ok {
+			keyword = ruleErr.Code()
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  keyword,
+			Location: location,
+			Message:  ruleErr.Error(),
+			Pointer:  pointer,
+		})
+	case errors.As(err, &typeErr):
+		if typeErr.Field != "" {
+			pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "type",
+			Location: location,
+			Message:  fmt.Sprintf("cannot unmarshal %s into %s", typeErr.Value, typeErr.Type),
+			Pointer:  pointer,
+		})
+	default:
+		result.Violations = append(result.Violations, Violation{
+			Keyword:  "",
+			Location: location,
+			Message:  err.Error(),
+			Pointer:  pointer,
+		})
+	}
+
+	return result
+}
+
+// unmarshalViolations locates the properties of data failing to unmarshal into the fields of the unmarshal helper
+// target, so the violations of nested components point at them. It is called once unmarshalling has failed.
+func unmarshalViolations(data []byte, target interface{}, err error) error {
+	var properties map[string]json.RawMessage
+	if json.Unmarshal(data, &properties) != nil {
+		return ValidationErrorOf("", "", err)
+	}
+
+	var result ValidationError
+	fields := reflect.TypeOf(target).Elem()
+	for i := 0; i < fields.NumField(); i++ {
+		name, _, _ := strings.Cut(fields.Field(i).Tag.Get("json"), ",")
+		if property, ok := properties[name]; ok {
+			result.Violations = append(result.Violations, propertyViolations("/"+pointerEscaper.Replace(name), property, fields.Field(i).Type)...)
+		}
+	}
+
+	if len(result.Violations) == 0 {
+		return ValidationErrorOf("", "", err)
+	}
+
+	return result
+}
+
+// propertyViolations unmarshals a property on its own, the items of failing arrays and maps are unmarshalled one by one.
+func propertyViolations(pointer string, data []byte, typ reflect.Type) []Violation {
+	err := json.Unmarshal(data, reflect.New(typ).Interface())
+	if err == nil {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var violations []Violation
+	switch typ.Kind() {
+	case reflect.Slice:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for i, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+strconv.Itoa(i), item, typ.Elem())...)
+			}
+		}
+	case reflect.Map:
+		var items map[string]json.RawMessage
+		if json.Unmarshal(data, &items) == nil {
+			for key, item := range items {
+				violations = append(violations, propertyViolations(pointer+"/"+pointerEscaper.Replace(key), item, typ.Elem())...)
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return ValidationErrorOf("", pointer, err).Violations
+	}
+
+	return violations
+}
@@ -0,0 +1,4 @@
+// Package unions tests the tagged unions generated for oneOf and anyOf schemas.
+package unions
+
+//go:generate go-oas3 -swagger-addr openapi.yaml -package unions -path .
@@ -0,0 +1,12 @@
+// This file is generated by github.com/mikekonan/go-oas3. DO NOT EDIT.
+
+package unions
+
+import "net/http"
+
+var spec = []byte("{\"components\":{\"schemas\":{\"Animal\":{\"discriminator\":{\"mapping\":{\"cat\":\"#/components/schemas/Cat\",\"dog\":\"#/components/schemas/Dog\"},\"propertyName\":\"petType\"},\"oneOf\":[{\"$ref\":\"#/components/schemas/Cat\"},{\"$ref\":\"#/components/schemas/Dog\"}]},\"Cat\":{\"properties\":{\"name\":{\"minLength\":1,\"type\":\"string\"},\"petType\":{\"type\":\"string\"}},\"required\":[\"petType\",\"name\"],\"type\":\"object\"},\"Circle\":{\"properties\":{\"radius\":{\"minimum\":1,\"type\":\"integer\"}},\"required\":[\"radius\"],\"type\":\"object\"},\"Dog\":{\"properties\":{\"bark\":{\"type\":\"boolean\"},\"petType\":{\"type\":\"string\"}},\"required\":[\"petType\",\"bark\"],\"type\":\"object\"},\"Shape\":{\"anyOf\":[{\"$ref\":\"#/components/schemas/Circle\"},{\"$ref\":\"#/components/schemas/Square\"}]},\"Square\":{\"properties\":{\"side\":{\"minimum\":1,\"type\":\"integer\"}},\"required\":[\"side\"],\"type\":\"object\"}}},\"info\":{\"description\":\"oneOf schemas picked by a discriminator and anyOf schemas picked by the first matching variant\",\"title\":\"Unions\",\"version\":\"1.0.0\"},\"openapi\":\"3.0.3\",\"paths\":{\"/animals\":{\"post\":{\"requestBody\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Animal\"}}},\"required\":true},\"responses\":{\"200\":{\"content\":{\"application/json\":{\"schema\":{\"$ref\":\"#/components/schemas/Shape\"}}},\"description\":\"the footprint of the animal\"}},\"tags\":[\"animals\"]}}}}")
+
+func Spec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(spec)
+}
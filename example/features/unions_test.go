@@ -0,0 +1,176 @@
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type animalsService struct {
+	animal   Animal
+	employee Employee
+	result   RequestProcessingResult
+}
+
+func (s *animalsService) PostAnimals(ctx context.Context, request PostAnimalsRequest) PostAnimalsResponse {
+	s.animal, s.result = request.Body, request.ProcessingResult
+
+	shape := ShapeFromCircle(Circle{Radius: 1})
+	if dog, ok := request.Body.AsDog(); ok && dog.Bark {
+		shape = ShapeFromSquare(Square{Side: 2})
+	}
+
+	return PostAnimalsResponseBuilder().StatusCode200().ApplicationJson().Body(shape).Build()
+}
+
+func (s *animalsService) PostEmployees(ctx context.Context, request PostEmployeesRequest) PostEmployeesResponse {
+	s.employee, s.result = request.Body, request.ProcessingResult
+
+	return PostEmployeesResponseBuilder().StatusCode201().ApplicationJson().Body(request.Body).Build()
+}
+
+func TestOneOfDiscriminator(t *testing.T) {
+	tests := []struct {
+		name        string
+		jsonInput   string
+		expectError bool
+		expectation func(*testing.T, Animal)
+	}{
+		{
+			name:      "Cat is picked by the petType discriminator",
+			jsonInput: `{"petType": "cat", "name": "Tom"}`,
+			expectation: func(t *testing.T, animal Animal) {
+				cat, ok := animal.AsCat()
+				if !ok || cat.Name != "Tom" {
+					t.Errorf("Expected cat Tom, got %#v", animal.Value())
+				}
+				if _, ok := animal.AsDog(); ok {
+					t.Error("Expected the animal not to be a dog")
+				}
+			},
+		},
+		{
+			name:      "Dog is picked by the petType discriminator",
+			jsonInput: `{"petType": "dog", "bark": true}`,
+			expectation: func(t *testing.T, animal Animal) {
+				dog, ok := animal.AsDog()
+				if !ok || !dog.Bark {
+					t.Errorf("Expected a barking dog, got %#v", animal.Value())
+				}
+			},
+		},
+		{
+			name:        "Unknown discriminator value should error",
+			jsonInput:   `{"petType": "cow"}`,
+			expectError: true,
+		},
+		{
+			name:        "Required property of the variant should error",
+			jsonInput:   `{"petType": "dog"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var animal Animal
+			err := json.Unmarshal([]byte(tt.jsonInput), &animal)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected an error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			tt.expectation(t, animal)
+		})
+	}
+}
+
+func TestOneOfValidatesTheVariant(t *testing.T) {
+	if err := AnimalFromCat(Cat{PetType: "cat", Name: "Tom"}).Validate(); err != nil {
+		t.Errorf("Expected a valid cat, got: %v", err)
+	}
+
+	if err := AnimalFromCat(Cat{PetType: "cat"}).Validate(); err == nil {
+		t.Error("Expected a cat without a name to fail validation")
+	}
+}
+
+func TestOneOfMarshalsTheVariant(t *testing.T) {
+	data, err := json.Marshal(AnimalFromDog(Dog{PetType: "dog", Bark: true}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if string(data) != `{"bark":true,"petType":"dog"}` {
+		t.Errorf("Expected the dog to be marshalled as is, got %s", data)
+	}
+}
+
+func TestAnyOfPicksTheFirstMatchingVariant(t *testing.T) {
+	tests := []struct {
+		name       string
+		jsonInput  string
+		expectSide int
+		expectErr  bool
+	}{
+		{name: "Circle", jsonInput: `{"radius": 3}`},
+		{name: "Square", jsonInput: `{"side": 4}`, expectSide: 4},
+		{name: "Neither", jsonInput: `{"corners": 4}`, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var shape Shape
+			err := json.Unmarshal([]byte(tt.jsonInput), &shape)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error but got %#v", shape.Value())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if square, ok := shape.AsSquare(); ok != (tt.expectSide != 0) || square.Side != tt.expectSide {
+				t.Errorf("Expected side %d, got %#v", tt.expectSide, shape.Value())
+			}
+		})
+	}
+}
+
+func TestUnionBodies(t *testing.T) {
+	service := &animalsService{}
+	handler := AnimalsHandler(service, chi.NewRouter(), nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/animals", strings.NewReader(`{"petType": "dog", "bark": true}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if err := service.result.Err(); err != nil {
+		t.Fatalf("Unexpected processing error: %v", err)
+	}
+
+	if _, ok := service.animal.AsDog(); !ok {
+		t.Errorf("Expected the service to receive a dog, got %#v", service.animal.Value())
+	}
+
+	if rec.Code != http.StatusOK || strings.TrimSpace(rec.Body.String()) != `{"side":2}` {
+		t.Errorf("Expected 200 with a square, got %d %s", rec.Code, rec.Body.String())
+	}
+}
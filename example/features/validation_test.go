@@ -0,0 +1,140 @@
+package features
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestNewPetValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		pet           NewPet
+		expectPointer string
+		expectKeyword string
+	}{
+		{
+			name: "Valid pet",
+			pet:  NewPet{Name: "Rex", Tags: OptionalOf([]string{"big", "calm"}), Traits: OptionalOf(map[string]string{"size": "big"})},
+		},
+		{
+			name:          "Name above maxLength",
+			pet:           NewPet{Name: strings.Repeat("x", 21)},
+			expectPointer: "/name",
+			expectKeyword: "maxLength",
+		},
+		{
+			name:          "Tags above maxItems",
+			pet:           NewPet{Name: "Rex", Tags: OptionalOf([]string{"a", "b", "c", "d"})},
+			expectPointer: "/tags",
+			expectKeyword: "maxItems",
+		},
+		{
+			name:          "Tags not unique",
+			pet:           NewPet{Name: "Rex", Tags: OptionalOf([]string{"a", "a"})},
+			expectPointer: "/tags",
+			expectKeyword: "uniqueItems",
+		},
+		{
+			name:          "Tag not matching its pattern",
+			pet:           NewPet{Name: "Rex", Tags: OptionalOf([]string{"Big"})},
+			expectPointer: "/tags/0",
+			expectKeyword: "pattern",
+		},
+		{
+			name:          "Empty trait",
+			pet:           NewPet{Name: "Rex", Traits: OptionalOf(map[string]string{"size": ""})},
+			expectPointer: "/traits/size",
+			expectKeyword: "required",
+		},
+		{
+			name:          "Trait not matching its pattern",
+			pet:           NewPet{Name: "Rex", Traits: OptionalOf(map[string]string{"size": "Big"})},
+			expectPointer: "/traits/size",
+			expectKeyword: "pattern",
+		},
+		{
+			name:          "Traits above maxProperties",
+			pet:           NewPet{Name: "Rex", Traits: OptionalOf(map[string]string{"a": "x", "b": "x", "c": "x", "d": "x"})},
+			expectPointer: "/traits",
+			expectKeyword: "maxProperties",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pet.Validate()
+
+			if tt.expectPointer == "" {
+				if err != nil {
+					t.Errorf("Expected no validation error, got: %v", err)
+				}
+				return
+			}
+
+			violations := ValidationErrorOf("body", "", err).Violations
+			if len(violations) != 1 {
+				t.Fatalf("Expected a single violation, got: %+v", violations)
+			}
+
+			if violations[0].Pointer != tt.expectPointer || violations[0].Keyword != tt.expectKeyword || violations[0].Location != "body" {
+				t.Errorf("Expected %s at body %s, got %+v", tt.expectKeyword, tt.expectPointer, violations[0])
+			}
+		})
+	}
+}
+
+func TestValidationErrorOfNil(t *testing.T) {
+	if violations := ValidationErrorOf("body", "", nil).Violations; len(violations) != 0 {
+		t.Errorf("Expected no violations for a nil error, got %+v", violations)
+	}
+}
+
+func TestRequestViolations(t *testing.T) {
+	tests := []struct {
+		name          string
+		jsonInput     string
+		expectType    requestProcessingResultType
+		expectPointer string
+	}{
+		{
+			name:          "Missing required property",
+			jsonInput:     `{"nick": "rexy"}`,
+			expectType:    BodyUnmarshalFailed,
+			expectPointer: "/name",
+		},
+		{
+			name:          "Property of the wrong type",
+			jsonInput:     `{"name": "Rex", "legs": "four"}`,
+			expectType:    BodyUnmarshalFailed,
+			expectPointer: "/legs",
+		},
+		{
+			name:          "Property failing validation",
+			jsonInput:     `{"name": ""}`,
+			expectType:    BodyValidationFailed,
+			expectPointer: "/name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &petsService{}
+			req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(tt.jsonInput))
+			req.Header.Set("Content-Type", "application/json")
+			PetsHandler(service, chi.NewRouter(), nil).ServeHTTP(httptest.NewRecorder(), req)
+
+			if service.result.Type() != tt.expectType {
+				t.Fatalf("Expected result type %d, got %d: %v", tt.expectType, service.result.Type(), service.result.Err())
+			}
+
+			violations, ok := service.result.ValidationError()
+			if !ok || len(violations.Violations) != 1 || violations.Violations[0].Pointer != tt.expectPointer {
+				t.Errorf("Expected a single violation at %s, got %+v", tt.expectPointer, violations)
+			}
+		})
+	}
+}
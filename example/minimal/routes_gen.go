@@ -4,6 +4,7 @@ package minimal
 
 import (
 	"context"
+	"errors"
 	chi "github.com/go-chi/chi/v5"
 	"net/http"
 	"slices"
@@ -18,14 +19,17 @@ type Hooks struct {
 	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
 	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
 	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
 	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
 	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
 	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
 	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
 	RequestBodyUnmarshalCompleted func(*http.Request, string)
 	RequestHeaderParseCompleted   func(*http.Request, string)
 	RequestPathParseCompleted     func(*http.Request, string)
 	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
 	RequestParseCompleted         func(*http.Request, string)
 	RequestProcessingCompleted    func(*http.Request, string)
 	RequestRedirectStarted        func(*http.Request, string, string)
@@ -50,6 +54,10 @@ const (
 	SecurityParseFailed
 	SecurityCheckFailed
 	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
 )
 
 type RequestProcessingResult struct {
@@ -72,6 +80,13 @@ func (r RequestProcessingResult) Err() error {
 	return r.error
 }
 
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
 func DefaultHandler(impl DefaultService, r chi.Router, hooks *Hooks) http.Handler {
 	if hooks == nil {
 		hooks = &Hooks{}
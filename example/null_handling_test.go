@@ -19,14 +19,14 @@ func TestNullHandling(t *testing.T) {
 			name:        "required field null",
 			json:        `{"description": null, "title": "test title", "regexParam": "123"}`,
 			expectError: true,
-			errorMsg:    "field 'Description' is required but was null or missing",
+			errorMsg:    "/description: is required",
 			description: "Required field set to null should produce descriptive error",
 		},
 		{
 			name:        "required field missing",
 			json:        `{"title": "test title", "regexParam": "123"}`,
 			expectError: true,
-			errorMsg:    "field 'Description' is required but was null or missing",
+			errorMsg:    "/description: is required",
 			description: "Missing required field should produce descriptive error",
 		},
 		{
@@ -81,9 +81,9 @@ func TestNullHandling(t *testing.T) {
 		{
 			name:        "regex field validation failure",
 			json:        `{"description": "test description", "regexParam": "invalid"}`,
-			expectError: false, // UnmarshalJSON should succeed
-			expectValid: false, // but Validate() should fail
-			description: "Field not matching regex should pass UnmarshalJSON but fail validation",
+			expectError: true,
+			errorMsg:    "/regexParam: not matched by the '^[.?\\d]+$' regex",
+			description: "Field not matching regex should produce a pattern violation",
 		},
 		{
 			name:        "regex field validation success",
@@ -142,14 +142,14 @@ func TestUpdateTransactionNullHandling(t *testing.T) {
 			name:        "required description field null",
 			json:        `{"description": null, "title": "test title"}`,
 			expectError: true,
-			errorMsg:    "field 'Description' is required but was null or missing",
+			errorMsg:    "/description: is required",
 			description: "Required field set to null should produce descriptive error",
 		},
 		{
 			name:        "required description field missing",
 			json:        `{"title": "test title"}`,
 			expectError: true,
-			errorMsg:    "field 'Description' is required but was null or missing",
+			errorMsg:    "/description: is required",
 			description: "Missing required field should produce descriptive error",
 		},
 		{
@@ -11,6 +11,7 @@ import (
 	chi "github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	cast "github.com/spf13/cast"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"regexp"
@@ -31,14 +32,17 @@ type Hooks struct {
 	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
 	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
 	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
 	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
 	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
 	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
 	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
 	RequestBodyUnmarshalCompleted func(*http.Request, string)
 	RequestHeaderParseCompleted   func(*http.Request, string)
 	RequestPathParseCompleted     func(*http.Request, string)
 	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
 	RequestParseCompleted         func(*http.Request, string)
 	RequestProcessingCompleted    func(*http.Request, string)
 	RequestRedirectStarted        func(*http.Request, string, string)
@@ -63,6 +67,10 @@ const (
 	SecurityParseFailed
 	SecurityCheckFailed
 	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
 )
 
 type RequestProcessingResult struct {
@@ -85,6 +93,13 @@ func (r RequestProcessingResult) Err() error {
 	return r.error
 }
 
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
 func AuthHandler(impl AuthService, r chi.Router, hooks *Hooks, securitySchemas SecuritySchemas) http.Handler {
 	if hooks == nil {
 		hooks = &Hooks{}
@@ -668,9 +683,9 @@ func (router *callbacksRouter) parsePostCallbacksCallbackTypeRequest(r *http.Req
 
 	pathCallbackType := chi.URLParam(r, "callbackType")
 	if pathCallbackType == "" {
-		err := fmt.Errorf("callbackType is empty")
+		err := errors.New("is empty")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: PathParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/callbackType", "required", err.Error()), typee: PathParseFailed}
 		if router.hooks.RequestPathParseFailed != nil {
 			router.hooks.RequestPathParseFailed(r, "PostCallbacksCallbackType", "callbackType", request.ProcessingResult)
 		}
@@ -681,7 +696,7 @@ func (router *callbacksRouter) parsePostCallbacksCallbackTypeRequest(r *http.Req
 	request.Path.CallbackType = pathCallbackType
 
 	if err := request.Path.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: PathValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
 		if router.hooks.RequestPathValidationFailed != nil {
 			router.hooks.RequestPathValidationFailed(r, "PostCallbacksCallbackType", request.ProcessingResult)
 		}
@@ -697,7 +712,7 @@ func (router *callbacksRouter) parsePostCallbacksCallbackTypeRequest(r *http.Req
 	if queryHasSmthStr != "" {
 		queryHasSmth, err := strconv.ParseBool(queryHasSmthStr)
 		if err != nil {
-			request.ProcessingResult = RequestProcessingResult{error: err, typee: QueryParseFailed}
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/hasSmth", "format", err.Error()), typee: QueryParseFailed}
 			if router.hooks.RequestQueryParseFailed != nil {
 				router.hooks.RequestQueryParseFailed(r, "PostCallbacksCallbackType", "hasSmth", request.ProcessingResult)
 			}
@@ -709,7 +724,7 @@ func (router *callbacksRouter) parsePostCallbacksCallbackTypeRequest(r *http.Req
 	}
 
 	if err := request.Query.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: QueryValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("query", "", err), typee: QueryValidationFailed}
 		if router.hooks.RequestQueryValidationFailed != nil {
 			router.hooks.RequestQueryValidationFailed(r, "PostCallbacksCallbackType", request.ProcessingResult)
 		}
@@ -736,11 +751,9 @@ func (router *callbacksRouter) parsePostCallbacksCallbackTypeRequest(r *http.Req
 		}
 	}
 	if decodeErr != nil {
-		request.ProcessingResult = RequestProcessingResult{error: decodeErr, typee: BodyUnmarshalFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
 		if router.hooks.RequestBodyUnmarshalFailed != nil {
 			router.hooks.RequestBodyUnmarshalFailed(r, "PostCallbacksCallbackType", request.ProcessingResult)
-
-			return
 		}
 
 		return
@@ -797,6 +810,27 @@ func (router *callbacksRouter) PostCallbacksCallbackType(w http.ResponseWriter,
 
 	w.WriteHeader(response.statusCode())
 
+	if stream := response.bodyStream(); stream != nil {
+		if closer, ok := stream.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		count, err := io.Copy(w, contextReader{ctx: r.Context(), reader: stream})
+		if err != nil {
+			if router.hooks.ResponseBodyWriteFailed != nil {
+				router.hooks.ResponseBodyWriteFailed(r, "PostCallbacksCallbackType", int(count), err)
+			}
+		} else if router.hooks.ResponseBodyWriteCompleted != nil {
+			router.hooks.ResponseBodyWriteCompleted(r, "PostCallbacksCallbackType", int(count))
+		}
+
+		if router.hooks.ServiceCompleted != nil {
+			router.hooks.ServiceCompleted(r, "PostCallbacksCallbackType")
+		}
+
+		return
+	}
+
 	var body []byte
 
 	if response.body() != nil {
@@ -903,9 +937,9 @@ func (router *transactionsRouter) parsePostTransactionRequest(r *http.Request) (
 
 	headerXFingerprint := r.Header.Get("x-fingerprint")
 	if headerXFingerprint == "" {
-		err := fmt.Errorf("x-fingerprint is empty")
+		err := errors.New("is empty")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-fingerprint", "required", err.Error()), typee: HeaderParseFailed}
 		if router.hooks.RequestHeaderParseFailed != nil {
 			router.hooks.RequestHeaderParseFailed(r, "PostTransaction", "x-fingerprint", request.ProcessingResult)
 		}
@@ -914,9 +948,9 @@ func (router *transactionsRouter) parsePostTransactionRequest(r *http.Request) (
 	}
 
 	if !xFingerprintRegex.MatchString(headerXFingerprint) {
-		err := fmt.Errorf("x-fingerprint not matched by the '[0-9a-fA-F]+' regex")
+		err := errors.New("not matched by the '[0-9a-fA-F]+' regex")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-fingerprint", "pattern", err.Error()), typee: HeaderParseFailed}
 		if router.hooks.RequestHeaderParseFailed != nil {
 			router.hooks.RequestHeaderParseFailed(r, "PostTransaction", "x-fingerprint", request.ProcessingResult)
 		}
@@ -927,7 +961,7 @@ func (router *transactionsRouter) parsePostTransactionRequest(r *http.Request) (
 	request.Header.XFingerprint = headerXFingerprint
 
 	if err := request.Header.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("header", "", err), typee: HeaderValidationFailed}
 		if router.hooks.RequestHeaderValidationFailed != nil {
 			router.hooks.RequestHeaderValidationFailed(r, "PostTransaction", request.ProcessingResult)
 		}
@@ -945,11 +979,9 @@ func (router *transactionsRouter) parsePostTransactionRequest(r *http.Request) (
 	)
 	decodeErr = json.NewDecoder(r.Body).Decode(&body)
 	if decodeErr != nil {
-		request.ProcessingResult = RequestProcessingResult{error: decodeErr, typee: BodyUnmarshalFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
 		if router.hooks.RequestBodyUnmarshalFailed != nil {
 			router.hooks.RequestBodyUnmarshalFailed(r, "PostTransaction", request.ProcessingResult)
-
-			return
 		}
 
 		return
@@ -962,7 +994,7 @@ func (router *transactionsRouter) parsePostTransactionRequest(r *http.Request) (
 	}
 
 	if err := request.Body.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: BodyValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
 		if router.hooks.RequestBodyValidationFailed != nil {
 			router.hooks.RequestBodyValidationFailed(r, "PostTransaction", request.ProcessingResult)
 		}
@@ -1083,9 +1115,9 @@ func (router *transactionsRouter) parsePutTransactionRequest(r *http.Request) (r
 
 	headerXFingerprint := r.Header.Get("x-fingerprint")
 	if headerXFingerprint == "" {
-		err := fmt.Errorf("x-fingerprint is empty")
+		err := errors.New("is empty")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-fingerprint", "required", err.Error()), typee: HeaderParseFailed}
 		if router.hooks.RequestHeaderParseFailed != nil {
 			router.hooks.RequestHeaderParseFailed(r, "PutTransaction", "x-fingerprint", request.ProcessingResult)
 		}
@@ -1094,9 +1126,9 @@ func (router *transactionsRouter) parsePutTransactionRequest(r *http.Request) (r
 	}
 
 	if !xFingerprintRegex.MatchString(headerXFingerprint) {
-		err := fmt.Errorf("x-fingerprint not matched by the '[0-9a-fA-F]+' regex")
+		err := errors.New("not matched by the '[0-9a-fA-F]+' regex")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-fingerprint", "pattern", err.Error()), typee: HeaderParseFailed}
 		if router.hooks.RequestHeaderParseFailed != nil {
 			router.hooks.RequestHeaderParseFailed(r, "PutTransaction", "x-fingerprint", request.ProcessingResult)
 		}
@@ -1107,7 +1139,7 @@ func (router *transactionsRouter) parsePutTransactionRequest(r *http.Request) (r
 	request.Header.XFingerprint = headerXFingerprint
 
 	if err := request.Header.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("header", "", err), typee: HeaderValidationFailed}
 		if router.hooks.RequestHeaderValidationFailed != nil {
 			router.hooks.RequestHeaderValidationFailed(r, "PutTransaction", request.ProcessingResult)
 		}
@@ -1125,11 +1157,9 @@ func (router *transactionsRouter) parsePutTransactionRequest(r *http.Request) (r
 	)
 	decodeErr = json.NewDecoder(r.Body).Decode(&body)
 	if decodeErr != nil {
-		request.ProcessingResult = RequestProcessingResult{error: decodeErr, typee: BodyUnmarshalFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
 		if router.hooks.RequestBodyUnmarshalFailed != nil {
 			router.hooks.RequestBodyUnmarshalFailed(r, "PutTransaction", request.ProcessingResult)
-
-			return
 		}
 
 		return
@@ -1309,9 +1339,9 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 
 	headerXFingerprint := r.Header.Get("x-fingerprint")
 	if headerXFingerprint == "" {
-		err := fmt.Errorf("x-fingerprint is empty")
+		err := errors.New("is empty")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-fingerprint", "required", err.Error()), typee: HeaderParseFailed}
 		if router.hooks.RequestHeaderParseFailed != nil {
 			router.hooks.RequestHeaderParseFailed(r, "DeleteTransactionsUUID", "x-fingerprint", request.ProcessingResult)
 		}
@@ -1320,9 +1350,9 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 	}
 
 	if !xFingerprintRegex.MatchString(headerXFingerprint) {
-		err := fmt.Errorf("x-fingerprint not matched by the '[0-9a-fA-F]+' regex")
+		err := errors.New("not matched by the '[0-9a-fA-F]+' regex")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("header", "/x-fingerprint", "pattern", err.Error()), typee: HeaderParseFailed}
 		if router.hooks.RequestHeaderParseFailed != nil {
 			router.hooks.RequestHeaderParseFailed(r, "DeleteTransactionsUUID", "x-fingerprint", request.ProcessingResult)
 		}
@@ -1333,7 +1363,7 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 	request.Header.XFingerprint = headerXFingerprint
 
 	if err := request.Header.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: HeaderValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("header", "", err), typee: HeaderValidationFailed}
 		if router.hooks.RequestHeaderValidationFailed != nil {
 			router.hooks.RequestHeaderValidationFailed(r, "DeleteTransactionsUUID", request.ProcessingResult)
 		}
@@ -1347,9 +1377,9 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 
 	pathUUID := chi.URLParam(r, "uuid")
 	if pathUUID == "" {
-		err := fmt.Errorf("uuid is empty")
+		err := errors.New("is empty")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: PathParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/uuid", "required", err.Error()), typee: PathParseFailed}
 		if router.hooks.RequestPathParseFailed != nil {
 			router.hooks.RequestPathParseFailed(r, "DeleteTransactionsUUID", "uuid", request.ProcessingResult)
 		}
@@ -1361,9 +1391,9 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 
 	pathRegexParam := chi.URLParam(r, "regexParam")
 	if pathRegexParam == "" {
-		err := fmt.Errorf("regexParam is empty")
+		err := errors.New("is empty")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: PathParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/regexParam", "required", err.Error()), typee: PathParseFailed}
 		if router.hooks.RequestPathParseFailed != nil {
 			router.hooks.RequestPathParseFailed(r, "DeleteTransactionsUUID", "regexParam", request.ProcessingResult)
 		}
@@ -1372,9 +1402,9 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 	}
 
 	if !regexParamRegex.MatchString(pathRegexParam) {
-		err := fmt.Errorf("regexParam not matched by the '^[.?\\d]+$' regex")
+		err := errors.New("not matched by the '^[.?\\d]+$' regex")
 
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: PathParseFailed}
+		request.ProcessingResult = RequestProcessingResult{error: NewViolation("path", "/regexParam", "pattern", err.Error()), typee: PathParseFailed}
 		if router.hooks.RequestPathParseFailed != nil {
 			router.hooks.RequestPathParseFailed(r, "DeleteTransactionsUUID", "regexParam", request.ProcessingResult)
 		}
@@ -1385,7 +1415,7 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 	request.Path.RegexParam = pathRegexParam
 
 	if err := request.Path.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: PathValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("path", "", err), typee: PathValidationFailed}
 		if router.hooks.RequestPathValidationFailed != nil {
 			router.hooks.RequestPathValidationFailed(r, "DeleteTransactionsUUID", request.ProcessingResult)
 		}
@@ -1401,7 +1431,7 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 	if queryTimeParamStr != "" {
 		queryTimeParam, err := cast.ToTimeE(queryTimeParamStr)
 		if err != nil {
-			request.ProcessingResult = RequestProcessingResult{error: err, typee: QueryParseFailed}
+			request.ProcessingResult = RequestProcessingResult{error: NewViolation("query", "/timeParam", "format", err.Error()), typee: QueryParseFailed}
 			if router.hooks.RequestQueryParseFailed != nil {
 				router.hooks.RequestQueryParseFailed(r, "DeleteTransactionsUUID", "timeParam", request.ProcessingResult)
 			}
@@ -1413,7 +1443,7 @@ func (router *transactionsRouter) parseDeleteTransactionsUUIDRequest(r *http.Req
 	}
 
 	if err := request.Query.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: QueryValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("query", "", err), typee: QueryValidationFailed}
 		if router.hooks.RequestQueryValidationFailed != nil {
 			router.hooks.RequestQueryValidationFailed(r, "DeleteTransactionsUUID", request.ProcessingResult)
 		}
@@ -1530,10 +1560,25 @@ func (router *transactionsRouter) DeleteTransactionsUUID(w http.ResponseWriter,
 	}
 }
 
+// contextReader stops reading a streamed body once the request is canceled.
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (reader contextReader) Read(p []byte) (int, error) {
+	if err := reader.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return reader.reader.Read(p)
+}
+
 type response struct {
 	statusCode  int
 	body        interface{}
 	bodyRaw     []byte
+	bodyStream  io.Reader
 	contentType string
 	redirectURL string
 	headers     map[string]string
@@ -1544,6 +1589,7 @@ type responseInterface interface {
 	statusCode() int
 	body() interface{}
 	bodyRaw() []byte
+	bodyStream() io.Reader
 	contentType() string
 	redirectURL() string
 	cookies() []http.Cookie
@@ -1573,6 +1619,10 @@ func (response postBearerEndpointResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response postBearerEndpointResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response postBearerEndpointResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1612,6 +1662,10 @@ func (response postCallbacksCallbackTypeResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response postCallbacksCallbackTypeResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response postCallbacksCallbackTypeResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1651,6 +1705,10 @@ func (response getSecureEndpointResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response getSecureEndpointResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response getSecureEndpointResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1690,6 +1748,10 @@ func (response getSemiSecureEndpointResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response getSemiSecureEndpointResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response getSemiSecureEndpointResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1729,6 +1791,10 @@ func (response postTransactionResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response postTransactionResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response postTransactionResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1768,6 +1834,10 @@ func (response putTransactionResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response putTransactionResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response putTransactionResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1807,6 +1877,10 @@ func (response deleteTransactionsUUIDResponse) bodyRaw() []byte {
 	return response.response.bodyRaw
 }
 
+func (response deleteTransactionsUUIDResponse) bodyStream() io.Reader {
+	return response.response.bodyStream
+}
+
 func (response deleteTransactionsUUIDResponse) contentType() string {
 	return response.response.contentType
 }
@@ -1980,6 +2054,13 @@ func (builder *postCallbacksCallbackType200ApplicationOctetStreamBodyBuilder) Bo
 	return &PostCallbacksCallbackType200ApplicationOctetStreamResponseBuilder{response: builder.response}
 }
 
+// BodyStream copies body into the response, an io.Closer body is closed after it is sent.
+func (builder *postCallbacksCallbackType200ApplicationOctetStreamBodyBuilder) BodyStream(body io.Reader) *PostCallbacksCallbackType200ApplicationOctetStreamResponseBuilder {
+	builder.response.bodyStream = body
+
+	return &PostCallbacksCallbackType200ApplicationOctetStreamResponseBuilder{response: builder.response}
+}
+
 func (builder *postCallbacksCallbackTypeStatusCodeResponseBuilder) StatusCode307(redirectURL string) *postCallbacksCallbackType307HeadersBuilder {
 	builder.response.statusCode = 307
 	builder.response.redirectURL = redirectURL
@@ -2613,7 +2694,7 @@ type GetSemiSecureEndpointRequest struct {
 }
 
 type PostCallbacksCallbackTypeRequestPath struct {
-	CallbackType string
+	CallbackType string `json:"callbackType"`
 }
 
 func (path PostCallbacksCallbackTypeRequestPath) GetCallbackType() string {
@@ -2625,7 +2706,7 @@ func (path PostCallbacksCallbackTypeRequestPath) Validate() error {
 }
 
 type PostCallbacksCallbackTypeRequestQuery struct {
-	HasSmth bool
+	HasSmth bool `json:"hasSmth"`
 }
 
 func (query PostCallbacksCallbackTypeRequestQuery) GetHasSmth() bool {
@@ -2659,7 +2740,7 @@ func (header PostTransactionRequestHeader) GetXSignature() string {
 
 func (header PostTransactionRequestHeader) Validate() error {
 	return validation.ValidateStruct(&header,
-		validation.Field(&header.XFingerprint, validation.Required, validation.RuneLength(32, 32)),
+		validation.Field(&header.XFingerprint, validation.Required, validation.RuneLength(32, 0), validation.RuneLength(0, 32)),
 		validation.Field(&header.XSignature, validation.RuneLength(0, 5)))
 }
 
@@ -2684,7 +2765,7 @@ func (header PutTransactionRequestHeader) GetXSignature() string {
 
 func (header PutTransactionRequestHeader) Validate() error {
 	return validation.ValidateStruct(&header,
-		validation.Field(&header.XFingerprint, validation.Required, validation.RuneLength(32, 32)),
+		validation.Field(&header.XFingerprint, validation.Required, validation.RuneLength(32, 0), validation.RuneLength(0, 32)),
 		validation.Field(&header.XSignature, validation.RuneLength(0, 5)))
 }
 
@@ -2709,13 +2790,13 @@ func (header DeleteTransactionsUUIDRequestHeader) GetXSignature() string {
 
 func (header DeleteTransactionsUUIDRequestHeader) Validate() error {
 	return validation.ValidateStruct(&header,
-		validation.Field(&header.XFingerprint, validation.Required, validation.RuneLength(32, 32)),
+		validation.Field(&header.XFingerprint, validation.Required, validation.RuneLength(32, 0), validation.RuneLength(0, 32)),
 		validation.Field(&header.XSignature, validation.RuneLength(0, 5)))
 }
 
 type DeleteTransactionsUUIDRequestPath struct {
-	RegexParam string
-	UUID       string
+	RegexParam string `json:"regexParam"`
+	UUID       string `json:"uuid"`
 }
 
 func (path DeleteTransactionsUUIDRequestPath) GetRegexParam() string {
@@ -2732,7 +2813,7 @@ func (path DeleteTransactionsUUIDRequestPath) Validate() error {
 }
 
 type DeleteTransactionsUUIDRequestQuery struct {
-	TimeParam time.Time
+	TimeParam time.Time `json:"timeParam"`
 }
 
 func (query DeleteTransactionsUUIDRequestQuery) GetTimeParam() time.Time {
@@ -5,6 +5,7 @@ package simplearray
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	chi "github.com/go-chi/chi/v5"
 	"net/http"
 	"slices"
@@ -19,14 +20,17 @@ type Hooks struct {
 	RequestHeaderParseFailed      func(*http.Request, string, string, RequestProcessingResult)
 	RequestPathParseFailed        func(*http.Request, string, string, RequestProcessingResult)
 	RequestQueryParseFailed       func(*http.Request, string, string, RequestProcessingResult)
+	RequestCookieParseFailed      func(*http.Request, string, string, RequestProcessingResult)
 	RequestBodyValidationFailed   func(*http.Request, string, RequestProcessingResult)
 	RequestHeaderValidationFailed func(*http.Request, string, RequestProcessingResult)
 	RequestPathValidationFailed   func(*http.Request, string, RequestProcessingResult)
 	RequestQueryValidationFailed  func(*http.Request, string, RequestProcessingResult)
+	RequestCookieValidationFailed func(*http.Request, string, RequestProcessingResult)
 	RequestBodyUnmarshalCompleted func(*http.Request, string)
 	RequestHeaderParseCompleted   func(*http.Request, string)
 	RequestPathParseCompleted     func(*http.Request, string)
 	RequestQueryParseCompleted    func(*http.Request, string)
+	RequestCookieParseCompleted   func(*http.Request, string)
 	RequestParseCompleted         func(*http.Request, string)
 	RequestProcessingCompleted    func(*http.Request, string)
 	RequestRedirectStarted        func(*http.Request, string, string)
@@ -51,6 +55,10 @@ const (
 	SecurityParseFailed
 	SecurityCheckFailed
 	ParseSucceed
+	CookieParseFailed
+	CookieValidationFailed
+	BodyContentTypeUnsupported
+	ParseFailed
 )
 
 type RequestProcessingResult struct {
@@ -73,6 +81,13 @@ func (r RequestProcessingResult) Err() error {
 	return r.error
 }
 
+// ValidationError returns the violations of a failed parsing or validation.
+func (r RequestProcessingResult) ValidationError() (ValidationError, bool) {
+	var err ValidationError
+
+	return err, errors.As(r.error, &err)
+}
+
 func DefaultHandler(impl DefaultService, r chi.Router, hooks *Hooks) http.Handler {
 	if hooks == nil {
 		hooks = &Hooks{}
@@ -104,11 +119,9 @@ func (router *defaultRouter) parsePostTestRequest(r *http.Request) (request Post
 	)
 	decodeErr = json.NewDecoder(r.Body).Decode(&body)
 	if decodeErr != nil {
-		request.ProcessingResult = RequestProcessingResult{error: decodeErr, typee: BodyUnmarshalFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", decodeErr), typee: BodyUnmarshalFailed}
 		if router.hooks.RequestBodyUnmarshalFailed != nil {
 			router.hooks.RequestBodyUnmarshalFailed(r, "PostTest", request.ProcessingResult)
-
-			return
 		}
 
 		return
@@ -121,7 +134,7 @@ func (router *defaultRouter) parsePostTestRequest(r *http.Request) (request Post
 	}
 
 	if err := request.Body.Validate(); err != nil {
-		request.ProcessingResult = RequestProcessingResult{error: err, typee: BodyValidationFailed}
+		request.ProcessingResult = RequestProcessingResult{error: ValidationErrorOf("body", "", err), typee: BodyValidationFailed}
 		if router.hooks.RequestBodyValidationFailed != nil {
 			router.hooks.RequestBodyValidationFailed(r, "PostTest", request.ProcessingResult)
 		}
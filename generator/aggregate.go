@@ -0,0 +1,100 @@
+package generator
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// requestFailed reports the ValidationError err of a failed parser or validator through hook and stops the parsing.
+// With -aggregate-errors the violations of err are collected instead and return only ends the scope of the failed part.
+func (generator *Generator) requestFailed(typee string, err jen.Code, hook string, hookParams ...jen.Code) []jen.Code {
+	if generator.config.AggregateErrors {
+		return []jen.Code{
+			jen.Id("violations").Op("=").Id("violations").Dot("Merge").Call(err),
+			jen.Return(),
+		}
+	}
+
+	return []jen.Code{
+		jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Add(err),
+			jen.Id("typee").Op(":").Id(typee)),
+		jen.If(jen.Id("router").Dot("hooks").Dot(hook).Op("!=").Id("nil")).Block(
+			jen.Id("router").Dot("hooks").Dot(hook).Call(append(hookParams, jen.Id("request").Dot("ProcessingResult"))...)),
+		jen.Line().Return(),
+	}
+}
+
+// aggregated runs a parameter parser, a validation or the body parsing in a scope of its own with -aggregate-errors,
+// so the parsing goes on after it failed.
+func (generator *Generator) aggregated(code jen.Code) jen.Code {
+	if !generator.config.AggregateErrors {
+		return code
+	}
+
+	return jen.Func().Params().Block(code).Call().Line()
+}
+
+// aggregatedViolations declares the collected violations at the start of a request parser.
+func (generator *Generator) aggregatedViolations() jen.Code {
+	if !generator.config.AggregateErrors {
+		return jen.Null()
+	}
+
+	return jen.Var().Id("violations").Qual(generator.config.ComponentsPackage, "ValidationError").Line()
+}
+
+// aggregatedFailed reports the collected violations through RequestParseFailed once all parts were parsed.
+func (generator *Generator) aggregatedFailed(name string) jen.Code {
+	if !generator.config.AggregateErrors {
+		return jen.Null()
+	}
+
+	return jen.Line().If(jen.Len(jen.Id("violations").Dot("Violations")).Op(">").Lit(0)).Block(
+		jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("violations"),
+			jen.Id("typee").Op(":").Id("ParseFailed")),
+		jen.If(jen.Id("router").Dot("hooks").Dot("RequestParseFailed").Op("!=").Id("nil")).Block(
+			jen.Id("router").Dot("hooks").Dot("RequestParseFailed").Call(jen.Id("r"), jen.Lit(name), jen.Id("request").Dot("ProcessingResult"))),
+		jen.Line().Return(),
+	).Line()
+}
+
+// aggregatedHook is the RequestParseFailed hook of the Hooks, it replaces the parse and validation failure hooks
+// with -aggregate-errors.
+func (generator *Generator) aggregatedHook() jen.Code {
+	if !generator.config.AggregateErrors {
+		return jen.Null()
+	}
+
+	return jen.Id("RequestParseFailed").Func().Params(jen.Op("*").Qual("net/http",
+		"Request"),
+		jen.Id("string"),
+		jen.Id("RequestProcessingResult"))
+}
+
+// mergeViolations is emitted into the components with -aggregate-errors.
+func (generator *Generator) mergeViolations() []jen.Code {
+	if !generator.config.AggregateErrors {
+		return nil
+	}
+
+	isWithin := func(pointer jen.Code, parent jen.Code) *jen.Statement {
+		return jen.Parens(jen.Add(pointer).Op("==").Add(parent).Op("||").Qual("strings", "HasPrefix").Call(pointer, jen.Add(parent).Op("+").Lit("/")))
+	}
+
+	return []jen.Code{
+		jen.Comment("Merge appends the violations of other found at pointers err has no violation at, within or above,").Line().
+			Comment("so a value failing to parse is not reported again by the validation of its zero value.").Line().
+			Func().Params(jen.Id("err").Id("ValidationError")).Id("Merge").Params(jen.Id("other").Id("ValidationError")).Id("ValidationError").Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("violation")).Op(":=").Range().Id("other").Dot("Violations")).Block(
+				jen.If(jen.Op("!").Qual("slices", "ContainsFunc").Call(jen.Id("err").Dot("Violations"), jen.Id("violation").Dot("overlaps"))).Block(
+					jen.Id("err").Dot("Violations").Op("=").Append(jen.Id("err").Dot("Violations"), jen.Id("violation")),
+				),
+			),
+			jen.Line().Return(jen.Id("err")),
+		),
+		jen.Func().Params(jen.Id("violation").Id("Violation")).Id("overlaps").Params(jen.Id("other").Id("Violation")).Bool().Block(
+			jen.Return(jen.Id("violation").Dot("Location").Op("==").Id("other").Dot("Location").Op("&&").Parens(
+				isWithin(jen.Id("violation").Dot("Pointer"), jen.Id("other").Dot("Pointer")).Op("||").
+					Add(isWithin(jen.Id("other").Dot("Pointer"), jen.Id("violation").Dot("Pointer"))))),
+		),
+	}
+}
@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"slices"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// allOfFromSchema generates a struct embedding every referenced component of an allOf schema.
+// Inline parts are merged into a <Name>Properties component which is embedded as well, so each part keeps
+// its own required fields, regexes and validation rules.
+func (generator *Generator) allOfFromSchema(name string, schemaRef *openapi3.SchemaRef) jen.Code {
+	generator.useAllOf = true
+
+	inline := &openapi3.Schema{Type: &openapi3.Types{openapi3.TypeObject}, Properties: openapi3.Schemas{}}
+	parts := []*openapi3.SchemaRef{schemaRef}
+	for len(parts) > 0 {
+		part := parts[0].Value
+		parts = parts[1:]
+
+		for _, property := range sortedMapKeys(part.Properties) {
+			inline.Properties[property] = part.Properties[property]
+		}
+
+		for _, required := range part.Required {
+			if !slices.Contains(inline.Required, required) {
+				inline.Required = append(inline.Required, required)
+			}
+		}
+
+		for _, allOf := range part.AllOf {
+			if allOf.Ref == "" {
+				parts = append(parts, allOf)
+			}
+		}
+	}
+
+	var embedded []string
+	var components []jen.Code
+	for _, allOf := range schemaRef.Value.AllOf {
+		if allOf.Ref != "" {
+			embedded = append(embedded, generator.normalizer.extractNameFromRef(allOf.Ref))
+		}
+	}
+
+	if len(inline.Properties) > 0 {
+		embedded = append(embedded, name+"Properties")
+		components = append(components, generator.componentFromSchema(name+"Properties", &openapi3.SchemaRef{Value: inline}))
+	}
+
+	var fields, unmarshalParts, validateParts, marshalParts []jen.Code
+	for _, part := range embedded {
+		fields = append(fields, jen.Qual(generator.config.ComponentsPackage, part))
+		unmarshalParts = append(unmarshalParts,
+			jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("body").Dot(part)), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Id("err")),
+			),
+		)
+		validateParts = append(validateParts,
+			jen.If(jen.Id("err").Op(":=").Qual("github.com/go-ozzo/ozzo-validation/v4", "Validate").Call(jen.Id("body").Dot(part)), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Id("err")),
+			),
+		)
+		marshalParts = append(marshalParts, jen.Id("body").Dot(part))
+	}
+
+	components = append(components,
+		jen.Type().Id(name).Struct(fields...),
+		jen.Func().Params(jen.Id("body").Op("*").Id(name)).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(
+			append(generator.normalizer.lineAfterEachElement(unmarshalParts...), jen.Return(jen.Nil()))...,
+		),
+		jen.Func().Params(jen.Id("body").Id(name)).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+			jen.Return(jen.Id("marshalAllOf").Call(marshalParts...)),
+		),
+	)
+
+	if !generator.typee.getXGoSkipValidation(schemaRef.Value) {
+		components = append(components,
+			jen.Func().Params(jen.Id("body").Id(name)).Id("Validate").Params().Error().Block(
+				append(generator.normalizer.lineAfterEachElement(validateParts...), jen.Return(jen.Nil()))...,
+			),
+		)
+	}
+
+	return jen.Add(generator.normalizer.doubleLineAfterEachElement(components...)...)
+}
+
+// isEmbeddingAllOf tells whether an allOf schema is generated as a struct embedding its parts. An allOf made of
+// a single reference and parts without properties, such as a description, stays a type of the referenced schema.
+func isEmbeddingAllOf(schema *openapi3.Schema) bool {
+	if len(schema.AllOf) == 0 {
+		return false
+	}
+
+	refs := 0
+	for _, part := range schema.AllOf {
+		if part.Ref != "" {
+			refs++
+		}
+	}
+
+	return refs > 1 || len(inlineProperties(schema)) > 0
+}
+
+// allOfHelpers is emitted once into the components when at least one allOf struct is generated.
+// Embedded parts are marshalled one by one and merged, so fields with the same name do not cancel each other out.
+// Parts which are not JSON objects have no fields to merge and are skipped.
+func (generator *Generator) allOfHelpers() jen.Code {
+	if !generator.useAllOf {
+		return jen.Null()
+	}
+
+	return jen.Func().Id("marshalAllOf").Params(jen.Id("parts").Op("...").Interface()).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.Id("merged").Op(":=").Map(jen.String()).Qual("encoding/json", "RawMessage").Values(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("part")).Op(":=").Range().Id("parts")).Block(
+			jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("part")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id("err")),
+			),
+			jen.Line().If(jen.Len(jen.Id("data")).Op("==").Lit(0).Op("||").Id("data").Index(jen.Lit(0)).Op("!=").LitByte('{')).Block(
+				jen.Continue(),
+			),
+			jen.Line().If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("merged")), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id("err")),
+			),
+		),
+		jen.Line().Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("merged"))),
+	)
+}
+
+// inlineProperties collects the properties of a schema together with the ones declared by its inline allOf/oneOf/anyOf parts.
+func inlineProperties(schema *openapi3.Schema) openapi3.Schemas {
+	properties := openapi3.Schemas{}
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+
+	for _, part := range slices.Concat(schema.AllOf, schema.OneOf, schema.AnyOf) {
+		if part.Ref != "" || part.Value == nil {
+			continue
+		}
+
+		for name, property := range inlineProperties(part.Value) {
+			properties[name] = property
+		}
+	}
+
+	return properties
+}
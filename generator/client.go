@@ -0,0 +1,792 @@
+package generator
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/spf13/cast"
+)
+
+// clientCode generates a typed http client per tag mirroring the <Tag>Service interfaces.
+func (generator *Generator) clientCode(swagger *openapi3.T) jen.Code {
+	var results []jen.Code
+
+	results = append(results, generator.clientResponseInterface(), generator.clientSecurityAppliers(swagger), generator.clientHelpers())
+
+	groupedOps := generator.groupedOperations(swagger)
+	slices.SortFunc(groupedOps, func(a, b groupedOperations) int { return strings.Compare(a.tag, b.tag) })
+
+	for _, grouped := range groupedOps {
+		tag := generator.normalizer.normalize(grouped.tag)
+		clientName := strings.Title(tag) + "Client"
+
+		results = append(results, generator.clientStruct(clientName))
+
+		for _, operation := range grouped.operations {
+			name := generator.normalizer.normalizeOperationName(operation.path, operation.method)
+			responses := generator.operationResponses(name, operation.operation)
+
+			results = append(results, generator.clientResponseAccessors(name), generator.clientResponseDecoder(name, responses))
+
+			if operation.operation.RequestBody == nil {
+				results = append(results, generator.clientMethod(clientName, name, name, operation, ""))
+				continue
+			}
+
+			contentTypes := sortedMapKeys(operation.operation.RequestBody.Value.Content)
+			if len(contentTypes) == 1 {
+				results = append(results, generator.clientMethod(clientName, name, name, operation, contentTypes[0]))
+				continue
+			}
+
+			//if we have several content types every one of them gets its own method
+			for _, contentType := range contentTypes {
+				methodName := name + generator.normalizer.contentType(contentType)
+				results = append(results, generator.clientMethod(clientName, methodName, name, operation, contentType))
+			}
+		}
+	}
+
+	for _, name := range sortedMapKeys(generator.clientFormEncoders) {
+		results = append(results, generator.clientFormEncoders[name])
+	}
+
+	if generator.useClientFormFiles {
+		results = append(results, generator.clientFormHelpers())
+	}
+
+	return jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(results...)...)
+}
+
+func (generator *Generator) clientResponseInterface() jen.Code {
+	return jen.Type().Id("ClientResponse").Interface(
+		jen.Id("StatusCode").Params().Int(),
+		jen.Id("ContentType").Params().String(),
+		jen.Id("Headers").Params().Map(jen.String()).String(),
+		jen.Id("Cookies").Params().Index().Qual("net/http", "Cookie"),
+		jen.Id("Body").Params().Interface(),
+	)
+}
+
+// clientResponseAccessors exposes a response received by the client while keeping the <Op>Response type.
+func (generator *Generator) clientResponseAccessors(name string) jen.Code {
+	responseName := generator.normalizer.decapitalize(name) + "Response"
+
+	interfaceDeclaration := jen.Type().Id(name+"ClientResponse").Interface(
+		jen.Id(name+"Response"),
+		jen.Id("ClientResponse"),
+	)
+
+	accessors := jen.Func().Params(jen.Id("response").Id(responseName)).Id("StatusCode").Params().Int().Block(
+		jen.Return().Id("response").Dot("response").Dot("statusCode"),
+	).
+		Add(jen.Line(), jen.Line()).
+		Add(jen.Func().Params(jen.Id("response").Id(responseName)).Id("ContentType").Params().String().Block(
+			jen.Return().Id("response").Dot("response").Dot("contentType"),
+		)).
+		Add(jen.Line(), jen.Line()).
+		Add(jen.Func().Params(jen.Id("response").Id(responseName)).Id("Headers").Params().Map(jen.String()).String().Block(
+			jen.Return().Id("response").Dot("response").Dot("headers"),
+		)).
+		Add(jen.Line(), jen.Line()).
+		Add(jen.Func().Params(jen.Id("response").Id(responseName)).Id("Cookies").Params().Index().Qual("net/http", "Cookie").Block(
+			jen.Return().Id("response").Dot("response").Dot("cookies"),
+		)).
+		Add(jen.Line(), jen.Line()).
+		Add(jen.Func().Params(jen.Id("response").Id(responseName)).Id("Body").Params().Interface().Block(
+			jen.If(jen.Id("response").Dot("response").Dot("body").Op("!=").Nil()).Block(
+				jen.Return().Id("response").Dot("response").Dot("body"),
+			),
+			jen.Line().Return().Id("response").Dot("response").Dot("bodyRaw"),
+		))
+
+	return jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(interfaceDeclaration, accessors)...)
+}
+
+func (generator *Generator) clientStruct(clientName string) jen.Code {
+	codecsField := jen.Null()
+	codecsParameter := jen.Null()
+	codecs := jen.Null()
+	codecsValue := jen.Null()
+	if generator.config.Codecs {
+		codecsField = codecsField.Id("codecs").Id("Codecs")
+		codecsParameter = codecsParameter.Id("codecs").Id("Codecs")
+		codecs = codecs.If(jen.Id("codecs").Op("==").Nil()).Block(
+			jen.Id("codecs").Op("=").Id("DefaultCodecs").Call(),
+		)
+		codecsValue = codecsValue.Id("codecs").Op(":").Id("codecs")
+	}
+
+	declaration := jen.Type().Id(clientName).Struct(
+		jen.Id("baseURL").String(),
+		jen.Id("httpClient").Op("*").Qual("net/http", "Client"),
+		jen.Id("credentials").Map(jen.Id("SecurityScheme")).String(),
+		codecsField,
+	)
+
+	constructor := jen.Func().Id("New"+clientName).Params(
+		jen.Id("baseURL").String(),
+		jen.Id("httpClient").Op("*").Qual("net/http", "Client"),
+		jen.Id("credentials").Map(jen.Id("SecurityScheme")).String(),
+		codecsParameter).
+		Params(jen.Op("*").Id(clientName)).
+		Block(
+			jen.If(jen.Id("httpClient").Op("==").Nil()).Block(
+				jen.Id("httpClient").Op("=").Qual("net/http", "DefaultClient"),
+			),
+			codecs,
+			jen.Line().Return().Op("&").Id(clientName).Values(
+				jen.Id("baseURL").Op(":").Qual("strings", "TrimRight").Call(jen.Id("baseURL"), jen.Lit("/")),
+				jen.Id("httpClient").Op(":").Id("httpClient"),
+				jen.Id("credentials").Op(":").Id("credentials"),
+				codecsValue,
+			),
+		)
+
+	return jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(declaration, constructor)...)
+}
+
+func (generator *Generator) clientMethod(clientName string, methodName string, name string, operation operationWithPath, contentType string) jen.Code {
+	var funcCode []jen.Code
+
+	funcCode = append(funcCode, jen.Id("path").Op(":=").Lit(operation.path))
+
+	parameters := slices.Clone(operation.operation.Parameters)
+	slices.SortFunc(parameters, func(a, b *openapi3.ParameterRef) int { return strings.Compare(a.Value.Name, b.Value.Name) })
+
+	for _, parameter := range parameters {
+		if parameter.Value.In != "path" {
+			continue
+		}
+
+		funcCode = append(funcCode, jen.Id("path").Op("=").Qual("strings", "ReplaceAll").Call(
+			jen.Id("path"),
+			jen.Lit("{"+parameter.Value.Name+"}"),
+			jen.Id("clientPathValue").Call(generator.clientParameterField(parameter))))
+	}
+
+	funcCode = append(funcCode, jen.Line().Id("query").Op(":=").Qual("net/url", "Values").Values())
+	for _, parameter := range parameters {
+		if parameter.Value.In != "query" {
+			continue
+		}
+
+		funcCode = append(funcCode, generator.clientWrapOptional(parameter, generator.clientQueryParameter(parameter)))
+	}
+
+	bodyReader := jen.Qual("net/http", "NoBody")
+	if isFormContentType(contentType) {
+		funcCode = append(funcCode,
+			jen.Line().List(jen.Id("body"), jen.Id("contentType"), jen.Id("err")).Op(":=").Add(generator.clientFormRequestBodyEncode(name, contentType, operation.operation)),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		)
+		bodyReader = jen.Id("body")
+	} else if contentType != "" && generator.config.Codecs {
+		funcCode = append(funcCode,
+			jen.Line().List(jen.Id("body"), jen.Id("err")).Op(":=").Id("client").Dot("codecs").Dot("encode").Call(jen.Lit(contentType), jen.Id("request").Dot("Body")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		)
+		bodyReader = jen.Qual("bytes", "NewReader").Call(jen.Id("body"))
+	} else if contentType != "" {
+		funcCode = append(funcCode,
+			jen.Line().List(jen.Id("body"), jen.Id("err")).Op(":=").Id("clientEncodeBody").Call(jen.Lit(contentType), jen.Id("request").Dot("Body")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		)
+		bodyReader = jen.Id("body")
+	}
+
+	funcCode = append(funcCode,
+		jen.Line().List(jen.Id("httpRequest"), jen.Id("err")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(
+			jen.Id("ctx"),
+			jen.Lit(strings.ToUpper(operation.method)),
+			jen.Id("client").Dot("baseURL").Op("+").Id("path"),
+			bodyReader),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		jen.Line().Id("httpRequest").Dot("URL").Dot("RawQuery").Op("=").Id("query").Dot("Encode").Call(),
+	)
+
+	if isFormContentType(contentType) {
+		funcCode = append(funcCode, jen.Id("httpRequest").Dot("Header").Dot("Set").Call(jen.Lit("Content-Type"), jen.Id("contentType")))
+	} else if contentType != "" {
+		funcCode = append(funcCode, jen.Id("httpRequest").Dot("Header").Dot("Set").Call(jen.Lit("Content-Type"), jen.Lit(contentType)))
+	}
+
+	if generator.config.ContentNegotiation {
+		funcCode = append(funcCode, jen.If(jen.Id("request").Dot("Accept").Op("!=").Lit("")).Block(
+			jen.Id("httpRequest").Dot("Header").Dot("Set").Call(jen.Lit("Accept"), jen.String().Call(jen.Id("request").Dot("Accept")))))
+	}
+
+	for _, parameter := range parameters {
+		if parameter.Value.In != "header" {
+			continue
+		}
+
+		funcCode = append(funcCode, generator.clientWrapOptional(parameter,
+			jen.Id("httpRequest").Dot("Header").Dot("Set").Call(
+				jen.Lit(parameter.Value.Name),
+				jen.Qual("strings", "Join").Call(jen.Id("clientParameterValues").Call(generator.clientParameterField(parameter)), jen.Lit(",")))))
+	}
+
+	for _, parameter := range parameters {
+		if parameter.Value.In != "cookie" {
+			continue
+		}
+
+		funcCode = append(funcCode, generator.clientWrapOptional(parameter,
+			jen.Id("httpRequest").Dot("AddCookie").Call(jen.Op("&").Qual("net/http", "Cookie").Values(
+				jen.Id("Name").Op(":").Lit(parameter.Value.Name),
+				jen.Id("Value").Op(":").Qual("strings", "Join").Call(jen.Id("clientParameterValues").Call(generator.clientParameterField(parameter)), jen.Lit(","))))))
+	}
+
+	if operation.operation.Security != nil && len(*operation.operation.Security) > 0 {
+		var requirements []jen.Code
+		for _, securityRequirement := range *operation.operation.Security {
+			var schemes []jen.Code
+			for _, scheme := range sortedMapKeys(securityRequirement) {
+				schemes = append(schemes, jen.Id("SecurityScheme"+strings.Title(scheme)))
+			}
+			requirements = append(requirements, jen.Values(schemes...))
+		}
+
+		funcCode = append(funcCode,
+			jen.Line().If(jen.Err().Op(":=").Id("applySecurityRequirements").Call(
+				jen.Id("httpRequest"),
+				jen.Id("client").Dot("credentials"),
+				jen.Index().Index().Id("SecurityScheme").Values(requirements...),
+				jen.Lit(generator.typee.getXGoSkipSecurityCheck(operation.operation))),
+				jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())))
+	}
+
+	funcCode = append(funcCode,
+		jen.Line().List(jen.Id("httpResponse"), jen.Id("err")).Op(":=").Id("client").Dot("httpClient").Dot("Do").Call(jen.Id("httpRequest")),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		jen.Defer().Id("httpResponse").Dot("Body").Dot("Close").Call(),
+		jen.Line().List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("io", "ReadAll").Call(jen.Id("httpResponse").Dot("Body")),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		jen.Line().Var().Id("result").Id(generator.normalizer.decapitalize(name)+"Response"),
+		jen.Id("result").Dot("response").Dot("statusCode").Op("=").Id("httpResponse").Dot("StatusCode"),
+		jen.List(jen.Id("result").Dot("response").Dot("contentType"), jen.Id("_"), jen.Id("_")).Op("=").
+			Qual("mime", "ParseMediaType").Call(jen.Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type"))),
+		jen.Id("result").Dot("response").Dot("headers").Op("=").Id("clientResponseHeaders").Call(jen.Id("httpResponse").Dot("Header")),
+		jen.For(jen.List(jen.Id("_"), jen.Id("cookie")).Op(":=").Range().Id("httpResponse").Dot("Cookies").Call()).Block(
+			jen.Id("result").Dot("response").Dot("cookies").Op("=").Append(jen.Id("result").Dot("response").Dot("cookies"), jen.Op("*").Id("cookie")),
+		),
+		jen.Line().If(jen.Err().Op(":=").Id("result").Dot("decode").Call(generator.clientDecodeParams()...), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Line().Return(jen.Id("result"), jen.Nil()),
+	)
+
+	return jen.Func().Params(jen.Id("client").Op("*").Id(clientName)).Id(methodName).Params(
+		jen.Id("ctx").Qual("context", "Context"),
+		jen.Id("request").Id(methodName+"Request")).
+		Params(jen.Id(name+"ClientResponse"), jen.Error()).
+		Block(funcCode...)
+}
+
+// clientResponseDecoder decodes a received body into the component declared for its status code and content type.
+func (generator *Generator) clientResponseDecoder(name string, responses []operationResponse) jen.Code {
+	var cases []jen.Code
+	var rangeCases []jen.Code
+	var defaultCase []jen.Code
+
+	//a response without content still needs its case when a range or the default response would decode its body
+	hasFallback := slices.ContainsFunc(responses, func(resp operationResponse) bool {
+		return (resp.IsDefault || resp.StatusClass > 0) && len(resp.ContentTypeBodyNameMap) > 0
+	})
+
+	for _, resp := range responses {
+		if len(resp.ContentTypeBodyNameMap) == 0 {
+			switch {
+			case !hasFallback || resp.IsDefault:
+			case resp.StatusClass > 0:
+				rangeCases = append(rangeCases, jen.Case(jen.Lit(resp.StatusClass)).Block())
+			default:
+				cases = append(cases, jen.Case(jen.Lit(cast.ToInt(resp.StatusCode))).Block())
+			}
+
+			continue
+		}
+
+		var contentTypeCases []jen.Code
+		for _, contentType := range sortedMapKeys(resp.ContentTypeBodyNameMap) {
+			//an event stream is a sequence of events, it is kept raw
+			if isEventStream(contentType) {
+				continue
+			}
+
+			contentTypeCases = append(contentTypeCases, jen.Case(jen.Lit(contentType)).Block(
+				jen.Var().Id("body").Qual(generator.config.ComponentsPackage, resp.ContentTypeBodyNameMap[contentType]),
+				jen.If(jen.Err().Op(":=").Add(generator.clientDecodeBody()),
+					jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+				jen.Line().Id("response").Dot("response").Dot("body").Op("=").Id("body"),
+				jen.Return(jen.Nil()),
+			))
+		}
+
+		contentTypeSwitch := jen.Switch(jen.Id("response").Dot("response").Dot("contentType")).Block(contentTypeCases...)
+
+		if resp.IsDefault {
+			defaultCase = []jen.Code{contentTypeSwitch}
+			continue
+		}
+
+		if resp.StatusClass > 0 {
+			rangeCases = append(rangeCases, jen.Case(jen.Lit(resp.StatusClass)).Block(contentTypeSwitch))
+			continue
+		}
+
+		cases = append(cases, jen.Case(jen.Lit(cast.ToInt(resp.StatusCode))).Block(contentTypeSwitch))
+	}
+
+	//status codes declared exactly take precedence over their range, the default response takes the rest
+	if len(rangeCases) > 0 {
+		if len(defaultCase) > 0 {
+			rangeCases = append(rangeCases, jen.Default().Block(defaultCase...))
+		}
+
+		defaultCase = []jen.Code{jen.Switch(jen.Id("response").Dot("response").Dot("statusCode").Op("/").Lit(100)).Block(rangeCases...)}
+	}
+
+	if len(defaultCase) > 0 {
+		cases = append(cases, jen.Default().Block(defaultCase...))
+	}
+
+	var funcCode []jen.Code
+	if len(cases) > 0 {
+		funcCode = append(funcCode, jen.Switch(jen.Id("response").Dot("response").Dot("statusCode")).Block(cases...).Line())
+	}
+
+	funcCode = append(funcCode,
+		jen.Id("response").Dot("response").Dot("bodyRaw").Op("=").Id("data"),
+		jen.Line().Return(jen.Nil()))
+
+	return jen.Func().Params(jen.Id("response").Op("*").Id(generator.normalizer.decapitalize(name) + "Response")).Id("decode").
+		Params(generator.clientDecodeSignature()...).Params(jen.Error()).
+		Block(funcCode...)
+}
+
+// clientDecodeSignature are the parameters of a response decoder, with -codecs it gets the codecs of the client.
+func (generator *Generator) clientDecodeSignature() []jen.Code {
+	if generator.config.Codecs {
+		return []jen.Code{jen.Id("codecs").Id("Codecs"), jen.Id("data").Index().Byte()}
+	}
+
+	return []jen.Code{jen.Id("data").Index().Byte()}
+}
+
+func (generator *Generator) clientDecodeParams() []jen.Code {
+	if generator.config.Codecs {
+		return []jen.Code{jen.Id("client").Dot("codecs"), jen.Id("data")}
+	}
+
+	return []jen.Code{jen.Id("data")}
+}
+
+func (generator *Generator) clientDecodeBody() jen.Code {
+	if generator.config.Codecs {
+		return jen.Id("codecs").Dot("decode").Call(jen.Id("response").Dot("response").Dot("contentType"), jen.Qual("bytes", "NewReader").Call(jen.Id("data")), jen.Op("&").Id("body"))
+	}
+
+	return jen.Id("clientDecodeBody").Call(jen.Id("response").Dot("response").Dot("contentType"), jen.Id("data"), jen.Op("&").Id("body"))
+}
+
+func (generator *Generator) clientParameterField(parameter *openapi3.ParameterRef) jen.Code {
+	return jen.Id("request").Dot(strings.Title(parameter.Value.In)).Dot(generator.normalizer.normalize(parameter.Value.Name))
+}
+
+func (generator *Generator) clientQueryParameter(parameter *openapi3.ParameterRef) jen.Code {
+	method := serializationMethod(parameter.Value)
+
+	switch {
+	case method.Style == openapi3.SerializationDeepObject:
+		return jen.For(jen.List(jen.Id("key"), jen.Id("value")).Op(":=").Range().Id("clientDeepObjectValues").Call(generator.clientParameterField(parameter))).Block(
+			jen.Id("query").Dot("Set").Call(jen.Lit(parameter.Value.Name+"[").Op("+").Id("key").Op("+").Lit("]"), jen.Id("value")),
+		)
+	case method.Style == openapi3.SerializationForm && method.Explode:
+		return jen.For(jen.List(jen.Id("_"), jen.Id("value")).Op(":=").Range().Id("clientParameterValues").Call(generator.clientParameterField(parameter))).Block(
+			jen.Id("query").Dot("Add").Call(jen.Lit(parameter.Value.Name), jen.Id("value")),
+		)
+	}
+
+	return jen.Id("query").Dot("Set").Call(jen.Lit(parameter.Value.Name),
+		jen.Qual("strings", "Join").Call(jen.Id("clientParameterValues").Call(generator.clientParameterField(parameter)), jen.Lit(parameterSeparator(method.Style))))
+}
+
+func (generator *Generator) clientWrapOptional(parameter *openapi3.ParameterRef, code jen.Code) jen.Code {
+	if parameter.Value.Required {
+		return code
+	}
+
+	return jen.If(jen.Id("request").Dot(strings.Title(parameter.Value.In)).Dot(generator.normalizer.normalize(parameter.Value.Name) + "Set")).Block(code)
+}
+
+func (generator *Generator) clientSecurityAppliers(swagger *openapi3.T) jen.Code {
+	var appliers []jen.Code
+
+	for _, entry := range sortedMapEntries(swagger.Components.SecuritySchemes) {
+		schema := entry.Value.Value
+		var body jen.Code
+
+		switch {
+		case schema.Type == "http" && schema.Scheme == "bearer", isScopedScheme(schema):
+			body = jen.Id("r").Dot("Header").Dot("Set").Call(jen.Lit("Authorization"), jen.Lit("Bearer ").Op("+").Id("value"))
+		case schema.Type == "http":
+			body = jen.Id("r").Dot("Header").Dot("Set").Call(jen.Lit("Authorization"), jen.Lit("Basic ").Op("+").Id("value"))
+		case schema.Type == "apiKey" && schema.In == "header":
+			body = jen.Id("r").Dot("Header").Dot("Set").Call(jen.Lit(schema.Name), jen.Id("value"))
+		case schema.Type == "apiKey" && schema.In == "query":
+			body = jen.Id("query").Op(":=").Id("r").Dot("URL").Dot("Query").Call().Line().
+				Id("query").Dot("Set").Call(jen.Lit(schema.Name), jen.Id("value")).Line().
+				Id("r").Dot("URL").Dot("RawQuery").Op("=").Id("query").Dot("Encode").Call()
+		case schema.Type == "apiKey" && schema.In == "cookie":
+			body = jen.Id("r").Dot("AddCookie").Call(jen.Op("&").Qual("net/http", "Cookie").Values(
+				jen.Id("Name").Op(":").Lit(schema.Name),
+				jen.Id("Value").Op(":").Id("value")))
+		default:
+			continue
+		}
+
+		appliers = append(appliers, jen.Line().Id("SecurityScheme"+strings.Title(entry.Key)).Op(":").Func().Params(
+			jen.Id("r").Op("*").Qual("net/http", "Request"),
+			jen.Id("value").String()).Block(body))
+	}
+
+	appliers = append(appliers, jen.Line())
+
+	return jen.Var().Id("securityAppliersFuncs").Op("=").Map(jen.Id("SecurityScheme")).Func().Params(
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+		jen.Id("value").String()).Values(appliers...)
+}
+
+func (generator *Generator) clientHelpers() jen.Code {
+	applySecurity := jen.Func().Id("applySecurityRequirements").Params(
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+		jen.Id("credentials").Map(jen.Id("SecurityScheme")).String(),
+		jen.Id("requirements").Index().Index().Id("SecurityScheme"),
+		jen.Id("optional").Bool()).Params(jen.Error()).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("schemes")).Op(":=").Range().Id("requirements")).Block(
+			jen.Id("isSatisfied").Op(":=").True(),
+			jen.For(jen.List(jen.Id("_"), jen.Id("scheme")).Op(":=").Range().Id("schemes")).Block(
+				jen.If(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("credentials").Index(jen.Id("scheme")), jen.Op("!").Id("ok")).Block(
+					jen.Id("isSatisfied").Op("=").False(),
+					jen.Break(),
+				),
+			),
+			jen.Line().If(jen.Op("!").Id("isSatisfied")).Block(jen.Continue()),
+			jen.Line().For(jen.List(jen.Id("_"), jen.Id("scheme")).Op(":=").Range().Id("schemes")).Block(
+				jen.If(jen.List(jen.Id("apply"), jen.Id("ok")).Op(":=").Id("securityAppliersFuncs").Index(jen.Id("scheme")), jen.Id("ok")).Block(
+					jen.Id("apply").Call(jen.Id("r"), jen.Id("credentials").Index(jen.Id("scheme"))),
+				),
+			),
+			jen.Line().Return(jen.Nil()),
+		),
+		jen.Line().If(jen.Id("optional")).Block(jen.Return(jen.Nil())),
+		jen.Line().Return(jen.Qual("errors", "New").Call(jen.Lit("no credentials satisfy the operation security requirements"))),
+	)
+
+	parameterValues := jen.Func().Id("clientParameterValues").Params(jen.Id("value").Interface()).Params(jen.Index().String()).Block(
+		jen.Id("reflected").Op(":=").Qual("reflect", "ValueOf").Call(jen.Id("value")),
+		jen.If(jen.Id("reflected").Dot("Kind").Call().Op("!=").Qual("reflect", "Slice").Op("||").
+			Id("reflected").Dot("Type").Call().Dot("Elem").Call().Dot("Kind").Call().Op("==").Qual("reflect", "Uint8")).Block(
+			jen.Return(jen.Index().String().Values(jen.Id("clientParameterValue").Call(jen.Id("value")))),
+		),
+		jen.Line().Id("values").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Id("reflected").Dot("Len").Call()),
+		jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("reflected").Dot("Len").Call(), jen.Id("i").Op("++")).Block(
+			jen.Id("values").Op("=").Append(jen.Id("values"), jen.Id("clientParameterValue").Call(jen.Id("reflected").Dot("Index").Call(jen.Id("i")).Dot("Interface").Call())),
+		),
+		jen.Line().Return(jen.Id("values")),
+	)
+
+	parameterValue := jen.Func().Id("clientParameterValue").Params(jen.Id("value").Interface()).String().Block(
+		jen.If(jen.List(jen.Id("t"), jen.Id("ok")).Op(":=").Id("value").Assert(jen.Qual("time", "Time")), jen.Id("ok")).Block(
+			jen.Return(jen.Id("t").Dot("Format").Call(jen.Qual("time", "RFC3339"))),
+		),
+		jen.Line().Return(jen.Qual("fmt", "Sprint").Call(jen.Id("value"))),
+	)
+
+	// the items of an array are escaped one by one, so the commas of the simple style delimit them
+	pathValue := jen.Func().Id("clientPathValue").Params(jen.Id("value").Interface()).String().Block(
+		jen.Id("values").Op(":=").Id("clientParameterValues").Call(jen.Id("value")),
+		jen.For(jen.Id("i").Op(":=").Range().Id("values")).Block(
+			jen.Id("values").Index(jen.Id("i")).Op("=").Qual("net/url", "PathEscape").Call(jen.Id("values").Index(jen.Id("i"))),
+		),
+		jen.Line().Return(jen.Qual("strings", "Join").Call(jen.Id("values"), jen.Lit(","))),
+	)
+
+	deepObjectValues := jen.Func().Id("clientDeepObjectValues").Params(jen.Id("value").Interface()).Params(jen.Map(jen.String()).String()).Block(
+		jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("value")),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil())),
+		jen.Line().Var().Id("properties").Map(jen.String()).Interface(),
+		jen.Id("decoder").Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Qual("bytes", "NewReader").Call(jen.Id("data"))),
+		jen.Id("decoder").Dot("UseNumber").Call(),
+		jen.If(jen.Id("err").Op(":=").Id("decoder").Dot("Decode").Call(jen.Op("&").Id("properties")), jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil())),
+		jen.Line().Id("values").Op(":=").Make(jen.Map(jen.String()).String(), jen.Len(jen.Id("properties"))),
+		jen.For(jen.List(jen.Id("key"), jen.Id("property")).Op(":=").Range().Id("properties")).Block(
+			jen.If(jen.Id("property").Op("!=").Nil()).Block(
+				jen.Id("values").Index(jen.Id("key")).Op("=").Qual("fmt", "Sprint").Call(jen.Id("property")),
+			),
+		),
+		jen.Line().Return(jen.Id("values")),
+	)
+
+	encodeBody := jen.Func().Id("clientEncodeBody").Params(
+		jen.Id("contentType").String(),
+		jen.Id("body").Interface()).Params(jen.Qual("io", "Reader"), jen.Error()).Block(
+		jen.Switch(jen.Id("contentType")).Block(
+			jen.Case(jen.Lit("application/xml")).Block(
+				jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/xml", "Marshal").Call(jen.Id("body")),
+				jen.Return(jen.Qual("bytes", "NewReader").Call(jen.Id("data")), jen.Id("err")),
+			),
+			jen.Case(jen.Lit("application/octet-stream")).Block(
+				jen.List(jen.Id("data"), jen.Id("ok")).Op(":=").Id("body").Assert(jen.Index().Byte()),
+				jen.If(jen.Op("!").Id("ok")).Block(
+					jen.Return(jen.Nil(), jen.Qual("errors", "New").Call(jen.Lit("body is not []byte"))),
+				),
+				jen.Line().Return(jen.Qual("bytes", "NewReader").Call(jen.Id("data")), jen.Nil()),
+			),
+			jen.Default().Block(
+				jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("body")),
+				jen.Return(jen.Qual("bytes", "NewReader").Call(jen.Id("data")), jen.Id("err")),
+			),
+		),
+	)
+
+	decodeBody := jen.Func().Id("clientDecodeBody").Params(
+		jen.Id("contentType").String(),
+		jen.Id("data").Index().Byte(),
+		jen.Id("into").Interface()).Params(jen.Error()).Block(
+		jen.Switch(jen.Id("contentType")).Block(
+			jen.Case(jen.Lit("application/xml")).Block(
+				jen.Return(jen.Qual("encoding/xml", "Unmarshal").Call(jen.Id("data"), jen.Id("into"))),
+			),
+			jen.Case(jen.Lit("application/json")).Block(
+				jen.Return(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Id("into"))),
+			),
+		),
+		jen.Line().If(jen.List(jen.Id("raw"), jen.Id("ok")).Op(":=").Id("into").Assert(jen.Op("*").Index().Byte()), jen.Id("ok")).Block(
+			jen.Op("*").Id("raw").Op("=").Id("data"),
+			jen.Return(jen.Nil()),
+		),
+		jen.Line().Return(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Id("into"))),
+	)
+
+	responseHeaders := jen.Func().Id("clientResponseHeaders").Params(jen.Id("header").Qual("net/http", "Header")).
+		Params(jen.Map(jen.String()).String()).Block(
+		jen.Id("headers").Op(":=").Make(jen.Map(jen.String()).String(), jen.Len(jen.Id("header"))),
+		jen.For(jen.Id("key").Op(":=").Range().Id("header")).Block(
+			jen.Id("headers").Index(jen.Id("key")).Op("=").Id("header").Dot("Get").Call(jen.Id("key")),
+		),
+		jen.Line().Return(jen.Id("headers")),
+	)
+
+	if generator.config.Codecs {
+		return jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(applySecurity, parameterValues, parameterValue, pathValue, deepObjectValues, responseHeaders)...)
+	}
+
+	return jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(applySecurity, parameterValues, parameterValue, pathValue, deepObjectValues, encodeBody, decodeBody, responseHeaders)...)
+}
+
+// clientFormRequestBodyEncode encodes a form body with the encoder of its type and content type, the encoder returns the
+// content type to send as a multipart body carries its boundary.
+func (generator *Generator) clientFormRequestBodyEncode(name string, contentType string, operation *openapi3.Operation) jen.Code {
+	bodyTypeName := generator.requestBodyTypeName(name, contentType, operation)
+	encoderName := "encode" + bodyTypeName + generator.normalizer.contentType(contentType)
+
+	if generator.clientFormEncoders == nil {
+		generator.clientFormEncoders = map[string]jen.Code{}
+	}
+
+	if _, ok := generator.clientFormEncoders[encoderName]; !ok {
+		generator.clientFormEncoders[encoderName] = generator.clientFormEncoder(encoderName, bodyTypeName, contentType, operation.RequestBody.Value.Content[contentType])
+	}
+
+	return jen.Id(encoderName).Call(jen.Id("request").Dot("Body"))
+}
+
+// clientFormEncoder encodes a form body property by property the way formDecoder binds it. Scalars are formatted like
+// parameters, arrays are repeated values or a single value delimited by the style of their encoding and objects are
+// JSON encoded values. Binary properties of a multipart body are sent as files. Properties which are not required are
+// sent only when they are set.
+func (generator *Generator) clientFormEncoder(encoderName string, bodyTypeName string, contentType string, mediaType *openapi3.MediaType) jen.Code {
+	contentType = mediaTypeOf(contentType)
+	schema := mediaType.Schema.Value
+	asFile := contentType == multipartFormData && mediaType.Schema.Ref == ""
+
+	code := []jen.Code{jen.Id("form").Op(":=").Qual("net/url", "Values").Values()}
+	if contentType == multipartFormData {
+		code = append(code,
+			jen.Var().Id("buffer").Qual("bytes", "Buffer"),
+			jen.Id("writer").Op(":=").Qual("mime/multipart", "NewWriter").Call(jen.Op("&").Id("buffer")),
+		)
+	}
+
+	for _, property := range sortedMapKeys(schema.Properties) {
+		code = append(code, jen.Line().Add(generator.clientFormProperty(contentType, asFile, schema, property, mediaType.Encoding[property])))
+	}
+
+	if contentType == multipartFormData {
+		code = append(code,
+			jen.Line().If(jen.Id("err").Op(":=").Id("clientFormFields").Call(jen.Id("writer"), jen.Id("form")), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Lit(""), jen.Id("err")),
+			),
+			jen.Line().If(jen.Id("err").Op(":=").Id("writer").Dot("Close").Call(), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Lit(""), jen.Id("err")),
+			),
+			jen.Line().Return(jen.Op("&").Id("buffer"), jen.Id("writer").Dot("FormDataContentType").Call(), jen.Nil()),
+		)
+	} else {
+		code = append(code, jen.Line().Return(jen.Qual("strings", "NewReader").Call(jen.Id("form").Dot("Encode").Call()), jen.Lit(formURLEncoded), jen.Nil()))
+	}
+
+	return jen.Func().Id(encoderName).Params(
+		jen.Id("body").Qual(generator.config.ComponentsPackage, bodyTypeName),
+	).Params(jen.Qual("io", "Reader"), jen.String(), jen.Error()).Block(code...)
+}
+
+// clientFormProperty encodes a single property of a form body.
+func (generator *Generator) clientFormProperty(contentType string, asFile bool, schema *openapi3.Schema, property string, encoding *openapi3.Encoding) jen.Code {
+	propertySchema := schema.Properties[property]
+	field := jen.Id("body").Dot(generator.normalizer.normalize(property))
+	failed := jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Lit(""), jen.Id("err")))
+
+	var encode func(value jen.Code) jen.Code
+	switch {
+	case contentType == multipartFormData && isBinarySchema(propertySchema):
+		encode = func(value jen.Code) jen.Code {
+			return generator.clientFormFile(property, asFile, value)
+		}
+
+	case contentType == multipartFormData && isSchemaType(propertySchema.Value.Type, "array") && isBinarySchema(propertySchema.Value.Items):
+		encode = func(value jen.Code) jen.Code {
+			return jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Add(value)).Block(generator.clientFormFile(property, asFile, jen.Id("item")))
+		}
+
+	case isSchemaType(propertySchema.Value.Type, "array") && isJSONFormValue(propertySchema.Value.Items):
+		encode = func(value jen.Code) jen.Code {
+			return jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Add(value)).Block(
+				jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("item")),
+				failed,
+				jen.Line().Id("form").Dot("Add").Call(jen.Lit(property), jen.String().Call(jen.Id("data"))),
+			)
+		}
+
+	case isSchemaType(propertySchema.Value.Type, "array"):
+		encode = func(value jen.Code) jen.Code {
+			if contentType == formURLEncoded && encoding != nil {
+				if method := encoding.SerializationMethod(); !method.Explode || method.Style != openapi3.SerializationForm {
+					return jen.Id("form").Dot("Set").Call(jen.Lit(property),
+						jen.Qual("strings", "Join").Call(jen.Id("clientParameterValues").Call(value), jen.Lit(parameterSeparator(method.Style))))
+				}
+			}
+
+			return jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("clientParameterValues").Call(value)).Block(
+				jen.Id("form").Dot("Add").Call(jen.Lit(property), jen.Id("item")),
+			)
+		}
+
+	case isBinarySchema(propertySchema):
+		encode = func(value jen.Code) jen.Code {
+			return jen.Id("form").Dot("Set").Call(jen.Lit(property), jen.String().Call(value))
+		}
+
+	case isJSONFormValue(propertySchema):
+		encode = func(value jen.Code) jen.Code {
+			return jen.Null().
+				Add(jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(value)).Line().
+				Add(failed).Line().Line().
+				Add(jen.Id("form").Dot("Set").Call(jen.Lit(property), jen.String().Call(jen.Id("data"))))
+		}
+
+	default:
+		encode = func(value jen.Code) jen.Code {
+			return jen.Id("form").Dot("Set").Call(jen.Lit(property), jen.Id("clientParameterValue").Call(value))
+		}
+	}
+
+	switch {
+	case generator.isOptionalProperty(schema, property):
+		return jen.If(jen.List(jen.Id("value"), jen.Id("ok")).Op(":=").Add(field).Dot("Get").Call(), jen.Id("ok")).Block(encode(jen.Id("value")))
+	case slices.Contains(schema.Required, property):
+		return encode(field)
+	}
+
+	return jen.If(jen.Op("!").Qual("reflect", "ValueOf").Call(field).Dot("IsZero").Call()).Block(encode(field))
+}
+
+// clientFormFile writes an uploaded file, or the content of a []byte property, as a file of a multipart body.
+func (generator *Generator) clientFormFile(property string, asFile bool, value jen.Code) jen.Code {
+	generator.useClientFormFiles = true
+
+	write := jen.Id("clientFormBytes").Call(jen.Id("writer"), jen.Lit(property), value)
+	if asFile {
+		write = jen.Id("clientFormFile").Call(jen.Id("writer"), jen.Lit(property), value)
+	}
+
+	return jen.If(jen.Id("err").Op(":=").Add(write), jen.Id("err").Op("!=").Nil()).Block(
+		jen.Return(jen.Nil(), jen.Lit(""), jen.Id("err")),
+	)
+}
+
+// clientFormHelpers are emitted once into the client when a multipart body is encoded.
+func (generator *Generator) clientFormHelpers() jen.Code {
+	fields := jen.Func().Id("clientFormFields").Params(jen.Id("writer").Op("*").Qual("mime/multipart", "Writer"), jen.Id("form").Qual("net/url", "Values")).Error().Block(
+		jen.Id("names").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(jen.Id("form"))),
+		jen.For(jen.Id("name").Op(":=").Range().Id("form")).Block(
+			jen.Id("names").Op("=").Append(jen.Id("names"), jen.Id("name")),
+		),
+		jen.Qual("sort", "Strings").Call(jen.Id("names")),
+		jen.Line().For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("names")).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("value")).Op(":=").Range().Id("form").Index(jen.Id("name"))).Block(
+				jen.If(jen.Id("err").Op(":=").Id("writer").Dot("WriteField").Call(jen.Id("name"), jen.Id("value")), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+			),
+		),
+		jen.Line().Return(jen.Nil()),
+	)
+
+	file := jen.Func().Id("clientFormFile").Params(
+		jen.Id("writer").Op("*").Qual("mime/multipart", "Writer"),
+		jen.Id("name").String(),
+		jen.Id("file").Op("*").Qual("mime/multipart", "FileHeader"),
+	).Error().Block(
+		jen.If(jen.Id("file").Op("==").Nil()).Block(jen.Return(jen.Nil())),
+		jen.Line().List(jen.Id("content"), jen.Id("err")).Op(":=").Id("file").Dot("Open").Call(),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Id("err"))),
+		jen.Defer().Id("content").Dot("Close").Call(),
+		jen.Line().List(jen.Id("part"), jen.Id("err")).Op(":=").Id("writer").Dot("CreatePart").Call(jen.Id("clientFormFileHeader").Call(
+			jen.Id("name"), jen.Id("file").Dot("Filename"), jen.Id("file").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type")))),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Id("err"))),
+		jen.Line().List(jen.Id("_"), jen.Id("err")).Op("=").Qual("io", "Copy").Call(jen.Id("part"), jen.Id("content")),
+		jen.Return(jen.Id("err")),
+	)
+
+	bytes := jen.Func().Id("clientFormBytes").Params(
+		jen.Id("writer").Op("*").Qual("mime/multipart", "Writer"),
+		jen.Id("name").String(),
+		jen.Id("content").Index().Byte(),
+	).Error().Block(
+		jen.List(jen.Id("part"), jen.Id("err")).Op(":=").Id("writer").Dot("CreatePart").Call(jen.Id("clientFormFileHeader").Call(jen.Id("name"), jen.Id("name"), jen.Lit(""))),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Id("err"))),
+		jen.Line().List(jen.Id("_"), jen.Id("err")).Op("=").Id("part").Dot("Write").Call(jen.Id("content")),
+		jen.Return(jen.Id("err")),
+	)
+
+	header := jen.Func().Id("clientFormFileHeader").Params(jen.List(jen.Id("name"), jen.Id("filename"), jen.Id("contentType")).String()).Qual("net/textproto", "MIMEHeader").Block(
+		jen.If(jen.Id("contentType").Op("==").Lit("")).Block(
+			jen.Id("contentType").Op("=").Lit("application/octet-stream"),
+		),
+		jen.Line().Id("header").Op(":=").Qual("net/textproto", "MIMEHeader").Values(),
+		jen.Id("header").Dot("Set").Call(jen.Lit("Content-Disposition"), jen.Qual("mime", "FormatMediaType").Call(jen.Lit("form-data"), jen.Map(jen.String()).String().Values(
+			jen.Lit("name").Op(":").Id("name"),
+			jen.Lit("filename").Op(":").Id("filename"),
+		))),
+		jen.Id("header").Dot("Set").Call(jen.Lit("Content-Type"), jen.Id("contentType")),
+		jen.Line().Return(jen.Id("header")),
+	)
+
+	return jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(fields, file, bytes, header)...)
+}
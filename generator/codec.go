@@ -0,0 +1,171 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// codecTypes is emitted into the routes with -codecs. Bodies are decoded and encoded by the Codec registered in the
+// Codecs passed to <Tag>Handler for their media type, DefaultCodecs registers JSON, XML, octet-stream and text.
+func (generator *Generator) codecTypes() []jen.Code {
+	if !generator.config.Codecs {
+		return nil
+	}
+
+	codecMethods := func(codec string, decode []jen.Code, encode []jen.Code) []jen.Code {
+		return []jen.Code{
+			jen.Func().Params(jen.Id(codec)).Id("Decode").Params(jen.Id("r").Qual("io", "Reader"), jen.Id("v").Any()).Error().Block(decode...),
+			jen.Func().Params(jen.Id(codec)).Id("Encode").Params(jen.Id("w").Qual("io", "Writer"), jen.Id("v").Any()).Error().Block(encode...),
+		}
+	}
+
+	marshalled := func(marshal jen.Code) []jen.Code {
+		return []jen.Code{
+			jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Add(marshal),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Id("err"))),
+			jen.Line().List(jen.Id("_"), jen.Id("err")).Op("=").Id("w").Dot("Write").Call(jen.Id("data")),
+			jen.Return(jen.Id("err")),
+		}
+	}
+
+	readAll := func(code ...jen.Code) []jen.Code {
+		return append([]jen.Code{
+			jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("io", "ReadAll").Call(jen.Id("r")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Id("err"))),
+		}, code...)
+	}
+
+	isBytes := func(value jen.Code) *jen.Statement {
+		return jen.Add(value).Dot("Kind").Call().Op("==").Qual("reflect", "Slice").Op("&&").
+			Add(value).Dot("Type").Call().Dot("Elem").Call().Dot("Kind").Call().Op("==").Qual("reflect", "Uint8")
+	}
+
+	results := []jen.Code{
+		jen.Comment("Codec decodes request bodies and encodes response bodies of the media types it is registered for.").Line().
+			Type().Id("Codec").Interface(
+			jen.Id("Decode").Params(jen.Id("r").Qual("io", "Reader"), jen.Id("v").Any()).Error(),
+			jen.Id("Encode").Params(jen.Id("w").Qual("io", "Writer"), jen.Id("v").Any()).Error(),
+		),
+		jen.Comment("Codecs registers codecs by media type. Keys may be wildcards: \"application/*+json\" matches every JSON based").Line().
+			Comment("media type, \"text/*\" every text type and \"*/*\" any type.").Line().
+			Type().Id("Codecs").Map(jen.String()).Id("Codec"),
+		jen.Comment("DefaultCodecs registers JSON, XML, octet-stream and text codecs, further codecs can be added to the returned registry.").Line().
+			Func().Id("DefaultCodecs").Params().Id("Codecs").Block(
+			jen.Return(jen.Id("Codecs").Values(jen.DictFunc(func(dict jen.Dict) {
+				dict[jen.Lit("application/json")] = jen.Id("JSONCodec").Values()
+				dict[jen.Lit("application/*+json")] = jen.Id("JSONCodec").Values()
+				dict[jen.Lit("application/xml")] = jen.Id("XMLCodec").Values()
+				dict[jen.Lit("application/*+xml")] = jen.Id("XMLCodec").Values()
+				dict[jen.Lit("text/xml")] = jen.Id("XMLCodec").Values()
+				dict[jen.Lit("application/octet-stream")] = jen.Id("BytesCodec").Values()
+				dict[jen.Lit("text/*")] = jen.Id("TextCodec").Values()
+			}))),
+		),
+		jen.Comment("Lookup finds the codec of contentType, trying its media type, its structured syntax suffix (type/*+suffix),").Line().
+			Comment("its type (type/*) and */* in this order.").Line().
+			Func().Params(jen.Id("codecs").Id("Codecs")).Id("Lookup").Params(jen.Id("contentType").String()).Params(jen.Id("Codec"), jen.Bool()).Block(
+			jen.List(jen.Id("mediaType"), jen.Id("_"), jen.Id("err")).Op(":=").Qual("mime", "ParseMediaType").Call(jen.Id("contentType")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.False())),
+			jen.Line().Id("candidates").Op(":=").Index().String().Values(jen.Id("mediaType")),
+			jen.If(jen.List(jen.Id("kind"), jen.Id("subtype"), jen.Id("ok")).Op(":=").Qual("strings", "Cut").Call(jen.Id("mediaType"), jen.Lit("/")), jen.Id("ok")).Block(
+				jen.If(jen.Id("plus").Op(":=").Qual("strings", "LastIndex").Call(jen.Id("subtype"), jen.Lit("+")), jen.Id("plus").Op(">=").Lit(0)).Block(
+					jen.Id("candidates").Op("=").Append(jen.Id("candidates"), jen.Id("kind").Op("+").Lit("/*").Op("+").Id("subtype").Index(jen.Id("plus").Op(":"))),
+				),
+				jen.Id("candidates").Op("=").Append(jen.Id("candidates"), jen.Id("kind").Op("+").Lit("/*")),
+			),
+			jen.Id("candidates").Op("=").Append(jen.Id("candidates"), jen.Lit("*/*")),
+			jen.Line().For(jen.List(jen.Id("_"), jen.Id("candidate")).Op(":=").Range().Id("candidates")).Block(
+				jen.If(jen.List(jen.Id("codec"), jen.Id("ok")).Op(":=").Id("codecs").Index(jen.Id("candidate")), jen.Id("ok")).Block(
+					jen.Return(jen.Id("codec"), jen.True()),
+				),
+			),
+			jen.Line().Return(jen.Nil(), jen.False()),
+		),
+		jen.Func().Params(jen.Id("codecs").Id("Codecs")).Id("decode").Params(jen.Id("contentType").String(), jen.Id("r").Qual("io", "Reader"), jen.Id("v").Any()).Error().Block(
+			jen.List(jen.Id("codec"), jen.Id("ok")).Op(":=").Id("codecs").Dot("Lookup").Call(jen.Id("contentType")),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("no codec is registered for content type %q"), jen.Id("contentType"))),
+			),
+			jen.Line().Return(jen.Id("codec").Dot("Decode").Call(jen.Id("r"), jen.Id("v"))),
+		),
+		jen.Func().Params(jen.Id("codecs").Id("Codecs")).Id("encode").Params(jen.Id("contentType").String(), jen.Id("v").Any()).Params(jen.Index().Byte(), jen.Error()).Block(
+			jen.List(jen.Id("codec"), jen.Id("ok")).Op(":=").Id("codecs").Dot("Lookup").Call(jen.Id("contentType")),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("no codec is registered for content type %q"), jen.Id("contentType"))),
+			),
+			jen.Line().Var().Id("buf").Qual("bytes", "Buffer"),
+			jen.If(jen.Id("err").Op(":=").Id("codec").Dot("Encode").Call(jen.Op("&").Id("buf"), jen.Id("v")), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id("err")),
+			),
+			jen.Line().Return(jen.Id("buf").Dot("Bytes").Call(), jen.Nil()),
+		),
+		jen.Comment("JSONCodec decodes and encodes bodies with encoding/json.").Line().
+			Type().Id("JSONCodec").Struct(),
+	}
+
+	results = append(results, codecMethods("JSONCodec",
+		[]jen.Code{jen.Return(jen.Qual("encoding/json", "NewDecoder").Call(jen.Id("r")).Dot("Decode").Call(jen.Id("v")))},
+		marshalled(jen.Qual("encoding/json", "Marshal").Call(jen.Id("v"))))...)
+
+	results = append(results, jen.Comment("XMLCodec decodes and encodes bodies with encoding/xml.").Line().
+		Type().Id("XMLCodec").Struct())
+
+	results = append(results, codecMethods("XMLCodec",
+		[]jen.Code{jen.Return(jen.Qual("encoding/xml", "NewDecoder").Call(jen.Id("r")).Dot("Decode").Call(jen.Id("v")))},
+		marshalled(jen.Qual("encoding/xml", "Marshal").Call(jen.Id("v"))))...)
+
+	results = append(results, jen.Comment("BytesCodec passes bodies of types with []byte as their underlying type through.").Line().
+		Type().Id("BytesCodec").Struct())
+
+	results = append(results, codecMethods("BytesCodec",
+		readAll(
+			jen.Line().Id("value").Op(":=").Qual("reflect", "ValueOf").Call(jen.Id("v")),
+			jen.If(jen.Id("value").Dot("Kind").Call().Op("!=").Qual("reflect", "Pointer").Op("||").Op("!").Parens(isBytes(jen.Id("value").Dot("Elem").Call()))).Block(
+				jen.Return(jen.Qual("errors", "New").Call(jen.Lit("body is not []byte"))),
+			),
+			jen.Line().Id("value").Dot("Elem").Call().Dot("SetBytes").Call(jen.Id("data")),
+			jen.Return(jen.Nil()),
+		),
+		[]jen.Code{
+			jen.Id("value").Op(":=").Qual("reflect", "ValueOf").Call(jen.Id("v")),
+			jen.If(jen.Op("!").Parens(isBytes(jen.Id("value")))).Block(
+				jen.Return(jen.Qual("errors", "New").Call(jen.Lit("body is not []byte"))),
+			),
+			jen.Line().List(jen.Id("_"), jen.Id("err")).Op(":=").Id("w").Dot("Write").Call(jen.Id("value").Dot("Bytes").Call()),
+			jen.Return(jen.Id("err")),
+		})...)
+
+	results = append(results, jen.Comment("TextCodec reads bodies into strings or encoding.TextUnmarshalers and writes them with fmt.Fprint.").Line().
+		Type().Id("TextCodec").Struct())
+
+	results = append(results, codecMethods("TextCodec",
+		readAll(
+			jen.Line().If(jen.List(jen.Id("unmarshaler"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Qual("encoding", "TextUnmarshaler")), jen.Id("ok")).Block(
+				jen.Return(jen.Id("unmarshaler").Dot("UnmarshalText").Call(jen.Id("data"))),
+			),
+			jen.Line().Id("value").Op(":=").Qual("reflect", "ValueOf").Call(jen.Id("v")),
+			jen.If(jen.Id("value").Dot("Kind").Call().Op("!=").Qual("reflect", "Pointer").Op("||").Id("value").Dot("Elem").Call().Dot("Kind").Call().Op("!=").Qual("reflect", "String")).Block(
+				jen.Return(jen.Qual("errors", "New").Call(jen.Lit("body is not a string"))),
+			),
+			jen.Line().Id("value").Dot("Elem").Call().Dot("SetString").Call(jen.String().Parens(jen.Id("data"))),
+			jen.Return(jen.Nil()),
+		),
+		[]jen.Code{
+			jen.List(jen.Id("_"), jen.Id("err")).Op(":=").Qual("fmt", "Fprint").Call(jen.Id("w"), jen.Id("v")),
+			jen.Return(jen.Id("err")),
+		})...)
+
+	return results
+}
+
+// codecRequestBodyDecode decodes the request body with the codec of contentType, a wildcard content type of the
+// operation is decoded by the codec of the Content-Type the request was sent with.
+func (generator *Generator) codecRequestBodyDecode(contentType string) jen.Code {
+	requestContentType := jen.Lit(contentType)
+	if strings.Contains(contentType, "*") {
+		requestContentType = jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type"))
+	}
+
+	return jen.Id("decodeErr").Op("=").Id("router").Dot("codecs").Dot("decode").Call(requestContentType, jen.Id("r").Dot("Body"), jen.Op("&").Id("body"))
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// defaultLiteral returns the go literal of a scalar schema default, trimmed like a sent value when the property is
+// trimmable. Defaults of other types are unmarshalled from their JSON.
+func (generator *Generator) defaultLiteral(schema *openapi3.Schema) (jen.Code, bool) {
+	if generator.typee.hasXGoType(schema) {
+		return nil, false
+	}
+
+	switch value := schema.Default.(type) {
+	case string:
+		if isSchemaType(schema.Type, "string") && !generator.typee.isCustomType(schema) {
+			if generator.getXGoStringTrimmable(&openapi3.SchemaRef{Value: schema}) {
+				value = strings.TrimSpace(value)
+			}
+
+			return jen.Lit(value), true
+		}
+	case bool:
+		return jen.Lit(value), true
+	case float64:
+		if isSchemaType(schema.Type, "integer") {
+			return jen.Lit(int(value)), true
+		}
+
+		return jen.Lit(value), true
+	}
+
+	return nil, false
+}
+
+// defaultAssignment assigns the schema default to target inside UnmarshalJSON.
+func (generator *Generator) defaultAssignment(target jen.Code, schema *openapi3.Schema) jen.Code {
+	if literal, ok := generator.defaultLiteral(schema); ok {
+		return jen.Add(target).Op("=").Add(literal)
+	}
+
+	return jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Index().Byte().Call(jen.Lit(defaultJSON(schema))), jen.Op("&").Add(target)),
+		jen.Id("err").Op("!=").Nil()).Block(
+		jen.Return(jen.Id("err")),
+	)
+}
+
+func defaultJSON(schema *openapi3.Schema) string {
+	data, err := json.Marshal(schema.Default)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(data)
+}
+
+// parameterDefault renders the default of a non-required parameter the way it is sent in a request.
+func parameterDefault(parameter *openapi3.Parameter) (string, bool) {
+	if parameter.Required || parameter.Schema == nil || parameter.Schema.Value.Default == nil {
+		return "", false
+	}
+
+	if values, ok := parameter.Schema.Value.Default.([]interface{}); ok {
+		var rendered []string
+		for _, value := range values {
+			rendered = append(rendered, defaultString(value))
+		}
+
+		return strings.Join(rendered, parameterSeparator(serializationMethod(parameter).Style)), true
+	}
+
+	return defaultString(parameter.Schema.Value.Default), true
+}
+
+func defaultString(value interface{}) string {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// withDefault falls back to the default of a missing value, so it is parsed and validated like a sent one.
+func (generator *Generator) withDefault(source jen.Code, defaultValue string) jen.Code {
+	generator.useDefaults = true
+
+	return jen.Id("valueOrDefault").Call(source, jen.Lit(defaultValue))
+}
+
+// parameterValue returns the raw string value of a parameter with its default applied.
+func (generator *Generator) parameterValue(in string, parameter *openapi3.Parameter) jen.Code {
+	source := generator.parameterSource(in, parameter.Name)
+	if defaultValue, ok := parameterDefault(parameter); ok {
+		return generator.withDefault(source, defaultValue)
+	}
+
+	return source
+}
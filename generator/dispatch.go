@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"mime"
+	"slices"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// mediaTypeOf returns the media type of a content type key of the spec without its parameters, so that
+// application/json; charset=utf-8 is matched as application/json.
+func mediaTypeOf(contentType string) string {
+	parsed, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+
+	return parsed
+}
+
+// contentTypeSpecificity orders the content types of a request body from exact media types over type/* to */*.
+func contentTypeSpecificity(contentType string) int {
+	switch {
+	case contentType == "*/*":
+		return 2
+	case strings.HasSuffix(contentType, "/*"):
+		return 1
+	}
+
+	return 0
+}
+
+// contentTypeDispatcher is the single route of an operation with several request body content types. It hands the
+// request to the wrapper of the first content type matching its Content-Type, a request without one to the wrapper of
+// the most specific content type. A request matching none is answered 415 and reported through RequestBodyUnmarshalFailed.
+func (generator *Generator) contentTypeDispatcher(name string, routerName string, operation *openapi3.Operation) jen.Code {
+	generator.useContentTypeMatch = true
+
+	contentTypes := sortedMapKeys(operation.RequestBody.Value.Content)
+	slices.SortStableFunc(contentTypes, func(a, b string) int {
+		return contentTypeSpecificity(mediaTypeOf(a)) - contentTypeSpecificity(mediaTypeOf(b))
+	})
+
+	var cases []jen.Code
+	for _, contentType := range contentTypes {
+		cases = append(cases, jen.Case(jen.Id("isContentTypeSupported").Call(jen.Id("r"), jen.Lit(mediaTypeOf(contentType)))).Block(
+			jen.Id("router").Dot(name+generator.normalizer.contentType(contentType)).Call(jen.Id("w"), jen.Id("r")),
+		))
+	}
+
+	respond := jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Qual("net/http", "StatusText").Call(jen.Qual("net/http", "StatusUnsupportedMediaType")), jen.Qual("net/http", "StatusUnsupportedMediaType"))
+	if generator.config.ProblemDetails {
+		respond = jen.Id("router").Dot("problems").Dot("RespondProblem").Call(jen.Id("w"), jen.Id("r"), jen.Lit(name), jen.Id("result"))
+	}
+
+	cases = append(cases, jen.Default().Block(
+		jen.Id("result").Op(":=").Id("RequestProcessingResult").Values(
+			jen.Id("error").Op(":").Qual("fmt", "Errorf").Call(jen.Lit("content type %q is not supported"), jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type"))),
+			jen.Id("typee").Op(":").Id("BodyContentTypeUnsupported")),
+		jen.If(jen.Id("router").Dot("hooks").Dot("RequestBodyUnmarshalFailed").Op("!=").Id("nil")).Block(
+			jen.Id("router").Dot("hooks").Dot("RequestBodyUnmarshalFailed").Call(jen.Id("r"), jen.Lit(name), jen.Id("result"))),
+		jen.Line().Add(respond),
+	))
+
+	return jen.Func().Params(jen.Id("router").Op("*").Id(routerName)).Id(name).Params(
+		jen.Id("w").Qual("net/http", "ResponseWriter"),
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+	).Block(jen.Switch().Block(cases...)).Line()
+}
+
+// contentTypeHelpers are emitted into the routes when a request body Content-Type is checked or dispatched on.
+func (generator *Generator) contentTypeHelpers() []jen.Code {
+	if !generator.config.ProblemDetails && !generator.useContentTypeMatch {
+		return nil
+	}
+
+	return []jen.Code{
+		jen.Comment("isContentTypeSupported reports whether the body of r is of contentType, a request without a Content-Type is accepted.").Line().
+			Func().Id("isContentTypeSupported").Params(jen.Id("r").Op("*").Qual("net/http", "Request"), jen.Id("contentType").String()).Bool().Block(
+			jen.Id("header").Op(":=").Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type")),
+			jen.If(jen.Id("header").Op("==").Lit("").Op("||").Id("contentType").Op("==").Lit("*/*")).Block(
+				jen.Return(jen.True()),
+			),
+			jen.Line().List(jen.Id("mediaType"), jen.Id("_"), jen.Id("err")).Op(":=").Qual("mime", "ParseMediaType").Call(jen.Id("header")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.False()),
+			),
+			jen.Line().If(jen.List(jen.Id("prefix"), jen.Id("ok")).Op(":=").Qual("strings", "CutSuffix").Call(jen.Id("contentType"), jen.Lit("/*")), jen.Id("ok")).Block(
+				jen.Return(jen.Qual("strings", "HasPrefix").Call(jen.Id("mediaType"), jen.Id("prefix").Op("+").Lit("/"))),
+			),
+			jen.Line().Return(jen.Qual("strings", "EqualFold").Call(jen.Id("mediaType"), jen.Id("contentType"))),
+		),
+	}
+}
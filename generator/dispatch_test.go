@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mikekonan/go-oas3/configurator"
+)
+
+func TestContentTypeSpecificity(t *testing.T) {
+	tests := []struct {
+		contentType       string
+		expectSpecificity int
+	}{
+		{contentType: "application/json", expectSpecificity: 0},
+		{contentType: "application/x-www-form-urlencoded", expectSpecificity: 0},
+		{contentType: "image/*", expectSpecificity: 1},
+		{contentType: "*/*", expectSpecificity: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			if specificity := contentTypeSpecificity(tt.contentType); specificity != tt.expectSpecificity {
+				t.Errorf("Expected the specificity %d, got %d", tt.expectSpecificity, specificity)
+			}
+		})
+	}
+}
+
+func TestMediaTypeOf(t *testing.T) {
+	tests := []struct {
+		contentType     string
+		expectMediaType string
+	}{
+		{contentType: "application/json", expectMediaType: "application/json"},
+		{contentType: "application/json; charset=utf-8", expectMediaType: "application/json"},
+		{contentType: "not a media type;", expectMediaType: "not a media type;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			if mediaType := mediaTypeOf(tt.contentType); mediaType != tt.expectMediaType {
+				t.Errorf("Expected the media type %s, got %s", tt.expectMediaType, mediaType)
+			}
+		})
+	}
+}
+
+func TestContentTypeDispatcher(t *testing.T) {
+	generator := newTestGenerator(configurator.Config{})
+
+	content := openapi3.NewContent()
+	for _, contentType := range []string{"*/*", "image/*", "application/json; charset=utf-8", "application/x-www-form-urlencoded"} {
+		content[contentType] = openapi3.NewMediaType().WithSchema(openapi3.NewObjectSchema())
+	}
+	operation := &openapi3.Operation{RequestBody: &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().WithContent(content)}}
+
+	code := fmt.Sprintf("%#v", generator.contentTypeDispatcher("PostPets", "petsRouter", operation))
+
+	expectOrder := []string{
+		`case isContentTypeSupported(r, "application/json"):`,
+		`case isContentTypeSupported(r, "application/x-www-form-urlencoded"):`,
+		`case isContentTypeSupported(r, "image/*"):`,
+		`case isContentTypeSupported(r, "*/*"):`,
+		`default:`,
+	}
+
+	position := 0
+	for _, expected := range expectOrder {
+		index := strings.Index(code[position:], expected)
+		if index < 0 {
+			t.Fatalf("Expected the cases in the order %v, got:\n%s", expectOrder, code)
+		}
+		position += index + len(expected)
+	}
+
+	if !generator.useContentTypeMatch {
+		t.Error("Expected useContentTypeMatch to be set")
+	}
+
+	if !strings.Contains(code, `http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)`) {
+		t.Errorf("Expected an unsupported content type to be answered 415, got:\n%s", code)
+	}
+}
@@ -0,0 +1,334 @@
+package generator
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	formURLEncoded    = "application/x-www-form-urlencoded"
+	multipartFormData = "multipart/form-data"
+)
+
+// isFormContentType reports whether bodies of contentType are bound property by property from the parsed form
+// instead of being decoded as a whole.
+func isFormContentType(contentType string) bool {
+	return mediaTypeOf(contentType) == formURLEncoded || mediaTypeOf(contentType) == multipartFormData
+}
+
+// inlineRequestBodyComponent generates the component of an inline request body, binary properties of a multipart body
+// are bound to the uploaded *multipart.FileHeader.
+func (generator *Generator) inlineRequestBodyComponent(name string, contentType string, schema *openapi3.SchemaRef) jen.Code {
+	generator.typee.binaryAsFile = mediaTypeOf(contentType) == multipartFormData
+	defer func() { generator.typee.binaryAsFile = false }()
+
+	return generator.componentFromSchema(name, schema)
+}
+
+// formRequestBodyDecode binds a form body into body with the decoder of its type and content type.
+func (generator *Generator) formRequestBodyDecode(bodyTypeName string, contentType string, operation *openapi3.Operation) jen.Code {
+	mediaType := operation.RequestBody.Value.Content[contentType]
+	decoderName := "decode" + bodyTypeName + generator.normalizer.contentType(contentType)
+
+	if generator.formDecoders == nil {
+		generator.formDecoders = map[string]jen.Code{}
+	}
+
+	if _, ok := generator.formDecoders[decoderName]; !ok {
+		generator.formDecoders[decoderName] = generator.formDecoder(decoderName, bodyTypeName, contentType, mediaType)
+	}
+
+	return jen.Id("decodeErr").Op("=").Id(decoderName).Call(jen.Id("r"), jen.Op("&").Id("body"))
+}
+
+// formDecoder parses the form of the request and binds its values property by property. Scalars are parsed like
+// parameters, arrays are repeated values or a single value delimited by the style of their encoding and objects are
+// JSON encoded values. Binary properties of a multipart body are the uploaded files, checked against the content type
+// and the required headers of their encoding.
+func (generator *Generator) formDecoder(decoderName string, bodyTypeName string, contentType string, mediaType *openapi3.MediaType) jen.Code {
+	contentType = mediaTypeOf(contentType)
+	schema := mediaType.Schema.Value
+	asFile := contentType == multipartFormData && mediaType.Schema.Ref == ""
+
+	parse := jen.Id("r").Dot("ParseForm").Call()
+	if contentType == multipartFormData {
+		generator.useMultipart = true
+		parse = jen.Id("r").Dot("ParseMultipartForm").Call(jen.Id("multipartMaxMemory"))
+	}
+
+	code := []jen.Code{
+		jen.If(jen.Id("err").Op(":=").Add(parse), jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Id("err"))),
+	}
+
+	for _, property := range sortedMapKeys(schema.Properties) {
+		code = append(code, jen.Line().Add(generator.formProperty(bodyTypeName, contentType, asFile, schema, property, mediaType.Encoding[property])))
+	}
+
+	code = append(code, jen.Line().Return(jen.Nil()))
+
+	return jen.Func().Id(decoderName).Params(
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+		jen.Id("body").Op("*").Qual(generator.config.ComponentsPackage, bodyTypeName),
+	).Error().Block(code...)
+}
+
+// formProperty binds a single property of a form body.
+func (generator *Generator) formProperty(typeName string, contentType string, asFile bool, schema *openapi3.Schema, property string, encoding *openapi3.Encoding) jen.Code {
+	propertySchema := schema.Properties[property]
+	propertyName := generator.normalizer.normalize(property)
+	pointer := jsonPointer(property)
+	isRequired := slices.Contains(schema.Required, property)
+	isOptional := generator.isOptionalProperty(schema, property)
+
+	enumName := propertyName
+	if len(propertySchema.Value.Enum) > 0 {
+		if propertySchema.Ref != "" {
+			enumName = generator.normalizer.extractNameFromRef(propertySchema.Ref)
+		} else {
+			enumName = strings.Title(typeName) + strings.Title(propertyName) + "Enum"
+		}
+	}
+
+	assign := func(parsed jen.Code) jen.Code {
+		if isOptional {
+			parsed = jen.Qual(generator.config.ComponentsPackage, "OptionalOf").Call(parsed)
+		}
+
+		return jen.Id("body").Dot(propertyName).Op("=").Add(parsed)
+	}
+
+	failed := func(at jen.Code) func(keyword string) []jen.Code {
+		return func(keyword string) []jen.Code {
+			return []jen.Code{jen.Return(jen.Qual(generator.config.ComponentsPackage, "NewViolation").Call(jen.Lit(""), at, jen.Lit(keyword), jen.Id("err").Dot("Error").Call()))}
+		}
+	}
+
+	missing := jen.Return(generator.newViolation("", pointer, "required", jen.Lit("is required")))
+
+	goType := func(schema *openapi3.SchemaRef) jen.Code {
+		generator.typee.binaryAsFile = asFile
+		defer func() { generator.typee.binaryAsFile = false }()
+
+		into := jen.Null()
+		generator.typee.fillGoType(into, typeName, enumName, schema, false, false)
+		return into
+	}
+	propertyType := goType(propertySchema)
+
+	switch {
+	case contentType == multipartFormData && isBinarySchema(propertySchema):
+		file := jen.Id("files").Index(jen.Lit(0))
+		bind := generator.formFileCheck(file, jen.Lit(pointer), encoding)
+		if asFile {
+			bind = append(bind, assign(file))
+		} else {
+			bind = append(bind, generator.formFileBytes(file, jen.Lit(pointer), assign))
+		}
+
+		parse := jen.If(jen.Id("files").Op(":=").Id("r").Dot("MultipartForm").Dot("File").Index(jen.Lit(property)), jen.Len(jen.Id("files")).Op(">").Lit(0)).Block(bind...)
+		if isRequired {
+			parse = parse.Else().Block(missing)
+		}
+
+		return parse
+
+	case contentType == multipartFormData && isSchemaType(propertySchema.Value.Type, "array") && isBinarySchema(propertySchema.Value.Items):
+		items := generator.normalizer.decapitalize(propertyName) + "Items"
+		itemPointer := jen.Lit(pointer+"/").Op("+").Qual("strconv", "Itoa").Call(jen.Id("i"))
+		bind := generator.formFileCheck(jen.Id("file"), itemPointer, encoding)
+		if !asFile {
+			bind = append(bind, generator.formFileBytes(jen.Id("file"), itemPointer, func(parsed jen.Code) jen.Code {
+				return jen.Id(items).Op("=").Append(jen.Id(items), parsed)
+			}))
+		}
+
+		files := jen.Id("r").Dot("MultipartForm").Dot("File").Index(jen.Lit(property))
+		result := jen.Null()
+		if isRequired {
+			result = result.If(jen.Len(files).Op("==").Lit(0)).Block(missing).Line().Line()
+		}
+
+		if asFile {
+			if len(bind) > 0 {
+				result = result.For(jen.List(jen.Id("i"), jen.Id("file")).Op(":=").Range().Add(files)).Block(bind...).Line().Line()
+			}
+
+			return result.If(jen.Len(files).Op(">").Lit(0)).Block(assign(files))
+		}
+
+		return result.
+			Var().Id(items).Add(propertyType).Line().
+			For(jen.List(jen.Id("i"), jen.Id("file")).Op(":=").Range().Add(files)).Block(bind...).Line().
+			Line().If(jen.Len(jen.Id(items)).Op(">").Lit(0)).Block(assign(jen.Id(items)))
+
+	case isSchemaType(propertySchema.Value.Type, "array"):
+		return generator.formArray(property, propertyName, pointer, propertySchema.Value.Items, propertyType, goType(propertySchema.Value.Items), contentType, encoding, isRequired, assign, failed, missing)
+	}
+
+	var source jen.Code = jen.Id("r").Dot("PostForm").Dot("Get").Call(jen.Lit(property))
+	if propertySchema.Value.Default != nil && !isRequired {
+		source = generator.withDefault(source, defaultString(propertySchema.Value.Default))
+	}
+
+	parse := jen.If(jen.Id("value").Op(":=").Add(source), jen.Id("value").Op("!=").Lit("")).Block(
+		generator.formValueParser(propertySchema, propertyType, jen.Id("value"), assign, failed(jen.Lit(pointer)), func() jen.Code { return jen.Lit(pointer) }),
+	)
+
+	if isRequired {
+		parse = parse.Else().Block(missing)
+	}
+
+	return parse
+}
+
+// formArray binds the repeated values of an array property, a single value is split when the style of its encoding
+// delimits the items.
+func (generator *Generator) formArray(property string, propertyName string, pointer string, items *openapi3.SchemaRef, propertyType jen.Code, itemType jen.Code, contentType string, encoding *openapi3.Encoding, isRequired bool, assign func(parsed jen.Code) jen.Code, failed func(pointer jen.Code) func(keyword string) []jen.Code, missing jen.Code) jen.Code {
+	// the index is declared only if parsing an item can fail
+	index := jen.Id("_")
+	itemPointer := func() jen.Code {
+		index = jen.Id("i")
+		return jen.Lit(pointer+"/").Op("+").Qual("strconv", "Itoa").Call(jen.Id("i"))
+	}
+	itemFailed := func(keyword string) []jen.Code {
+		return failed(itemPointer())(keyword)
+	}
+
+	values := generator.normalizer.decapitalize(propertyName) + "Values"
+	parsedItems := generator.normalizer.decapitalize(propertyName) + "Items"
+
+	result := jen.Id(values).Op(":=").Id("r").Dot("PostForm").Index(jen.Lit(property)).Line()
+	if contentType == formURLEncoded && encoding != nil {
+		method := encoding.SerializationMethod()
+		if !method.Explode || method.Style != openapi3.SerializationForm {
+			result = result.If(jen.Len(jen.Id(values)).Op("==").Lit(1)).Block(
+				jen.Id(values).Op("=").Qual("strings", "Split").Call(jen.Id(values).Index(jen.Lit(0)), jen.Lit(parameterSeparator(method.Style))),
+			).Line()
+		}
+	}
+
+	if isRequired {
+		result = result.If(jen.Len(jen.Id(values)).Op("==").Lit(0)).Block(missing).Line()
+	}
+
+	parse := generator.formValueParser(items, itemType, jen.Id("value"), func(parsed jen.Code) jen.Code {
+		return jen.Id(parsedItems).Op("=").Append(jen.Id(parsedItems), parsed)
+	}, itemFailed, itemPointer)
+
+	return result.Line().
+		Var().Id(parsedItems).Add(propertyType).Line().
+		For(jen.List(index, jen.Id("value")).Op(":=").Range().Id(values)).Block(parse).Line().
+		Line().If(jen.Len(jen.Id(parsedItems)).Op(">").Lit(0)).Block(assign(jen.Id(parsedItems)))
+}
+
+// formValueParser parses a single form value, objects are JSON encoded and binary values are taken as they are. The
+// violations of an object are prefixed with the pointer of the value.
+func (generator *Generator) formValueParser(schema *openapi3.SchemaRef, goType jen.Code, value jen.Code, assign func(parsed jen.Code) jen.Code, failed func(keyword string) []jen.Code, pointer func() jen.Code) jen.Code {
+	if isBinarySchema(schema) {
+		return assign(jen.Index().Byte().Parens(value))
+	}
+
+	if isJSONFormValue(schema) {
+		return jen.Null().
+			Add(jen.Var().Id("parsed").Add(goType)).Line().
+			Add(jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Index().Byte().Parens(value), jen.Op("&").Id("parsed")), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Qual(generator.config.ComponentsPackage, "ValidationErrorOf").Call(jen.Lit(""), pointer(), jen.Id("err"))),
+			)).Line().Line().
+			Add(assign(jen.Id("parsed")))
+	}
+
+	return generator.parameterValueParser(schema, goType, value, assign, failed)
+}
+
+// formFileCheck checks an uploaded file against the content type and the required headers of its encoding.
+func (generator *Generator) formFileCheck(file jen.Code, pointer jen.Code, encoding *openapi3.Encoding) (checks []jen.Code) {
+	if encoding == nil {
+		return nil
+	}
+
+	if encoding.ContentType != "" {
+		generator.useFormFileContentType = true
+
+		var accepted []jen.Code
+		for _, contentType := range strings.Split(encoding.ContentType, ",") {
+			accepted = append(accepted, jen.Lit(strings.TrimSpace(contentType)))
+		}
+
+		checks = append(checks, jen.If(jen.Op("!").Id("formFileHasContentType").Call(append([]jen.Code{file}, accepted...)...)).Block(
+			jen.Return(jen.Qual(generator.config.ComponentsPackage, "NewViolation").Call(jen.Lit(""), pointer, jen.Lit("contentMediaType"), jen.Lit("content type is not "+encoding.ContentType))),
+		).Line())
+	}
+
+	for _, header := range sortedMapKeys(encoding.Headers) {
+		if !encoding.Headers[header].Value.Required {
+			continue
+		}
+
+		checks = append(checks, jen.If(jen.Add(file).Dot("Header").Dot("Get").Call(jen.Lit(header)).Op("==").Lit("")).Block(
+			jen.Return(jen.Qual(generator.config.ComponentsPackage, "NewViolation").Call(jen.Lit(""), pointer, jen.Lit("required"), jen.Lit(header+" header is required"))),
+		).Line())
+	}
+
+	return checks
+}
+
+// formFileBytes reads the content of an uploaded file into a []byte property.
+func (generator *Generator) formFileBytes(file jen.Code, pointer jen.Code, assign func(parsed jen.Code) jen.Code) jen.Code {
+	generator.useFormFileBytes = true
+
+	return jen.Null().
+		Add(jen.List(jen.Id("content"), jen.Id("err")).Op(":=").Id("formFileBytes").Call(file)).Line().
+		Add(jen.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Qual(generator.config.ComponentsPackage, "ValidationErrorOf").Call(jen.Lit(""), pointer, jen.Id("err"))),
+		)).Line().Line().
+		Add(assign(jen.Id("content")))
+}
+
+// formHelpers are emitted once into the routes when a form body is bound.
+func (generator *Generator) formHelpers() (helpers []jen.Code) {
+	if generator.useMultipart {
+		helpers = append(helpers, jen.Comment("multipartMaxMemory is the number of bytes of a multipart body kept in memory, larger files are stored on disk.").Line().
+			Const().Id("multipartMaxMemory").Op("=").Lit(int(generator.config.MultipartMaxMemory)).Line())
+	}
+
+	for _, name := range sortedMapKeys(generator.formDecoders) {
+		helpers = append(helpers, jen.Add(generator.formDecoders[name]).Line())
+	}
+
+	if generator.useFormFileContentType {
+		helpers = append(helpers, jen.Func().Id("formFileHasContentType").Params(jen.Id("file").Op("*").Qual("mime/multipart", "FileHeader"), jen.Id("accepted").Op("...").String()).Bool().Block(
+			jen.List(jen.Id("mediaType"), jen.Id("_"), jen.Id("err")).Op(":=").Qual("mime", "ParseMediaType").Call(jen.Id("file").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type"))),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.False())),
+			jen.Line().For(jen.List(jen.Id("_"), jen.Id("pattern")).Op(":=").Range().Id("accepted")).Block(
+				jen.If(jen.List(jen.Id("matched"), jen.Id("_")).Op(":=").Qual("path", "Match").Call(jen.Id("pattern"), jen.Id("mediaType")), jen.Id("matched")).Block(
+					jen.Return(jen.True()),
+				),
+			),
+			jen.Line().Return(jen.False()),
+		).Line())
+	}
+
+	if generator.useFormFileBytes {
+		helpers = append(helpers, jen.Func().Id("formFileBytes").Params(jen.Id("file").Op("*").Qual("mime/multipart", "FileHeader")).Params(jen.Index().Byte(), jen.Error()).Block(
+			jen.List(jen.Id("content"), jen.Id("err")).Op(":=").Id("file").Dot("Open").Call(),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+			jen.Defer().Id("content").Dot("Close").Call(),
+			jen.Line().Return(jen.Qual("io", "ReadAll").Call(jen.Id("content"))),
+		).Line())
+	}
+
+	return helpers
+}
+
+func isBinarySchema(schema *openapi3.SchemaRef) bool {
+	return schema != nil && isSchemaType(schema.Value.Type, "string") && schema.Value.Format == "binary"
+}
+
+// isJSONFormValue tells whether a form value of schema is a JSON encoded value rather than a formatted scalar.
+func isJSONFormValue(schema *openapi3.SchemaRef) bool {
+	return len(schema.Value.Enum) == 0 && !isSchemaType(schema.Value.Type, "string") && !isSchemaType(schema.Value.Type, "integer") &&
+		!isSchemaType(schema.Value.Type, "number") && !isSchemaType(schema.Value.Type, "boolean")
+}
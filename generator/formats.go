@@ -0,0 +1,157 @@
+package generator
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// preciseTimeParser returns the call parsing a raw date-time, date or duration value when precise types are enabled.
+func (generator *Generator) preciseTimeParser(schema *openapi3.Schema, value jen.Code) (jen.Code, bool) {
+	if !generator.typee.isPreciseTimeType(schema) {
+		return nil, false
+	}
+
+	switch schema.Format {
+	case "date-time":
+		return jen.Qual("time", "Parse").Call(jen.Qual("time", "RFC3339"), value), true
+	case "date":
+		return jen.Qual(generator.config.ComponentsPackage, "ParseDate").Call(value), true
+	default:
+		return jen.Qual(generator.config.ComponentsPackage, "ParseDuration").Call(value), true
+	}
+}
+
+// formatTypes is emitted into the components after the routes are generated, so the civil types used only by parameters are included.
+func (generator *Generator) formatTypes() []jen.Code {
+	var result []jen.Code
+
+	if generator.typee.useDate {
+		result = append(result,
+			jen.Comment("Date is a civil date without a time and a location, it is marshalled as 2006-01-02 and the zero Date as 0001-01-01 like the zero time.Time.").Line().Type().Id("Date").Struct(
+				jen.Id("Year").Int(),
+				jen.Id("Month").Qual("time", "Month"),
+				jen.Id("Day").Int(),
+			),
+			jen.Func().Id("ParseDate").Params(jen.Id("value").String()).Params(jen.Id("Date"), jen.Error()).Block(
+				jen.List(jen.Id("parsed"), jen.Id("err")).Op(":=").Qual("time", "Parse").Call(jen.Lit("2006-01-02"), jen.Id("value")),
+				jen.If(jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("Date").Values(), jen.Id("err")),
+				),
+				jen.Line().Return(jen.Id("DateOf").Call(jen.Id("parsed")), jen.Nil()),
+			),
+			jen.Func().Id("DateOf").Params(jen.Id("t").Qual("time", "Time")).Id("Date").Block(
+				jen.List(jen.Id("year"), jen.Id("month"), jen.Id("day")).Op(":=").Id("t").Dot("Date").Call(),
+				jen.Return(jen.Id("Date").Values(jen.Id("Year").Op(":").Id("year"), jen.Id("Month").Op(":").Id("month"), jen.Id("Day").Op(":").Id("day"))),
+			),
+			jen.Func().Params(jen.Id("date").Id("Date")).Id("String").Params().String().Block(
+				jen.If(jen.Id("date").Dot("IsZero").Call()).Block(
+					jen.Return(jen.Lit("0001-01-01")),
+				),
+				jen.Line().Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit("%04d-%02d-%02d"), jen.Id("date").Dot("Year"), jen.Id("date").Dot("Month"), jen.Id("date").Dot("Day"))),
+			),
+			jen.Func().Params(jen.Id("date").Id("Date")).Id("IsZero").Params().Bool().Block(
+				jen.Return(jen.Id("date").Op("==").Id("Date").Values()),
+			),
+			jen.Func().Params(jen.Id("date").Id("Date")).Id("In").Params(jen.Id("location").Op("*").Qual("time", "Location")).Qual("time", "Time").Block(
+				jen.Return(jen.Qual("time", "Date").Call(jen.Id("date").Dot("Year"), jen.Id("date").Dot("Month"), jen.Id("date").Dot("Day"), jen.Lit(0), jen.Lit(0), jen.Lit(0), jen.Lit(0), jen.Id("location"))),
+			),
+			jen.Func().Params(jen.Id("date").Id("Date")).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+				jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("date").Dot("String").Call())),
+			),
+			jen.Func().Params(jen.Id("date").Op("*").Id("Date")).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(
+				jen.If(jen.String().Call(jen.Id("data")).Op("==").Lit("null")).Block(
+					jen.Return(jen.Nil()),
+				),
+				jen.Line().Var().Id("value").String(),
+				jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("value")), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+				jen.Line().List(jen.Id("parsed"), jen.Id("err")).Op(":=").Id("ParseDate").Call(jen.Id("value")),
+				jen.If(jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+				jen.Line().Op("*").Id("date").Op("=").Id("parsed"),
+				jen.Return(jen.Nil()),
+			),
+		)
+	}
+
+	if generator.typee.useDuration {
+		result = append(result,
+			jen.Var().Id("durationPattern").Op("=").Qual("regexp", "MustCompile").Call(jen.Lit(`^(-)?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)),
+			jen.Comment("Duration is marshalled as an ISO 8601 duration, e.g. PT1H30M.").Line().Type().Id("Duration").Qual("time", "Duration"),
+			jen.Comment("ParseDuration parses an ISO 8601 duration of weeks, days, hours, minutes and seconds, a day is 24 hours.").Line().
+				Comment("Years and months have no fixed length and are not supported.").Line().
+				Func().Id("ParseDuration").Params(jen.Id("value").String()).Params(jen.Id("Duration"), jen.Error()).Block(
+				jen.Id("match").Op(":=").Id("durationPattern").Dot("FindStringSubmatch").Call(jen.Id("value")),
+				jen.If(jen.Id("match").Op("==").Nil().Op("||").Qual("strings", "HasSuffix").Call(jen.Id("value"), jen.Lit("P")).Op("||").Qual("strings", "HasSuffix").Call(jen.Id("value"), jen.Lit("T"))).Block(
+					jen.Return(jen.Lit(0), jen.Qual("fmt", "Errorf").Call(jen.Lit("invalid ISO 8601 duration '%s'"), jen.Id("value"))),
+				),
+				jen.Line().Var().Id("duration").Qual("time", "Duration"),
+				jen.For(jen.List(jen.Id("i"), jen.Id("unit")).Op(":=").Range().Index().Qual("time", "Duration").Values(
+					jen.Lit(7).Op("*").Lit(24).Op("*").Qual("time", "Hour"),
+					jen.Lit(24).Op("*").Qual("time", "Hour"),
+					jen.Qual("time", "Hour"),
+					jen.Qual("time", "Minute"),
+					jen.Qual("time", "Second"),
+				)).Block(
+					jen.If(jen.Id("match").Index(jen.Id("i").Op("+").Lit(2)).Op("==").Lit("")).Block(
+						jen.Continue(),
+					),
+					jen.Line().List(jen.Id("amount"), jen.Id("err")).Op(":=").Qual("strconv", "ParseFloat").Call(jen.Id("match").Index(jen.Id("i").Op("+").Lit(2)), jen.Lit(64)),
+					jen.If(jen.Id("err").Op("!=").Nil()).Block(
+						jen.Return(jen.Lit(0), jen.Id("err")),
+					),
+					jen.Line().Id("duration").Op("+=").Qual("time", "Duration").Call(jen.Id("amount").Op("*").Float64().Call(jen.Id("unit"))),
+				),
+				jen.Line().If(jen.Id("match").Index(jen.Lit(1)).Op("==").Lit("-")).Block(
+					jen.Id("duration").Op("=").Op("-").Id("duration"),
+				),
+				jen.Line().Return(jen.Id("Duration").Call(jen.Id("duration")), jen.Nil()),
+			),
+			jen.Comment("String formats the duration in hours, minutes and seconds, e.g. PT36H or PT1M30.5S.").Line().
+				Func().Params(jen.Id("duration").Id("Duration")).Id("String").Params().String().Block(
+				jen.Id("remaining").Op(":=").Qual("time", "Duration").Call(jen.Id("duration")),
+				jen.If(jen.Id("remaining").Op("==").Lit(0)).Block(
+					jen.Return(jen.Lit("PT0S")),
+				),
+				jen.Line().Id("result").Op(":=").Lit("PT"),
+				jen.If(jen.Id("remaining").Op("<").Lit(0)).Block(
+					jen.List(jen.Id("result"), jen.Id("remaining")).Op("=").List(jen.Lit("-PT"), jen.Op("-").Id("remaining")),
+				),
+				jen.Line().If(jen.Id("hours").Op(":=").Id("remaining").Op("/").Qual("time", "Hour"), jen.Id("hours").Op(">").Lit(0)).Block(
+					jen.Id("result").Op("+=").Qual("strconv", "FormatInt").Call(jen.Int64().Call(jen.Id("hours")), jen.Lit(10)).Op("+").Lit("H"),
+					jen.Id("remaining").Op("-=").Id("hours").Op("*").Qual("time", "Hour"),
+				),
+				jen.If(jen.Id("minutes").Op(":=").Id("remaining").Op("/").Qual("time", "Minute"), jen.Id("minutes").Op(">").Lit(0)).Block(
+					jen.Id("result").Op("+=").Qual("strconv", "FormatInt").Call(jen.Int64().Call(jen.Id("minutes")), jen.Lit(10)).Op("+").Lit("M"),
+					jen.Id("remaining").Op("-=").Id("minutes").Op("*").Qual("time", "Minute"),
+				),
+				jen.If(jen.Id("remaining").Op(">").Lit(0)).Block(
+					jen.Id("result").Op("+=").Qual("strconv", "FormatFloat").Call(jen.Id("remaining").Dot("Seconds").Call(), jen.LitRune('f'), jen.Lit(-1), jen.Lit(64)).Op("+").Lit("S"),
+				),
+				jen.Line().Return(jen.Id("result")),
+			),
+			jen.Func().Params(jen.Id("duration").Id("Duration")).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+				jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("duration").Dot("String").Call())),
+			),
+			jen.Func().Params(jen.Id("duration").Op("*").Id("Duration")).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(
+				jen.If(jen.String().Call(jen.Id("data")).Op("==").Lit("null")).Block(
+					jen.Return(jen.Nil()),
+				),
+				jen.Line().Var().Id("value").String(),
+				jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("value")), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+				jen.Line().List(jen.Id("parsed"), jen.Id("err")).Op(":=").Id("ParseDuration").Call(jen.Id("value")),
+				jen.If(jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+				jen.Line().Op("*").Id("duration").Op("=").Id("parsed"),
+				jen.Return(jen.Nil()),
+			),
+		)
+	}
+
+	return result
+}
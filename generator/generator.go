@@ -23,12 +23,51 @@ type Generator struct {
 
 	// optimize code generator for regexp
 	useRegex map[string]string
+	// unions without a discriminator share the unmarshalUnionVariant helper
+	useUnions bool
+	// allOf structs share the marshalAllOf helper
+	useAllOf bool
+	// deepObject parameters of maps share the deepObjectSent helper
+	useDeepObjectMaps bool
+	// cookie parameters share the cookieValue and cookieSent helpers
+	useCookies bool
+	// Optional[T] properties share the Optional type
+	useOptional bool
+	// parameter defaults share the valueOrDefault helper
+	useDefaults bool
+	// default and range responses share the statusCodeErr of the response
+	useStatusCodeErrors bool
+	// rules missing in ozzo are generated once as UniqueItems, MultipleOf, MinCount, Minimum and Maximum
+	useUniqueItems bool
+	useMultipleOf  bool
+	useMinCount    bool
+	useMinimum     bool
+	useMaximum     bool
+	// form bodies are bound by a decoder per body type and content type
+	formDecoders map[string]jen.Code
+	// multipart bodies share the multipartMaxMemory constant, uploaded files the formFile helpers
+	useMultipart           bool
+	useFormFileContentType bool
+	useFormFileBytes       bool
+	// form bodies of the client are encoded by an encoder per body type and content type, uploaded files share clientFormFile
+	clientFormEncoders map[string]jen.Code
+	useClientFormFiles bool
+	// operations with several request body content types dispatch with isContentTypeSupported
+	useContentTypeMatch bool
+	// streamed responses share the bodyStream field and contextReader, event streams the events field and writeEvent
+	useStreams bool
+	useEvents  bool
+	// oauth2 and openIdConnect schemes are authorized with the scopes of a security requirement
+	scopedSchemes map[string]bool
+	// mutualTLS schemes are checked with the verified client certificate
+	mutualTLSSchemes map[string]bool
 }
 
 type Result struct {
 	ComponentsCode *jen.File
 	RouterCode     *jen.File
 	SpecCode       *jen.File
+	ClientCode     *jen.File
 }
 
 // sortedMapKeys returns sorted keys from any map to ensure deterministic iteration
@@ -73,13 +112,23 @@ func (generator *Generator) file(from jen.Code, packagePath string) *jen.File {
 	file.ImportAlias("github.com/mikekonan/go-types/v2/currency", "currency")
 	file.ImportAlias("github.com/go-ozzo/ozzo-validation/v4", "validation")
 	file.ImportAlias("github.com/go-chi/chi/v5", "chi")
+	file.ImportAlias("github.com/labstack/echo/v4", "echo")
+	file.ImportAlias("github.com/gin-gonic/gin", "gin")
+	file.ImportAlias("github.com/gofiber/fiber/v2", "fiber")
 
 	file.Add(from)
 
 	return file
 }
 
-func (generator *Generator) Generate(swagger *openapi3.T) *Result {
+func (generator *Generator) Generate(swagger *openapi3.T) (*Result, error) {
+	if err := generator.checkPaths(swagger); err != nil {
+		return nil, err
+	}
+
+	generator.scopedSchemes = securitySchemeNames(swagger, isScopedScheme)
+	generator.mutualTLSSchemes = securitySchemeNames(swagger, isMutualTLS)
+
 	componentsAdditionalVars, parametersAdditionalVars := generator.additionalConstants(swagger)
 
 	componentsCode := jen.Null().Add(componentsAdditionalVars, generator.components(swagger))
@@ -89,11 +138,22 @@ func (generator *Generator) Generate(swagger *openapi3.T) *Result {
 		Add(generator.requestResponseBuilders(swagger)).Line().
 		Add(generator.securitySchemas(swagger))
 
-	return &Result{
+	componentsCode.Add(generator.normalizer.doubleLineAfterEachElement(generator.formatTypes()...)...)
+	componentsCode.Add(generator.normalizer.doubleLineAfterEachElement(generator.validationHelpers()...)...)
+	componentsCode.Add(generator.normalizer.doubleLineAfterEachElement(generator.validationErrorTypes()...)...)
+	componentsCode.Add(generator.normalizer.doubleLineAfterEachElement(generator.mergeViolations()...)...)
+
+	result := &Result{
 		ComponentsCode: generator.file(componentsCode, generator.config.ComponentsPackage),
 		RouterCode:     generator.file(routerCode, generator.config.Package),
 		SpecCode:       generator.file(generator.specCode(swagger), generator.config.Package),
 	}
+
+	if generator.config.GenerateClient {
+		result.ClientCode = generator.file(generator.clientCode(swagger), generator.config.Package)
+	}
+
+	return result, nil
 }
 
 func (generator *Generator) requestParameters(paths map[string]*openapi3.PathItem) jen.Code {
@@ -210,7 +270,7 @@ func (generator *Generator) components(swagger *openapi3.T) jen.Code {
 							name += "RequestBody"
 							obj := sortedMapEntries(operation.RequestBody.Value.Content)[0].Value
 
-							result[name] = generator.componentFromSchema(name, obj.Schema)
+							result[name] = generator.inlineRequestBodyComponent(name, sortedMapEntries(operation.RequestBody.Value.Content)[0].Key, obj.Schema)
 							return linq.From(toLinqKeyValue(sortedMapEntries(result)))
 						}
 
@@ -223,15 +283,15 @@ func (generator *Generator) components(swagger *openapi3.T) jen.Code {
 									meType := entry.Value
 
 									objName := name + generator.normalizer.contentType(entry.Key+"RequestBody")
-									return generator.componentFromSchema(objName, meType.Schema)
+									return generator.inlineRequestBodyComponent(objName, entry.Key, meType.Schema)
 								})
 
 						return linq.From(toLinqKeyValue(sortedMapEntries(result)))
 					},
 				).
 				ToMapByT(&componentsByName,
-					func(entry sortedKeyValue[string, jen.Code]) interface{} { return entry.Key },
-					func(entry sortedKeyValue[string, jen.Code]) interface{} { return entry.Value })
+					func(kv linq.KeyValue) interface{} { return kv.Key },
+					func(kv linq.KeyValue) interface{} { return kv.Value })
 
 			return linq.From(toLinqKeyValue(sortedMapEntries(componentsByName)))
 		}).
@@ -279,6 +339,12 @@ func (generator *Generator) components(swagger *openapi3.T) jen.Code {
 		Add(componentsFromPathsResult...).
 		Add(jen.Line()).
 		Add(generator.enums(swagger)).
+		Add(jen.Line()).
+		Add(generator.unionHelpers()).
+		Add(jen.Line()).
+		Add(generator.allOfHelpers()).
+		Add(jen.Line()).
+		Add(generator.optionalHelpers()).
 		Add(jen.Line())
 }
 
@@ -320,19 +386,19 @@ func (generator *Generator) additionalConstants(swagger *openapi3.T) (jen.Code,
 	slices.Sort(schemaNames)
 
 	for _, schemaName := range schemaNames {
-		schema := swagger.Components.Schemas[schemaName]
+		properties := inlineProperties(swagger.Components.Schemas[schemaName].Value)
 
 		// Sort property names to ensure deterministic property constants generation order
 		var propNames []string
-		for propName := range schema.Value.Properties {
+		for propName := range properties {
 			propNames = append(propNames, propName)
 		}
 		slices.Sort(propNames)
 
 		for _, propName := range propNames {
-			propSchema := schema.Value.Properties[propName]
+			propSchema := properties[propName]
 			name := generator.normalizer.normalize(strings.Title(propName))
-			constantsComponentsCode = append(constantsComponentsCode, generator.variableForRegex(name, propSchema))
+			constantsComponentsCode = append(constantsComponentsCode, generator.variableForRegex(name, propSchema), generator.variablesForPatterns(name, propSchema))
 		}
 	}
 
@@ -397,7 +463,7 @@ func (generator *Generator) additionalConstants(swagger *openapi3.T) (jen.Code,
 				for _, propName := range propNames {
 					propSchema := meType.Schema.Value.Properties[propName]
 					name := generator.normalizer.normalize(strings.Title(propName))
-					componentsPathsCode = append(componentsPathsCode, generator.variableForRegex(name, propSchema))
+					componentsPathsCode = append(componentsPathsCode, generator.variableForRegex(name, propSchema), generator.variablesForPatterns(name, propSchema))
 				}
 			}
 		}
@@ -434,8 +500,10 @@ func (generator *Generator) additionalConstants(swagger *openapi3.T) (jen.Code,
 			name = generator.normalizer.decapitalize(name)
 
 			for _, parameter := range operation.Parameters {
+				parameterName := generator.normalizer.normalize(strings.Title(parameter.Value.Name))
 				parametersCode = append(parametersCode,
-					generator.variableForRegex(generator.normalizer.normalize(strings.Title(parameter.Value.Name)), parameter.Value.Schema))
+					generator.variableForRegex(parameterName, parameter.Value.Schema),
+					generator.variablesForPatterns(parameterName, parameter.Value.Schema))
 			}
 		}
 	}
@@ -452,15 +520,11 @@ func (generator *Generator) requestParameterStruct(name string, contentType stri
 	var additionalParameters []parameter
 
 	if contentType != "" {
+		bodyTypeName := generator.requestBodyTypeName(name, contentType, operation)
 		if appendContentTypeToName {
 			name += generator.normalizer.contentType(contentType)
 		}
 
-		bodyTypeName := generator.normalizer.extractNameFromRef(operation.RequestBody.Value.Content[contentType].Schema.Ref)
-		if bodyTypeName == "" {
-			bodyTypeName = name + "RequestBody"
-		}
-
 		additionalParameters = append(additionalParameters,
 			parameter{In: "Body", Code: jen.Id("Body").Qual(generator.config.ComponentsPackage, bodyTypeName)})
 	}
@@ -474,40 +538,64 @@ func (generator *Generator) requestParameterStruct(name string, contentType stri
 		OrderByT(func(group linq.Group) string { return cast.ToString(group.Key) }).
 		SelectT(
 			func(group linq.Group) jen.Code {
-				var structFields []jen.Code
+				typeName := name + "Request" + strings.Title(cast.ToString(group.Key))
+
+				var structFields, objectStructs []jen.Code
 				linq.From(group.Group).
 					OrderByT(func(parameter *openapi3.ParameterRef) string { return parameter.Value.Name }).
 					SelectT(func(parameter *openapi3.ParameterRef) (result jen.Code) {
 						name := generator.normalizer.normalize(parameter.Value.Name)
 						var statement = jen.Id(name)
 
+						// add fill json tag for ozzo validation, violations point at the parameter name.
+						// Parameters:
+						//    in: header
+						//    name: my-header
+						// example: struct RequestHeader { MyHeader string }.Validate() err with msg: MyHeader invalid (but real header name is my-header)
+						// example: struct RequestHeader { MyHeader string `json:"my-header"` }.Validate() err with msg: my-header invalid (real header name is equal name in err msg)
+						// the name is kept as written in the spec, X-Trace is not decapitalized into x-Trace.
+						tagged := func(statement *jen.Statement) *jen.Statement {
+							tag := parameter.Value.Name
+							if generator.typee.getXGoOmitemptyFromSchemaRef(parameter.Value.Schema) {
+								tag += ",omitempty"
+							}
+
+							return statement.Tag(map[string]string{"json": tag})
+						}
+
 						if len(parameter.Value.Schema.Value.Enum) > 0 {
 							if len(parameter.Value.Schema.Ref) > 0 {
 								generator.typee.fillGoType(statement, "", generator.normalizer.extractNameFromRef(parameter.Value.Schema.Ref), parameter.Value.Schema, false, false)
-								return statement
+								return tagged(statement)
 							}
 
 							//todo: generate enum for anonymous type
 						}
 
-						generator.typee.fillGoType(statement, "", name, parameter.Value.Schema, false, false)
-
-						// add fill json tag for ozzo validation.
-						// Parameters:
-						//    in: header
-						//    name: my-header
-						// example: struct RequestHeader { MyHeader string }.Validate() err with msg: MyHeader invalid (but real header name is my-header)
-						// example: struct RequestHeader { MyHeader string `json:"my-header"` }.Validate() err with msg: my-header invalid (real header name is equal name in err msg)
-						if parameter.Value.In == "header" {
-							generator.typee.fillJsonTag(statement, parameter.Value.Schema, parameter.Value.Name)
+						// inline deepObject parameters get their own struct next to the parameters struct
+						if hasDeepObjectStruct(parameter.Value) {
+							objectStructs = append(objectStructs, jen.Type().Id(typeName+name).Struct(generator.typeProperties(typeName+name, parameter.Value.Schema.Value, false)...))
+							return tagged(statement.Id(typeName + name))
 						}
-						return statement
+
+						generator.parameterGoType(statement, name, parameter.Value.Schema)
+						return tagged(statement)
 					}).
 					ToSlice(&structFields)
 
-				var getters []jen.Code
+				// optional parameters of a client are flagged when they were sent, so a zero value can be told apart from an absent one
+				var flaggedFields []jen.Code
+				linq.From(group.Group).
+					OrderByT(func(parameter *openapi3.ParameterRef) string { return parameter.Value.Name }).
+					ForEachIndexedT(func(i int, parameter *openapi3.ParameterRef) {
+						flaggedFields = append(flaggedFields, structFields[i])
+						if generator.isFlaggedParameter(parameter.Value) {
+							flaggedFields = append(flaggedFields, jen.Id(generator.normalizer.normalize(parameter.Value.Name)+"Set").Bool().Tag(map[string]string{"json": "-"}))
+						}
+					})
+				structFields = flaggedFields
 
-				typeName := name + "Request" + strings.Title(cast.ToString(group.Key))
+				var getters []jen.Code
 
 				var fieldValidationRules []jen.Code
 
@@ -517,7 +605,7 @@ func (generator *Generator) requestParameterStruct(name string, contentType stri
 						name := generator.normalizer.normalize(parameter.Value.Name)
 						var statement = jen.Func().Params(jen.Id(parameter.Value.In).Id(typeName)).Id("Get" + name).Params()
 
-						fvRule := generator.fieldValidationRuleFromSchema(parameter.Value.In, name, parameter.Value.Schema, parameter.Value.Required)
+						fvRule := generator.fieldValidationRuleFromSchema(parameter.Value.In, name, parameter.Value.Schema, parameter.Value.Required, false)
 						if fvRule != nil {
 							fieldValidationRules = append(fieldValidationRules, jen.Line().Add(fvRule))
 						}
@@ -534,7 +622,11 @@ func (generator *Generator) requestParameterStruct(name string, contentType stri
 						}
 
 						var returnType = jen.Null()
-						generator.typee.fillGoType(returnType, "", name, parameter.Value.Schema, false, false)
+						if hasDeepObjectStruct(parameter.Value) {
+							returnType = jen.Id(typeName + name)
+						} else {
+							generator.parameterGoType(returnType, name, parameter.Value.Schema)
+						}
 						statement = statement.Params(returnType).Block(jen.Return().Id(parameter.Value.In).Dot(name))
 						return statement
 					}).
@@ -542,7 +634,8 @@ func (generator *Generator) requestParameterStruct(name string, contentType stri
 
 				validateFunc := generator.validationFuncFromRules(cast.ToString(group.Key), typeName, fieldValidationRules, nil)
 
-				return jen.Type().Id(typeName).Struct(structFields...).
+				return jen.Add(generator.normalizer.doubleLineAfterEachElement(objectStructs...)...).
+					Type().Id(typeName).Struct(structFields...).
 					Line().Line().
 					Add(generator.normalizer.doubleLineAfterEachElement(getters...)...).
 					Add(validateFunc)
@@ -590,10 +683,13 @@ func (generator *Generator) requestParameterStruct(name string, contentType stri
 		ToSlice(&parameters)
 
 	parameters = append(parameters, jen.Id("ProcessingResult").Id("RequestProcessingResult"))
+	parameters = append(parameters, generator.negotiationFields()...)
 
 	hasSecuritySchemas := operation.Security != nil && len(*operation.Security) > 0
 	if hasSecuritySchemas {
 		parameters = append(parameters, jen.Id("SecurityCheckResults").Map(jen.Id("SecurityScheme")).Id("string"))
+		parameters = append(parameters, generator.securityScopesField(operation))
+		parameters = append(parameters, generator.securityPrincipalsField(operation))
 	}
 
 	return jen.Null().
@@ -656,7 +752,7 @@ func (generator *Generator) enumFromSchema(name string, schema *openapi3.SchemaR
 		jen.Id("enumValue").Op(":=").Id(name).Call(jen.Id("strValue")),
 		jen.If(jen.Id("err").Op(":=").Id("enumValue").Dot("Check").Call(),
 			jen.Id("err").Op("!=").Id("nil")).Block(
-			jen.Line().Return().Id("err")),
+			jen.Line().Return(generator.newViolation("", "", "enum", jen.Id("err").Dot("Error").Call()))),
 		jen.Op("*").Id("enum").Op("=").Id("enumValue"),
 		jen.Line().Return().Id("nil"),
 	))
@@ -698,61 +794,6 @@ func (generator *Generator) validationFuncFromRules(receiverName string, name st
 		jen.Id("error")).Block(block)
 }
 
-func (generator *Generator) fieldValidationRuleFromSchema(receiverName string, propertyName string, schema *openapi3.SchemaRef, required bool) jen.Code {
-	var fieldRule jen.Code
-	v := schema.Value
-
-	if generator.typee.getXGoSkipValidation(v) {
-		return fieldRule
-	}
-
-	if isSchemaType(v.Type, "string") {
-		if v.MaxLength != nil || v.MinLength > 0 {
-			var maxLength uint64
-			if v.MaxLength != nil {
-				maxLength = *v.MaxLength
-			}
-			var params = []jen.Code{jen.Op("&").Id(receiverName).Dot(propertyName)}
-			if v.MinLength > 0 && required {
-				params = append(params, jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Required"))
-			} else if v.MinLength > 0 {
-				params = append(params, jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Skip").Dot("When").Call(jen.Id(receiverName).Dot(propertyName).Op("==").Lit("")))
-			}
-			params = append(params, jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "RuneLength").Call(jen.Lit(int(v.MinLength)), jen.Lit(int(maxLength))))
-			fieldRule = jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Field").Call(params...)
-		}
-	} else if isSchemaType(v.Type, "integer") || isSchemaType(v.Type, "number") {
-		var rules []jen.Code
-		if v.Min != nil {
-			min := jen.Lit(*v.Min)
-			if isSchemaType(v.Type, "integer") {
-				min = jen.Lit(int(*v.Min))
-			}
-			r := jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Min").Call(min)
-			if v.ExclusiveMin {
-				r.Dot("Exclusive").Call()
-			}
-			rules = append(rules, r)
-		}
-		if v.Max != nil {
-			max := jen.Lit(*v.Max)
-			if isSchemaType(v.Type, "integer") {
-				max = jen.Lit(int(*v.Max))
-			}
-			r := jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Max").Call(max)
-			if v.ExclusiveMax {
-				r.Dot("Exclusive").Call()
-			}
-			rules = append(rules)
-		}
-		if len(rules) > 0 {
-			params := append([]jen.Code{jen.Op("&").Id(receiverName).Dot(propertyName)}, rules...)
-			fieldRule = jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Field").Call(params...)
-		}
-	}
-	return fieldRule
-}
-
 func (generator *Generator) componentFromSchema(name string, parentSchema *openapi3.SchemaRef) jen.Code {
 	name = generator.normalizer.normalize(name)
 
@@ -764,6 +805,14 @@ func (generator *Generator) componentFromSchema(name string, parentSchema *opena
 		return typeDeclaration
 	}
 
+	if isUnionSchema(parentSchema.Value) {
+		return generator.unionFromSchema(name, parentSchema)
+	}
+
+	if isEmbeddingAllOf(parentSchema.Value) {
+		return generator.allOfFromSchema(name, parentSchema)
+	}
+
 	if len(parentSchema.Value.Properties) == 0 {
 		if len(parentSchema.Value.Enum) > 0 {
 			generator.typee.fillGoType(typeDeclaration, "", name+"Enum", parentSchema, false, false)
@@ -798,18 +847,31 @@ func (generator *Generator) componentFromSchema(name string, parentSchema *opena
 		schema := parentSchema.Value.Properties[property]
 		propertyName := strings.Title(generator.normalizer.normalize(property))
 
+		if generator.isOptionalProperty(parentSchema.Value, property) {
+			if fvRule := generator.fieldValidationRuleFromSchema("body", propertyName, schema, false, true); fvRule != nil {
+				fieldValidationRules = append(fieldValidationRules, jen.Line().Add(fvRule))
+			}
+			unmarshalNonRequiredAssignments = append(unmarshalNonRequiredAssignments, generator.optionalUnmarshalAssignment(property, propertyName, schema, false))
+			continue
+		}
+
 		var additionalValidationCode []jen.Code
 		regex := generator.getXGoRegex(schema)
 		if regex != "" {
 			regexVarName := generator.useRegex[regex]
 			//regexVarName := generator.normalizer.decapitalize(name) + strings.Title(property) + "Regex"
-			additionalValidationCode = append(additionalValidationCode,
-				jen.If(jen.Op("!").Id(regexVarName).Dot("MatchString").Call(jen.Id("body").Dot(propertyName))).Block(
-					jen.Return().Qual("fmt",
-						"Errorf").Call(jen.Lit(fmt.Sprintf(`%s not matched by the '%s' regex`, property, html.EscapeString(regex))))).Line())
+			if schema.Value.Default != nil {
+				additionalValidationCode = append(additionalValidationCode,
+					jen.If(jen.Id("value").Dot(propertyName).Op("!=").Nil().Op("&&").Op("!").Id(regexVarName).Dot("MatchString").Call(jen.Op("*").Id("value").Dot(propertyName))).Block(
+						jen.Return(generator.newViolation("", jsonPointer(formatTagName(property)), "pattern", jen.Lit(fmt.Sprintf(`not matched by the '%s' regex`, html.EscapeString(regex)))))).Line())
+			} else {
+				additionalValidationCode = append(additionalValidationCode,
+					jen.If(jen.Id("value").Dot(propertyName).Op("!=").Lit("").Op("&&").Op("!").Id(regexVarName).Dot("MatchString").Call(jen.Id("value").Dot(propertyName))).Block(
+						jen.Return(generator.newViolation("", jsonPointer(formatTagName(property)), "pattern", jen.Lit(fmt.Sprintf(`not matched by the '%s' regex`, html.EscapeString(regex)))))).Line())
+			}
 		}
 
-		fvRule := generator.fieldValidationRuleFromSchema("body", propertyName, schema, false)
+		fvRule := generator.fieldValidationRuleFromSchema("body", propertyName, schema, false, false)
 		if fvRule != nil {
 			fieldValidationRules = append(fieldValidationRules, jen.Line().Add(fvRule))
 		}
@@ -817,7 +879,19 @@ func (generator *Generator) componentFromSchema(name string, parentSchema *opena
 		var generateStatement = jen.Null().Add(additionalValidationCode...)
 
 		isTrimmable := generator.getXGoStringTrimmable(schema)
-		if isTrimmable {
+		if schema.Value.Default != nil {
+			value := jen.Op("*").Id("value").Dot(propertyName)
+			if isTrimmable {
+				value = jen.Qual("strings", "TrimSpace").Call(value)
+			}
+
+			unmarshalNonRequiredAssignments = append(unmarshalNonRequiredAssignments,
+				generateStatement.If(jen.Id("value").Dot(propertyName).Op("!=").Nil()).Block(
+					jen.Id("body").Dot(propertyName).Op("=").Add(value),
+				).Else().Block(
+					generator.defaultAssignment(jen.Id("body").Dot(propertyName), schema.Value),
+				).Line())
+		} else if isTrimmable {
 			unmarshalNonRequiredAssignments = append(unmarshalNonRequiredAssignments,
 				generateStatement.Id("body").Dot(propertyName).Op("=").Qual("strings", "TrimSpace").Call(jen.Id("value").Dot(propertyName)).Line())
 		} else {
@@ -841,23 +915,30 @@ func (generator *Generator) componentFromSchema(name string, parentSchema *opena
 		schema := parentSchema.Value.Properties[property]
 		propertyName := strings.Title(generator.normalizer.normalize(property))
 
+		if generator.isOptionalProperty(parentSchema.Value, property) {
+			if fvRule := generator.fieldValidationRuleFromSchema("body", propertyName, schema, false, true); fvRule != nil {
+				fieldValidationRules = append(fieldValidationRules, jen.Line().Add(fvRule))
+			}
+			unmarshalRequiredAssignments = append(unmarshalRequiredAssignments, generator.optionalUnmarshalAssignment(property, propertyName, schema, true).Line())
+			continue
+		}
+
 		var additionalValidationCode []jen.Code
 		regex := generator.getXGoRegex(schema)
 		if regex != "" {
 			regexVarName := generator.useRegex[regex] //normalizer.decapitalize(name) + strings.Title(property) + "Regex"
 			additionalValidationCode = append(additionalValidationCode,
 				jen.If(jen.Op("!").Id(regexVarName).Dot("MatchString").Call(jen.Op("*").Id("value").Dot(propertyName))).Block(
-					jen.Return().Qual("fmt",
-						"Errorf").Call(jen.Lit(fmt.Sprintf(`%s not matched by the '%s' regex`, property, html.EscapeString(regex))))).Line())
+					jen.Return(generator.newViolation("", jsonPointer(formatTagName(property)), "pattern", jen.Lit(fmt.Sprintf(`not matched by the '%s' regex`, html.EscapeString(regex)))))).Line())
 		}
 
-		fvRule := generator.fieldValidationRuleFromSchema("body", propertyName, schema, true)
+		fvRule := generator.fieldValidationRuleFromSchema("body", propertyName, schema, true, false)
 		if fvRule != nil {
 			fieldValidationRules = append(fieldValidationRules, jen.Line().Add(fvRule))
 		}
 
 		code := jen.If(jen.Id("value").Dot(propertyName).Op("==").Id("nil")).
-			Block(jen.Return().Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("%s is required", property)))).
+			Block(jen.Return(generator.newViolation("", jsonPointer(formatTagName(property)), "required", jen.Lit("is required")))).
 			Line().Line().
 			Add(additionalValidationCode...).
 			Line().Line()
@@ -881,19 +962,27 @@ func (generator *Generator) componentFromSchema(name string, parentSchema *opena
 			"Unmarshal").Call(jen.Id("data"),
 			jen.Op("&").Id("value")),
 			jen.Id("err").Op("!=").Id("nil")).Block(
-			jen.Return().Id("err")).Line().Line().
+			jen.Return().Id("unmarshalViolations").Call(jen.Id("data"), jen.Op("&").Id("value"), jen.Id("err"))).Line().Line().
 			Add(unmarshalNonRequiredAssignments...).Line().Line().
 			Add(unmarshalRequiredAssignments...).Line().Line().
 			Add(jen.Return().Id("nil"))).Line()
 
 	validateFunc := generator.validationFuncFromRules("body", name, fieldValidationRules, parentSchema.Value)
 
+	var unions []jen.Code
+	for _, property := range sortedMapKeys(parentSchema.Value.Properties) {
+		if union := inlineUnionSchema(parentSchema.Value.Properties[property]); union != nil {
+			unions = append(unions, jen.Line().Line().Add(generator.unionFromSchema(name+strings.Title(generator.normalizer.normalize(property)), union)))
+		}
+	}
+
 	return jen.Add(componentHelperStruct).
 		Add(jen.Line().Line()).
 		Add(componentStruct).
 		Add(jen.Line().Line()).
 		Add(unmarshalFunc).
-		Add(validateFunc)
+		Add(validateFunc).
+		Add(unions...)
 }
 
 func (generator *Generator) typeProperties(typeName string, schema *openapi3.Schema, pointersForRequired bool) (parameters []jen.Code) {
@@ -917,7 +1006,18 @@ func (generator *Generator) typeProperties(typeName string, schema *openapi3.Sch
 			}
 		}
 
-		asPointer := pointersForRequired && slices.Contains(schema.Required, originName)
+		if inlineUnionSchema(schemaRef) != nil {
+			name = strings.Title(typeName) + strings.Title(name)
+		}
+
+		if generator.isOptionalProperty(schema, originName) {
+			generator.optionalProperty(parameter, typeName, name, schemaRef, originName)
+			parameters = append(parameters, parameter)
+			continue
+		}
+
+		// properties with a default are pointers in the unmarshal helper, so a missing property can be told from a zero one
+		asPointer := pointersForRequired && (slices.Contains(schema.Required, originName) || schemaRef.Value.Default != nil)
 
 		generator.typee.fillGoType(parameter, typeName, name, schemaRef, asPointer, false)
 		generator.typee.fillJsonTag(parameter, schemaRef, originName)
@@ -966,9 +1066,12 @@ func (generator *Generator) enums(swagger *openapi3.T) jen.Code {
 					schema := mediaType.Schema
 
 					namePrefix := generator.normalizer.normalize(name + generator.normalizer.contentType(contentType))
+					if schema.Ref == "" {
+						namePrefix = generator.requestBodyTypeName(name, contentType, operation)
+					}
 
 					if len(schema.Value.Enum) > 0 {
-						requestBodyResults = append(requestBodyResults, generator.enumFromSchema(namePrefix+"RequestBodyEnum", schema))
+						requestBodyResults = append(requestBodyResults, generator.enumFromSchema(namePrefix+"Enum", schema))
 						continue
 					}
 
@@ -1136,6 +1239,11 @@ func (generator *Generator) hooksStruct() jen.Code {
 			jen.Id("string"),
 			jen.Id("string"),
 			jen.Id("RequestProcessingResult")),
+		jen.Id("RequestCookieParseFailed").Func().Params(jen.Op("*").Qual("net/http",
+			"Request"),
+			jen.Id("string"),
+			jen.Id("string"),
+			jen.Id("RequestProcessingResult")),
 		jen.Id("RequestBodyValidationFailed").Func().Params(jen.Op("*").Qual("net/http",
 			"Request"),
 			jen.Id("string"),
@@ -1152,6 +1260,10 @@ func (generator *Generator) hooksStruct() jen.Code {
 			"Request"),
 			jen.Id("string"),
 			jen.Id("RequestProcessingResult")),
+		jen.Id("RequestCookieValidationFailed").Func().Params(jen.Op("*").Qual("net/http",
+			"Request"),
+			jen.Id("string"),
+			jen.Id("RequestProcessingResult")),
 		jen.Id("RequestBodyUnmarshalCompleted").Func().Params(jen.Op("*").Qual("net/http",
 			"Request"),
 			jen.Id("string")),
@@ -1164,6 +1276,11 @@ func (generator *Generator) hooksStruct() jen.Code {
 		jen.Id("RequestQueryParseCompleted").Func().Params(jen.Op("*").Qual("net/http",
 			"Request"),
 			jen.Id("string")),
+		jen.Id("RequestCookieParseCompleted").Func().Params(jen.Op("*").Qual("net/http",
+			"Request"),
+			jen.Id("string")),
+		generator.aggregatedHook(),
+		generator.negotiationHook(),
 		jen.Id("RequestParseCompleted").Func().Params(jen.Op("*").Qual("net/http",
 			"Request"),
 			jen.Id("string")),
@@ -1189,6 +1306,8 @@ func (generator *Generator) hooksStruct() jen.Code {
 			jen.Id("string"),
 			jen.Id("int"),
 			jen.Id("error")),
+		generator.serviceFailedHook(),
+		generator.statusCodeHook(),
 		jen.Id("ServiceCompleted").Func().Params(jen.Op("*").Qual("net/http",
 			"Request"),
 			jen.Id("string")),
@@ -1210,6 +1329,11 @@ func (generator *Generator) requestProcessingResultType() jen.Code {
 			jen.Id("SecurityParseFailed"),
 			jen.Id("SecurityCheckFailed"),
 			jen.Id("ParseSucceed"),
+			jen.Id("CookieParseFailed"),
+			jen.Id("CookieValidationFailed"),
+			jen.Id("BodyContentTypeUnsupported"),
+			jen.Id("ParseFailed"),
+			generator.negotiationFailedType(),
 		)).
 		Add(jen.Line(), jen.Line()).
 		Add(jen.Type().Id("RequestProcessingResult").Struct(
@@ -1235,6 +1359,14 @@ func (generator *Generator) requestProcessingResultType() jen.Code {
 			jen.Id("r").Id("RequestProcessingResult")).Id("Err").Params().Params(
 			jen.Id("error")).Block(
 			jen.Return().Id("r").Dot("error"),
+		)).
+		Add(jen.Line(), jen.Line()).
+		Add(jen.Comment("ValidationError returns the violations of a failed parsing or validation.").Line()).
+		Add(jen.Func().Params(
+			jen.Id("r").Id("RequestProcessingResult")).Id("ValidationError").Params().Params(
+			jen.Qual(generator.config.ComponentsPackage, "ValidationError"), jen.Bool()).Block(
+			jen.Var().Id("err").Qual(generator.config.ComponentsPackage, "ValidationError"),
+			jen.Line().Return(jen.Id("err"), jen.Qual("errors", "As").Call(jen.Id("r").Dot("error"), jen.Op("&").Id("err"))),
 		))
 }
 
@@ -1262,19 +1394,9 @@ func (generator *Generator) wrappers(swagger *openapi3.T) jen.Code {
 				SelectT(func(operation operationWithPath) jen.Code {
 					method := generator.normalizer.normalize(strings.Title(strings.ToLower(cast.ToString(operation.method))))
 
-					if operation.operation.RequestBody == nil || len(operation.operation.RequestBody.Value.Content) == 1 {
-						name := generator.normalizer.normalizeOperationName(operation.path, cast.ToString(operation.method))
-						return jen.Id("router").Dot("router").Dot(method).Call(jen.Lit(operation.path), jen.Id("router").Dot(name))
-					}
-
-					var result []jen.Code
-					linq.From(toLinqKeyValue(sortedMapEntries(operation.operation.RequestBody.Value.Content))).
-						SelectT(func(kv linq.KeyValue) jen.Code {
-							name := generator.normalizer.normalizeOperationName(operation.path, cast.ToString(operation.method)) + generator.normalizer.contentType(cast.ToString(kv.Key))
-							return jen.Id("router").Dot("router").Dot(method).Call(jen.Lit(operation.path), jen.Id("router").Dot(name))
-						}).ToSlice(&result)
-
-					return jen.Add(generator.normalizer.lineAfterEachElement(result...)...)
+					// several request body content types share a single route dispatching on the Content-Type of the request
+					name := generator.normalizer.normalizeOperationName(operation.path, cast.ToString(operation.method))
+					return generator.routerBackend().route(method, operation.path, jen.Id("router").Dot(name))
 				}).ToSlice(&routes)
 
 			var wrappers []jen.Code
@@ -1298,16 +1420,16 @@ func (generator *Generator) wrappers(swagger *openapi3.T) jen.Code {
 					}
 
 					var result []jen.Code
-					linq.From(operation.operation.RequestBody.Value.Content).
-						SelectT(func(kv linq.KeyValue) interface{} {
-							name := generator.normalizer.normalizeOperationName(operation.path, cast.ToString(operation.method)) + generator.normalizer.contentType(cast.ToString(kv.Key))
-							requestName := name + "Request"
-							requestBody := operation.operation.RequestBody.Value.Content[cast.ToString(kv.Key)].Schema
-							return generator.wrapper(name, requestName, routerName, method, operation.path, operation.operation, requestBody, cast.ToString(kv.Key))
-						}).
-						ToSlice(&result)
+					for _, contentType := range sortedMapKeys(operation.operation.RequestBody.Value.Content) {
+						name := generator.normalizer.normalizeOperationName(operation.path, cast.ToString(operation.method)) + generator.normalizer.contentType(contentType)
+						requestName := name + "Request"
+						requestBody := operation.operation.RequestBody.Value.Content[contentType].Schema
+						result = append(result, generator.wrapper(name, requestName, routerName, method, operation.path, operation.operation, requestBody, contentType))
+					}
 
-					return jen.Add(generator.normalizer.lineAfterEachElement(result...)...)
+					dispatcher := generator.contentTypeDispatcher(generator.normalizer.normalizeOperationName(operation.path, cast.ToString(operation.method)), routerName, operation.operation)
+
+					return jen.Add(generator.normalizer.lineAfterEachElement(append([]jen.Code{dispatcher}, result...)...)...)
 				}).ToSlice(&wrappers)
 
 			hasSecuritySchemas := linq.From(groupedOperations.operations).
@@ -1327,6 +1449,16 @@ func (generator *Generator) wrappers(swagger *openapi3.T) jen.Code {
 
 		}).ToSlice(&results)
 
+	results = append(results, generator.routerBackend().helpers()...)
+	results = append(results, generator.parameterHelpers()...)
+	results = append(results, generator.formHelpers()...)
+	results = append(results, generator.contentTypeHelpers()...)
+	results = append(results, generator.negotiationHelpers()...)
+	results = append(results, generator.streamHelpers()...)
+	results = append(results, generator.normalizer.doubleLineAfterEachElement(generator.problemTypes()...)...)
+	results = append(results, generator.normalizer.doubleLineAfterEachElement(generator.serviceErrorTypes()...)...)
+	results = append(results, generator.normalizer.doubleLineAfterEachElement(generator.codecTypes()...)...)
+
 	// Generate cloneWithBody helper function when PassRawRequest is enabled
 	// This function clones the request while preserving the body for both
 	// the parsing phase and the original request passed to handlers
@@ -1365,7 +1497,13 @@ func (generator *Generator) wrappers(swagger *openapi3.T) jen.Code {
 func (generator *Generator) wrapper(name string, requestName string, routerName, method string, path string, operation *openapi3.Operation, requestBody *openapi3.SchemaRef, contentType string) jen.Code {
 	var funcCode []jen.Code
 
+	generator.useStatusCodeErrors = generator.useStatusCodeErrors || takesStatusCode(operation)
+	streams, events := responseStreams(operation)
+	generator.useStreams = generator.useStreams || streams
+	generator.useEvents = generator.useEvents || events
+
 	funcCode = append(funcCode, jen.Defer().Id("r").Dot("Body").Dot("Close").Call().Line())
+	funcCode = append(funcCode, generator.wrapperNegotiation(name, operation)...)
 
 	slicesThatContainsRedirectCodes := jen.Qual("slices", "Contains").Call(
 		jen.Index().Int().ValuesFunc(func(g *jen.Group) {
@@ -1392,8 +1530,19 @@ func (generator *Generator) wrapper(name string, requestName string, routerName,
 		)
 	}
 
-	funcCode = append(funcCode, jen.Id("response").Op(":=").Id("router").Dot("service").Dot(name).Call(generator.serviceCallParams(name)...),
-		jen.Line().Line(),
+	parsed := jen.Id("r")
+	if generator.config.PassRawRequest {
+		parsed = jen.Id("cloned")
+	}
+
+	if generator.config.ProblemDetails {
+		funcCode = append(funcCode, generator.problemResponded(name, parsed)...)
+	}
+
+	funcCode = append(funcCode, generator.wrapperPrincipals(name, operation, parsed)...)
+	funcCode = append(funcCode, generator.serviceCall(name, generator.config.ProblemDetails || generator.securedWithPrincipals(operation))...)
+	funcCode = append(funcCode, generator.wrapperStatusCodeCheck(name, operation)...)
+	funcCode = append(funcCode, jen.Line().Line(),
 		jen.For(jen.List(jen.Id("header"),
 			jen.Id("value")).Op(":=").Range().Id("response").Dot("headers").Call()).Block(
 			jen.Id("w").Dot("Header").Call().Dot("Set").Call(jen.Id("header"),
@@ -1434,36 +1583,43 @@ func (generator *Generator) wrapper(name string, requestName string, routerName,
 
 		funcCode = append(funcCode, jen.Id("w").Dot("WriteHeader").Call(jen.Id("response").Dot("statusCode").Call()).Line().Line())
 
+		funcCode = append(funcCode, generator.wrapperStreams(name, operation)...)
+
 		funcCode = append(funcCode, jen.Var().Id("body").Index().Byte().Line())
 
+		marshal := jen.Switch(jen.Id("response").Dot("contentType").Call()).Block(
+			jen.Case(jen.Lit("application/xml")).Block(
+				jen.List(jen.Id("body"), jen.Id("err")).Op("=").
+					Qual("encoding/xml", "Marshal").Call(jen.Id("response").Dot("body").Call()),
+			),
+			jen.Case(jen.Lit("application/octet-stream")).Block(
+				jen.Var().Id("ok").Bool(),
+				jen.If(
+					jen.List(jen.Id("body"), jen.Id("ok")).Op("=").Parens(jen.Id("response").Dot("body").Call()).Assert(jen.Index().Byte()),
+					jen.Op("!").Id("ok"),
+				).Block(
+					jen.Id("err").Op("=").Qual("errors", "New").Call(jen.Lit("body is not []byte")),
+				),
+			),
+			jen.Case(jen.Lit("text/html")).Block(
+				jen.Id("body").Op("=").
+					Index().Byte().Parens(jen.Qual("fmt", "Sprint").Call(jen.Id("response").Dot("body").Call())),
+			),
+			jen.Case(jen.Lit("application/json")).Block(
+				jen.Fallthrough(),
+			),
+			jen.Default().Block(
+				jen.List(jen.Id("body"), jen.Id("err")).Op("=").
+					Qual("encoding/json", "Marshal").Call(jen.Id("response").Dot("body").Call()),
+			),
+		)
+		if generator.config.Codecs {
+			marshal = jen.List(jen.Id("body"), jen.Id("err")).Op("=").Id("router").Dot("codecs").Dot("encode").Call(jen.Id("response").Dot("contentType").Call(), jen.Id("response").Dot("body").Call())
+		}
+
 		funcCode = append(funcCode, jen.If(jen.Id("response").Dot("body").Call().Op("!=").Id("nil")).Block(
 			jen.Var().Id("err").Error().Line(),
-			jen.Switch(jen.Id("response").Dot("contentType").Call()).Block(
-				jen.Case(jen.Lit("application/xml")).Block(
-					jen.List(jen.Id("body"), jen.Id("err")).Op("=").
-						Qual("encoding/xml", "Marshal").Call(jen.Id("response").Dot("body").Call()),
-				),
-				jen.Case(jen.Lit("application/octet-stream")).Block(
-					jen.Var().Id("ok").Bool(),
-					jen.If(
-						jen.List(jen.Id("body"), jen.Id("ok")).Op("=").Parens(jen.Id("response").Dot("body").Call()).Assert(jen.Index().Byte()),
-						jen.Op("!").Id("ok"),
-					).Block(
-						jen.Id("err").Op("=").Qual("errors", "New").Call(jen.Lit("body is not []byte")),
-					),
-				),
-				jen.Case(jen.Lit("text/html")).Block(
-					jen.Id("body").Op("=").
-						Index().Byte().Parens(jen.Qual("fmt", "Sprint").Call(jen.Id("response").Dot("body").Call())),
-				),
-				jen.Case(jen.Lit("application/json")).Block(
-					jen.Fallthrough(),
-				),
-				jen.Default().Block(
-					jen.List(jen.Id("body"), jen.Id("err")).Op("=").
-						Qual("encoding/json", "Marshal").Call(jen.Id("response").Dot("body").Call()),
-				),
-			).Line(),
+			marshal.Line(),
 			jen.If(jen.Id("err").Op("!=").Id("nil")).Block(
 				jen.If(jen.Id("router").Dot("hooks").Dot("ResponseBodyMarshalFailed").Op("!=").Id("nil")).Block(
 					jen.Id("router").Dot("hooks").Dot("ResponseBodyMarshalFailed").Call(
@@ -1595,11 +1751,12 @@ func (generator *Generator) handler(name string, serviceName string, routerName
 			}).
 			Distinct().
 			SelectT(func(name string) jen.Code {
+				handle := generator.securityProcessorHandle(name)
 				name = strings.Title(name)
 
 				return jen.Line().Id("SecurityScheme"+name).Op(":").Values(jen.Line().Id("scheme").Op(":").Id("SecurityScheme"+name),
 					jen.Line().Id("extract").Op(":").Id("securityExtractorsFuncs").Index(jen.Id("SecurityScheme"+name)),
-					jen.Line().Id("handle").Op(":").Id("securitySchemas").Dot("SecurityScheme"+name),
+					jen.Line().Add(handle),
 					jen.Line(),
 				)
 			}).ToSlice(&declarations)
@@ -1610,21 +1767,57 @@ func (generator *Generator) handler(name string, serviceName string, routerName
 			Values(declarations...)
 	}
 
+	problemsParameter := jen.Null()
+	problems := jen.Null()
+	problemsField := jen.Null()
+	if generator.config.ProblemDetails {
+		problemsParameter = problemsParameter.Id("problems").Id("ProblemResponder")
+		problems = problems.Line().If(jen.Id("problems").Op("==").Nil()).Block(
+			jen.Id("problems").Op("=").Id("DefaultProblemResponder").Values(),
+		)
+		problemsField = problemsField.Id("problems").Op(":").Id("problems")
+	}
+
+	serviceErrorsParameter := jen.Null()
+	serviceErrors := jen.Null()
+	serviceErrorsField := jen.Null()
+	if generator.config.StrictService {
+		serviceErrorsParameter = serviceErrorsParameter.Id("serviceErrors").Id("ServiceErrorHandler")
+		serviceErrors = serviceErrors.Line().If(jen.Id("serviceErrors").Op("==").Nil()).Block(
+			jen.Id("serviceErrors").Op("=").Id("DefaultServiceErrorHandler").Values(),
+		)
+		serviceErrorsField = serviceErrorsField.Id("serviceErrors").Op(":").Id("serviceErrors")
+	}
+
+	codecsParameter := jen.Null()
+	codecs := jen.Null()
+	codecsField := jen.Null()
+	if generator.config.Codecs {
+		codecsParameter = codecsParameter.Id("codecs").Id("Codecs")
+		codecs = codecs.Line().If(jen.Id("codecs").Op("==").Nil()).Block(
+			jen.Id("codecs").Op("=").Id("DefaultCodecs").Call(),
+		)
+		codecsField = codecsField.Id("codecs").Op(":").Id("codecs")
+	}
+
 	code := jen.Func().Id(name).
 		Params(
 			jen.Id("impl").Id(serviceName),
-			jen.Id("r").Qual("github.com/go-chi/chi/v5", "Router"),
-			jen.Id("hooks").Op("*").Id("Hooks"), schemasInterfaceParameter).
+			jen.Id("r").Add(generator.routerBackend().routerType()),
+			jen.Id("hooks").Op("*").Id("Hooks"), problemsParameter, serviceErrorsParameter, codecsParameter, schemasInterfaceParameter).
 		Params(jen.Qual("net/http", "Handler")).
 		Block(
 			jen.If(jen.Id("hooks").Op("==").Nil()).Block(
 				jen.Id("hooks").Op("=").Op("&").Id("Hooks").Values(),
 			),
+			problems,
+			serviceErrors,
+			codecs,
 			jen.Line().Id("router").Op(":=").Op("&").Id(routerName).Values(jen.Id("router").Op(":").Id("r"),
-				jen.Id("service").Op(":").Id("impl"), jen.Id("hooks").Op(":").Id("hooks")),
+				jen.Id("service").Op(":").Id("impl"), jen.Id("hooks").Op(":").Id("hooks"), problemsField, serviceErrorsField, codecsField),
 			schemas,
 			jen.Line().Id("router").Dot("mount").Call(),
-			jen.Line().Return().Id("router").Dot("router"),
+			jen.Line().Return().Add(generator.routerBackend().handler(jen.Id("router").Dot("router"))),
 		)
 
 	return code
@@ -1636,10 +1829,28 @@ func (generator *Generator) router(routerName string, serviceName string, hasSec
 		securityHandlers = securityHandlers.Id("securityHandlers").Map(jen.Id("SecurityScheme")).Id("securityProcessor")
 	}
 
+	problems := jen.Null()
+	if generator.config.ProblemDetails {
+		problems = problems.Id("problems").Id("ProblemResponder")
+	}
+
+	serviceErrors := jen.Null()
+	if generator.config.StrictService {
+		serviceErrors = serviceErrors.Id("serviceErrors").Id("ServiceErrorHandler")
+	}
+
+	codecs := jen.Null()
+	if generator.config.Codecs {
+		codecs = codecs.Id("codecs").Id("Codecs")
+	}
+
 	code := jen.Type().Id(routerName).Struct(
-		jen.Id("router").Qual("github.com/go-chi/chi/v5", "Router"),
+		jen.Id("router").Add(generator.routerBackend().routerType()),
 		jen.Id("service").Id(serviceName),
 		jen.Id("hooks").Op("*").Id("Hooks"),
+		problems,
+		serviceErrors,
+		codecs,
 		securityHandlers,
 	)
 
@@ -1655,49 +1866,12 @@ func (generator *Generator) wrapperRequestParsers(wrapperName string, operation
 		OrderByT(func(group linq.Group) string { return cast.ToString(group.Key) }).
 		SelectManyT(func(group linq.Group) linq.Query {
 			return linq.From(group.Group).SelectT(func(parameter *openapi3.ParameterRef) jen.Code {
-				in := parameter.Value.In
-				name := generator.normalizer.normalize(parameter.Value.Name)
-				paramName := in + name
-
-				// Check for allOf
-				if parameter.Value.Schema.Value.AllOf != nil {
-					for _, schema := range parameter.Value.Schema.Value.AllOf {
-						if schema.Ref != "" {
-							// Create a new schema with the ref
-							parameter.Value.Schema = &openapi3.SchemaRef{
-								Ref:   schema.Ref,
-								Value: schema.Value,
-							}
-							break
-						}
-					}
-				}
-
-				if generator.typee.isCustomType(parameter.Value.Schema.Value) {
-					return generator.wrapperCustomType(in, name, paramName, wrapperName, parameter)
-				}
-
-				if len(parameter.Value.Schema.Value.Enum) > 0 {
-					enumType := generator.normalizer.extractNameFromRef(parameter.Value.Schema.Ref)
-					return generator.wrapperEnum(in, enumType, name, paramName, wrapperName, parameter)
-				}
-
-				if isSchemaType(parameter.Value.Schema.Value.Type, "integer") {
-					return generator.wrapperInteger(in, name, paramName, wrapperName, parameter)
-				}
-
-				return generator.wrapperStr(in, name, paramName, wrapperName, parameter)
+				return generator.aggregated(generator.wrapperRequestParameter(wrapperName, parameter))
 			}).Concat(linq.From([]jen.Code{
-				jen.Line().Add(jen.If(jen.Id("err").Op(":=").Id("request").Dot(strings.Title(cast.ToString(group.Key))).Dot("Validate").Call(),
+				jen.Line().Add(generator.aggregated(jen.If(jen.Id("err").Op(":=").Id("request").Dot(strings.Title(cast.ToString(group.Key))).Dot("Validate").Call(),
 					jen.Id("err").Op("!=").Id("nil")).
-					Block(jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-						jen.Id("typee").Op(":").Id(strings.Title(cast.ToString(group.Key))+"ValidationFailed")),
-						jen.If(jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(cast.ToString(group.Key))+"ValidationFailed").Op("!=").Id("nil")).Block(
-							jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(cast.ToString(group.Key))+"ValidationFailed").Call(
-								jen.Id("r"),
-								jen.Lit(wrapperName),
-								jen.Id("request").Dot("ProcessingResult"))),
-						jen.Line().Return())),
+					Block(generator.requestFailed(strings.Title(cast.ToString(group.Key))+"ValidationFailed", generator.validationErrorOf(cast.ToString(group.Key), jen.Id("err")),
+						"Request"+strings.Title(cast.ToString(group.Key))+"ValidationFailed", jen.Id("r"), jen.Lit(wrapperName))...))),
 				jen.Line().Add(jen.Line()).
 					Add(jen.If(jen.Id("router").Dot("hooks").Dot("Request" + strings.Title(cast.ToString(group.Key)) + "ParseCompleted").Op("!=").Id("nil")).Block(
 						jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(cast.ToString(group.Key))+"ParseCompleted").Call(
@@ -1730,34 +1904,72 @@ func (generator *Generator) extractRefFromAllOf(schema *openapi3.SchemaRef) stri
 	return ""
 }
 
-func (generator *Generator) wrapperCustomType(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
-	result := jen.Null()
+// wrapperRequestParameter parses a single parameter into the request.
+func (generator *Generator) wrapperRequestParameter(wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	in := parameter.Value.In
+	name := generator.normalizer.normalize(parameter.Value.Name)
+	paramName := in + name
+
+	// Check for allOf
+	if parameter.Value.Schema.Value.AllOf != nil {
+		for _, schema := range parameter.Value.Schema.Value.AllOf {
+			if schema.Ref != "" {
+				// Create a new schema with the ref
+				parameter.Value.Schema = &openapi3.SchemaRef{
+					Ref:   schema.Ref,
+					Value: schema.Value,
+				}
+				break
+			}
+		}
+	}
 
-	switch in {
-	case "header":
-		result = result.Add(jen.Id(paramName + "Str").Op(":=").Id("r").Dot("Header").Dot("Get").Call(jen.Lit(parameter.Value.Name)))
-	case "query":
-		result = result.Add(jen.Id(paramName + "Str").Op(":=").Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(parameter.Value.Name)))
-	case "path":
-		result = result.Add(jen.Id(paramName+"Str").Op(":=").Id("chi").Dot("URLParam").Call(jen.Id("r"), jen.Lit(parameter.Value.Name)))
-	default:
-		panic("unsupported " + in + " type")
+	if generator.isFlaggedParameter(parameter.Value) {
+		return jen.Null().
+			Add(jen.Id("request").Dot(strings.Title(in)).Dot(name + "Set").Op("=").Add(generator.parameterSent(in, parameter.Value))).Line().
+			Add(generator.wrapperRequestParameterValue(in, name, paramName, wrapperName, parameter))
 	}
 
-	result = result.Add(jen.Line())
+	return generator.wrapperRequestParameterValue(in, name, paramName, wrapperName, parameter)
+}
 
-	parseFailed := []jen.Code{
-		jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-			jen.Id("typee").Op(":").Id(strings.Title(in)+"ParseFailed")),
-		jen.If(jen.Id("router").Dot("hooks").Dot("Request" + strings.Title(in) + "ParseFailed").Op("!=").Id("nil")).Block(
-			jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Call(
-				jen.Id("r"),
-				jen.Lit(wrapperName),
-				jen.Lit(parameter.Value.Name),
-				jen.Id("request").Dot("ProcessingResult"))),
-		jen.Line().Return(),
+func (generator *Generator) wrapperRequestParameterValue(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	if isSchemaType(parameter.Value.Schema.Value.Type, "array") {
+		return generator.wrapperArray(in, name, paramName, wrapperName, parameter)
 	}
 
+	if isDeepObjectParameter(parameter.Value) {
+		return generator.wrapperDeepObject(in, name, wrapperName, parameter)
+	}
+
+	if isTimeFormat(parameter.Value.Schema.Value) {
+		return generator.wrapperScalar(in, name, paramName, wrapperName, parameter)
+	}
+
+	if generator.typee.isCustomType(parameter.Value.Schema.Value) {
+		return generator.wrapperCustomType(in, name, paramName, wrapperName, parameter)
+	}
+
+	if len(parameter.Value.Schema.Value.Enum) > 0 {
+		enumType := generator.normalizer.extractNameFromRef(parameter.Value.Schema.Ref)
+		return generator.wrapperEnum(in, enumType, name, paramName, wrapperName, parameter)
+	}
+
+	if isSchemaType(parameter.Value.Schema.Value.Type, "integer") || isSchemaType(parameter.Value.Schema.Value.Type, "number") ||
+		isSchemaType(parameter.Value.Schema.Value.Type, "boolean") {
+		return generator.wrapperScalar(in, name, paramName, wrapperName, parameter)
+	}
+
+	return generator.wrapperStr(in, name, paramName, wrapperName, parameter)
+}
+
+func (generator *Generator) wrapperCustomType(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	result := jen.Null().Add(jen.Id(paramName + "Str").Op(":=").Add(generator.parameterValue(in, parameter.Value)))
+
+	result = result.Add(jen.Line())
+
+	parseFailed := generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(jsonPointer(parameter.Value.Name)))("format")
+
 	if pkg, parse, ok := generator.typee.getXGoTypeStringParse(parameter.Value.Schema.Value); ok {
 		parameterCode := jen.Null().
 			Add(jen.List(jen.Id(paramName), jen.Id("err")).Op(":=").Qual(pkg, parse).Call(jen.Id(paramName+"Str"))).
@@ -1812,67 +2024,28 @@ func (generator *Generator) wrapperCustomType(in string, name string, paramName
 }
 
 func (generator *Generator) wrapperEnum(in string, enumType string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
-	result := jen.Null()
-
-	switch in {
-	case "header":
-		result = result.Add(jen.Id(paramName).Op(":=").Qual(generator.config.ComponentsPackage, enumType).Call(jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit(parameter.Value.Name))))
-	case "query":
-		result = result.Add(jen.Id(paramName).Op(":=").Qual(generator.config.ComponentsPackage, enumType).Call(jen.Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(parameter.Value.Name))))
-	case "path":
-		result = result.Add(jen.Id(paramName).Op(":=").Qual(generator.config.ComponentsPackage, enumType).Call(jen.Id("chi").Dot("URLParam").Call(jen.Id("r"), jen.Lit(parameter.Value.Name))))
-	default:
-		panic("unsupported " + in + " type")
-	}
+	declaration := jen.Id(paramName).Op(":=").Qual(generator.config.ComponentsPackage, enumType).Call(generator.parameterValue(in, parameter.Value))
 
-	result = result.
-		Add(jen.Line()).
+	result := jen.Null().
 		Add(jen.If(jen.Id("err").Op(":=").Id(paramName).Dot("Check").Call(),
 			jen.Id("err").Op("!=").Id("nil")).Block(
-			jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-				jen.Id("typee").Op(":").Id(strings.Title(in)+"ParseFailed")),
-			jen.If(jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Op("!=").Id("nil")).Block(
-				jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Call(
-					jen.Id("r"),
-					jen.Lit(wrapperName),
-					jen.Lit(parameter.Value.Name),
-					jen.Id("request").Dot("ProcessingResult"))),
-			jen.Line().Return())).
+			generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(jsonPointer(parameter.Value.Name)))("enum")...)).
 		Add(jen.Line(), jen.Line()).
 		Add(jen.Id("request").Dot(strings.Title(parameter.Value.In)).Dot(name).Op("=").Id(paramName)).
 		Add(jen.Line())
 
-	return jen.Null().Add(generator.wrapRequired(paramName, parameter.Value.Required, result)).Line()
+	return jen.Null().Add(declaration).Line().Add(generator.wrapRequired(paramName, parameter.Value.Required, result)).Line()
 }
 
 func (generator *Generator) wrapperStr(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
-	result := jen.Null()
-
-	switch in {
-	case "header":
-		result = result.Add(jen.Id(paramName).Op(":=").Id("r").Dot("Header").Dot("Get").Call(jen.Lit(parameter.Value.Name)))
-	case "query":
-		result = result.Add(jen.Id(paramName).Op(":=").Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(parameter.Value.Name)))
-	case "path":
-		result = result.Add(jen.Id(paramName).Op(":=").Id("chi").Dot("URLParam").Call(jen.Id("r"), jen.Lit(parameter.Value.Name)))
-	default:
-		panic("unsupported " + in + " type")
-	}
+	result := jen.Null().Add(jen.Id(paramName).Op(":=").Add(generator.parameterValue(in, parameter.Value)))
+	failed := generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(jsonPointer(parameter.Value.Name)))
 
 	if parameter.Value.Required {
 		result = result.
 			Add(jen.Line()).
 			Add(jen.If(jen.Id(paramName).Op("==").Lit("")).Block(
-				jen.Id("err").Op(":=").Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("%s is empty", parameter.Value.Name))).Line(),
-				jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-					jen.Id("typee").Op(":").Id(strings.Title(in)+"ParseFailed")),
-				jen.If(jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Op("!=").Id("nil")).Block(
-					jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Call(
-						jen.Id("r"),
-						jen.Lit(wrapperName),
-						jen.Lit(parameter.Value.Name),
-						jen.Id("request").Dot("ProcessingResult"))),
-				jen.Line().Return())).
+				append([]jen.Code{jen.Id("err").Op(":=").Qual("errors", "New").Call(jen.Lit("is empty")).Line()}, failed("required")...)...)).
 			Add(jen.Line())
 	}
 
@@ -1881,18 +2054,7 @@ func (generator *Generator) wrapperStr(in string, name string, paramName string,
 		regexVarName := generator.useRegex[regex] // generator.normalizer.decapitalize(wrapperName) + strings.Title(in) + name + "Regex"
 
 		result = result.Line().If(jen.Op("!").Id(regexVarName).Dot("MatchString").Call(jen.Id(paramName))).Block(
-			jen.Id("err").Op(":=").Qual("fmt",
-				"Errorf").Call(jen.Lit(fmt.Sprintf("%s not matched by the '%s' regex", parameter.Value.Name, regex))),
-			jen.Line(),
-			jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-				jen.Id("typee").Op(":").Id(fmt.Sprintf("%sParseFailed", strings.Title(in)))),
-			jen.If(jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Op("!=").Id("nil")).Block(
-				jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Call(jen.Id("r"),
-					jen.Lit(wrapperName),
-					jen.Lit(parameter.Value.Name),
-					jen.Id("request").Dot("ProcessingResult"))),
-			jen.Line(),
-			jen.Return()).
+			append([]jen.Code{jen.Id("err").Op(":=").Qual("errors", "New").Call(jen.Lit(fmt.Sprintf("not matched by the '%s' regex", regex))).Line()}, failed("pattern")...)...).
 			Line()
 	}
 
@@ -1904,43 +2066,6 @@ func (generator *Generator) wrapperStr(in string, name string, paramName string,
 	return result
 }
 
-func (generator *Generator) wrapperInteger(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
-	result := jen.Null()
-
-	switch in {
-	case "header":
-		result = result.Add(jen.Id(paramName).Op(":=").Id("r").Dot("Header").Dot("Get").Call(jen.Lit(parameter.Value.Name)))
-	case "query":
-		result = result.Add(jen.Id(paramName).Op(":=").Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(parameter.Value.Name)))
-	case "path":
-		result = result.Add(jen.Id(paramName).Op(":=").Id("chi").Dot("URLParam").Call(jen.Id("r"), jen.Lit(parameter.Value.Name)))
-	default:
-		panic("unsupported " + in + " type")
-	}
-
-	if parameter.Value.Required {
-		result = result.
-			Add(jen.Line()).
-			Add(jen.If(jen.Id(paramName).Op("==").Lit("")).Block(
-				jen.Id("err").Op(":=").Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("%s is empty", parameter.Value.Name))).Line(),
-				jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-					jen.Id("typee").Op(":").Id(strings.Title(in)+"ParseFailed")),
-				jen.If(jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Op("!=").Id("nil")).Block(
-					jen.Id("router").Dot("hooks").Dot("Request"+strings.Title(in)+"ParseFailed").Call(
-						jen.Id("r"),
-						jen.Lit(wrapperName),
-						jen.Lit(parameter.Value.Name),
-						jen.Id("request").Dot("ProcessingResult"))),
-				jen.Line().Return())).
-			Add(jen.Line())
-	}
-
-	return result.
-		Add(jen.Line()).
-		Add(jen.Id("request").Dot(strings.Title(parameter.Value.In)).Dot(name).Op("=").Qual("github.com/spf13/cast", "ToInt").Call(jen.Id(paramName))).
-		Add(jen.Line())
-}
-
 func (generator *Generator) wrapperBody(method string, path string, contentType string, wrapperName string, operation *openapi3.Operation, body *openapi3.SchemaRef) jen.Code {
 	result := jen.Null()
 
@@ -1948,11 +2073,7 @@ func (generator *Generator) wrapperBody(method string, path string, contentType
 		return result
 	}
 
-	name := generator.normalizer.extractNameFromRef(body.Ref)
-
-	if name == "" {
-		name = generator.normalizer.normalizeOperationName(path, method) + generator.normalizer.contentType(cast.ToString(contentType)) + "RequestBody"
-	}
+	name := generator.requestBodyTypeName(generator.normalizer.normalizeOperationName(path, method), contentType, operation)
 
 	result = result.
 		Add(jen.Var().Defs(
@@ -1961,11 +2082,19 @@ func (generator *Generator) wrapperBody(method string, path string, contentType
 		)).
 		Add(jen.Line()).
 		Add(func() *jen.Statement {
-			switch contentType {
-			case "application/xml":
+			if isFormContentType(contentType) {
+				return jen.Add(generator.formRequestBodyDecode(name, contentType, operation))
+			}
+
+			if generator.config.Codecs {
+				return jen.Add(generator.codecRequestBodyDecode(contentType))
+			}
+
+			switch {
+			case mediaTypeOf(contentType) == "application/xml":
 				return jen.Id("decodeErr").Op("=").Qual("encoding/xml", "NewDecoder").Call(jen.Id("r").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("body"))
 
-			case "application/octet-stream":
+			case mediaTypeOf(contentType) == "application/octet-stream" || isBinarySchema(body):
 				return jen.Add(jen.Var().Defs(
 					jen.Id("buf").Interface(),
 					jen.Id("ok").Bool(),
@@ -1989,15 +2118,7 @@ func (generator *Generator) wrapperBody(method string, path string, contentType
 		}()).
 		Add(jen.Line()).
 		Add(jen.If(jen.Id("decodeErr").Op("!=").Id("nil")).Block(
-			jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("decodeErr"),
-				jen.Id("typee").Op(":").Id("BodyUnmarshalFailed")),
-			jen.If(jen.Id("router").Dot("hooks").Dot("RequestBodyUnmarshalFailed").Op("!=").Id("nil")).Block(
-				jen.Id("router").Dot("hooks").Dot("RequestBodyUnmarshalFailed").Call(
-					jen.Id("r"),
-					jen.Lit(wrapperName),
-					jen.Id("request").Dot("ProcessingResult")),
-				jen.Line().Return()),
-			jen.Line().Return())).
+			generator.requestFailed("BodyUnmarshalFailed", generator.validationErrorOf("body", jen.Id("decodeErr")), "RequestBodyUnmarshalFailed", jen.Id("r"), jen.Lit(wrapperName))...)).
 		Add(jen.Line(), jen.Line()).
 		Add(jen.Id("request").Dot("Body").Op("=").Id("body")).
 		Add(jen.Line(), jen.Line()).
@@ -2007,17 +2128,10 @@ func (generator *Generator) wrapperBody(method string, path string, contentType
 				jen.Lit(wrapperName)))).
 		Add(jen.Line())
 
-	if contentType != "application/octet-stream" && !generator.typee.getXGoSkipValidation(body.Value) {
+	if contentType != "application/octet-stream" && !isBinarySchema(body) && !generator.typee.getXGoSkipValidation(body.Value) {
 		result = result.Add(jen.Line()).Add(jen.If(jen.Id("err").Op(":=").Id("request").Dot("Body").Dot("Validate").Call(),
 			jen.Id("err").Op("!=").Id("nil")).
-			Block(jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
-				jen.Id("typee").Op(":").Id("BodyValidationFailed")),
-				jen.If(jen.Id("router").Dot("hooks").Dot("RequestBodyValidationFailed").Op("!=").Id("nil")).Block(
-					jen.Id("router").Dot("hooks").Dot("RequestBodyValidationFailed").Call(
-						jen.Id("r"),
-						jen.Lit(wrapperName),
-						jen.Id("request").Dot("ProcessingResult"))),
-				jen.Line().Return()))
+			Block(generator.requestFailed("BodyValidationFailed", generator.validationErrorOf("body", jen.Id("err")), "RequestBodyValidationFailed", jen.Id("r"), jen.Lit(wrapperName))...))
 	}
 
 	return result.Add(jen.Line())
@@ -2036,9 +2150,9 @@ func (generator *Generator) wrapperSecurity(name string, operation *openapi3.Ope
 	linq.From(*operation.Security).
 		SelectT(func(securityRequirement openapi3.SecurityRequirement) jen.Code {
 			var handlers []jen.Code
-			linq.From(securityRequirement).SelectT(func(kv linq.KeyValue) jen.Code {
-				return jen.Id("router").Dot("securityHandlers").Index(jen.Id("SecurityScheme" + strings.Title(cast.ToString(kv.Key))))
-			}).ToSlice(&handlers)
+			for _, scheme := range sortedMapKeys(securityRequirement) {
+				handlers = append(handlers, generator.securityRequirementProcessor(scheme, securityRequirement[scheme]))
+			}
 
 			return jen.Values(handlers...)
 		}).
@@ -2062,7 +2176,33 @@ func (generator *Generator) wrapperSecurity(name string, operation *openapi3.Ope
 		).Line()
 	} else {
 		// Normal security check mode
+		// with problem details rejected credentials are told apart from missing ones and answered 403
+		rejected := jen.Null()
+		rejectedResult := jen.Null()
+		rejectedVar := jen.Null()
+		if generator.config.ProblemDetails {
+			rejected = jen.Line().Id("securityCheckErr").Op("=").Id("err")
+			rejectedResult = jen.Comment("credentials were given but rejected").Line().
+				If(jen.Id("securityCheckErr").Op("!=").Nil()).Block(
+				jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(
+					jen.Id("error").Op(":").Qual("fmt", "Errorf").Call(jen.Lit("failed passing security checks: %w"), jen.Id("securityCheckErr")),
+					jen.Id("typee").Op(":").Id("SecurityCheckFailed")),
+			)
+			rejectedVar = jen.Var().Id("securityCheckErr").Error().Line()
+		}
+
+		check, record := generator.securityProcessorCheck(operation, []jen.Code{
+			jen.If(jen.Id("router").Dot("hooks").Dot("RequestSecurityCheckFailed").Op("!=").Id("nil")).Block(
+				jen.Id("router").Dot("hooks").Dot("RequestSecurityCheckFailed").Call(jen.Id("r"),
+					jen.Lit(name),
+					jen.Id("string").Call(jen.Id("processor").Dot("scheme")),
+					jen.Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"), jen.Id("typee").Op(":").Id("SecurityCheckFailed")))),
+			rejected,
+			jen.Line().Id("isLinkedChecksValid").Op("=").Id("false"),
+			jen.Line().Break(),
+		})
 		code = code.Line().Id("isSecurityCheckPassed").Op(":=").Id("false").Line().
+			Add(rejectedVar).
 			For(jen.List(jen.Id("_"),
 				jen.Id("processors")).Op(":=").Range().Index().Index().Id("securityProcessor").Values(schemasCode...)).Block(
 			jen.Id("isLinkedChecksValid").Op(":=").Id("true"),
@@ -2073,25 +2213,15 @@ func (generator *Generator) wrapperSecurity(name string, operation *openapi3.Ope
 				jen.Line().If(jen.Op("!").Id("isExtracted")).Block(
 					jen.Id("isLinkedChecksValid").Op("=").Id("false"),
 					jen.Break()),
-				jen.Line().If(jen.Id("err").Op(":=").Id("processor").Dot("handle").Call(jen.Id("r"),
-					jen.Id("processor").Dot("scheme"),
-					jen.Id("name"),
-					jen.Id("value")),
-					jen.Id("err").Op("!=").Id("nil")).Block(
-					jen.If(jen.Id("router").Dot("hooks").Dot("RequestSecurityCheckFailed").Op("!=").Id("nil")).Block(
-						jen.Id("router").Dot("hooks").Dot("RequestSecurityCheckFailed").Call(jen.Id("r"),
-							jen.Lit(name),
-							jen.Id("string").Call(jen.Id("processor").Dot("scheme")),
-							jen.Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"), jen.Id("typee").Op(":").Id("SecurityCheckFailed")))),
-					jen.Line().Id("isLinkedChecksValid").Op("=").Id("false"),
-					jen.Line().Break()),
+				jen.Line().Add(check),
 				jen.Line().If(jen.Id("router").Dot("hooks").Dot("RequestSecurityCheckCompleted").Op("!=").Id("nil")).Block(
 					jen.Id("router").Dot("hooks").Dot("RequestSecurityCheckCompleted").Call(jen.Id("r"),
 						jen.Lit(name),
 						jen.Id("string").Call(jen.Id("processor").Dot("scheme")))),
 				jen.Line().If(jen.Id("len").Call(jen.Id("request").Dot("SecurityCheckResults")).Op("==").Lit(0)).Block(
 					jen.Id("request").Dot("SecurityCheckResults").Op("=").Map(jen.Id("SecurityScheme")).Id("string").Values()),
-				jen.Line().Id("request").Dot("SecurityCheckResults").Index(jen.Id("processor").Dot("scheme")).Op("=").Id("value")),
+				jen.Line().Id("request").Dot("SecurityCheckResults").Index(jen.Id("processor").Dot("scheme")).Op("=").Id("value"),
+				record),
 			jen.Line().If(jen.Id("isLinkedChecksValid")).Block(
 				jen.Id("isSecurityCheckPassed").Op("=").Id("true"),
 				jen.Break())).
@@ -2100,6 +2230,7 @@ func (generator *Generator) wrapperSecurity(name string, operation *openapi3.Ope
 				"Errorf").Call(jen.Lit("failed passing security checks")),
 			jen.Line().Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("error").Op(":").Id("err"),
 				jen.Id("typee").Op(":").Id("SecurityParseFailed")),
+			rejectedResult,
 			jen.Line().If(jen.Id("router").Dot("hooks").Dot("RequestSecurityParseFailed").Op("!=").Id("nil")).Block(
 				jen.Id("router").Dot("hooks").Dot("RequestSecurityParseFailed").Call(jen.Id("r"),
 					jen.Lit(name),
@@ -2115,11 +2246,17 @@ func (generator *Generator) wrapperSecurity(name string, operation *openapi3.Ope
 func (generator *Generator) wrapperRequestParser(name string, requestName string, routerName, method string, path string, operation *openapi3.Operation, requestBody *openapi3.SchemaRef, contentType string) jen.Code {
 	funcCode := []jen.Code{
 		jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(jen.Id("typee").Op(":").Id("ParseSucceed")).Line(),
+		generator.parserNegotiation(operation),
 	}
 
 	funcCode = append(funcCode, generator.wrapperSecurity(name, operation))
+	if operation.RequestBody != nil {
+		funcCode = append(funcCode, generator.bodyContentTypeCheck(name, contentType))
+	}
+	funcCode = append(funcCode, generator.aggregatedViolations())
 	funcCode = append(funcCode, generator.wrapperRequestParsers(name, operation)...)
-	funcCode = append(funcCode, generator.wrapperBody(method, path, contentType, name, operation, requestBody)) //TODO: support different content-types
+	funcCode = append(funcCode, generator.aggregated(generator.wrapperBody(method, path, contentType, name, operation, requestBody))) //TODO: support different content-types
+	funcCode = append(funcCode, generator.aggregatedFailed(name))
 	funcCode = append(funcCode, jen.Line().If(jen.Id("router").Dot("hooks").Dot("RequestParseCompleted").Op("!=").Id("nil")).Block(
 		jen.Id("router").Dot("hooks").Dot("RequestParseCompleted").Call(
 			jen.Id("r"),
@@ -2148,11 +2285,33 @@ func (generator *Generator) requestResponseBuilders(swagger *openapi3.T) jen.Cod
 	return jen.Null().Add(result...)
 }
 
+// requestBodyTypeName is the component a request body of contentType is decoded into: the referenced schema, or the type
+// generated for an inline schema, named after the content type when the operation accepts several.
+func (generator *Generator) requestBodyTypeName(name string, contentType string, operation *openapi3.Operation) string {
+	schema := operation.RequestBody.Value.Content[contentType].Schema
+	if schema.Ref != "" {
+		return generator.normalizer.extractNameFromRef(schema.Ref)
+	}
+
+	if len(operation.RequestBody.Value.Content) == 1 {
+		return generator.normalizer.normalize(name + "RequestBody")
+	}
+
+	return generator.normalizer.normalize(name + generator.normalizer.contentType(contentType+"RequestBody"))
+}
+
 type operationResponse struct {
 	ContentTypeBodyNameMap map[string]string
+	ContentTypeSchemas     map[string]*openapi3.SchemaRef
 	Headers                map[string]*openapi3.HeaderRef
 	SetCookie              bool
 	StatusCode             string
+	// Name is the StatusCode used in the builder names: Default for default, 4XX for the 4XX range.
+	Name string
+	// IsDefault is set for the default response, its builder takes the status code.
+	IsDefault bool
+	// StatusClass is the first digit of a range status code like 4XX, its builder takes a status code of the range.
+	StatusClass int
 }
 
 type operationStruct struct {
@@ -2189,66 +2348,7 @@ func (generator *Generator) builders(swagger *openapi3.T) (result jen.Code) {
 		for _, method := range operationMethods {
 			operation := pathItem.Operations()[method]
 			name := generator.normalizer.normalizeOperationName(pathName, method)
-			var operationResponses []operationResponse
-
-			// Sort response status codes to ensure deterministic response processing order
-			var statusCodes []string
-			for statusCode := range operation.Responses.Map() {
-				statusCodes = append(statusCodes, statusCode)
-			}
-			slices.Sort(statusCodes)
-
-			for _, statusCode := range statusCodes {
-				responseRef := operation.Responses.Map()[statusCode]
-				var response operationResponse
-				response.ContentTypeBodyNameMap = map[string]string{}
-
-				headers := map[string]*openapi3.HeaderRef{}
-				// Sort header names to ensure deterministic ordering
-				var headerNames []string
-				for k := range responseRef.Value.Headers {
-					headerNames = append(headerNames, k)
-				}
-				slices.Sort(headerNames)
-
-				for _, k := range headerNames {
-					v := responseRef.Value.Headers[k]
-					if strings.ToLower(k) == "set-cookie" {
-						response.SetCookie = true
-						continue
-					}
-
-					if strings.ToLower(k) == "content-encoding" {
-						continue
-					}
-
-					headers[k] = v
-				}
-
-				response.Headers = headers
-
-				// Sort content types to ensure deterministic content type processing order
-				var contentTypes []string
-				for contentType := range responseRef.Value.Content {
-					contentTypes = append(contentTypes, contentType)
-				}
-				slices.Sort(contentTypes)
-
-				for _, contentType := range contentTypes {
-					mediaType := responseRef.Value.Content[contentType]
-					var structName string
-					if "" == mediaType.Schema.Ref {
-						structName = name
-						structName += strings.Title(generator.normalizer.normalize(contentType))
-					} else {
-						structName = generator.normalizer.extractNameFromRef(mediaType.Schema.Ref)
-					}
-					response.ContentTypeBodyNameMap[contentType] = structName
-				}
-
-				response.StatusCode = statusCode
-				operationResponses = append(operationResponses, response)
-			}
+			operationResponses := generator.operationResponses(name, operation)
 
 			var tag string
 			if len(operation.Tags) > 0 {
@@ -2277,6 +2377,84 @@ func (generator *Generator) builders(swagger *openapi3.T) (result jen.Code) {
 	return jen.Null().Add(builders...)
 }
 
+// operationResponses models the responses of an operation sorted by status code
+func (generator *Generator) operationResponses(name string, operation *openapi3.Operation) []operationResponse {
+	var operationResponses []operationResponse
+
+	// Sort response status codes to ensure deterministic response processing order
+	var statusCodes []string
+	for statusCode := range operation.Responses.Map() {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	slices.Sort(statusCodes)
+
+	for _, statusCode := range statusCodes {
+		responseRef := operation.Responses.Map()[statusCode]
+		var response operationResponse
+		response.ContentTypeBodyNameMap = map[string]string{}
+		response.ContentTypeSchemas = map[string]*openapi3.SchemaRef{}
+
+		headers := map[string]*openapi3.HeaderRef{}
+		// Sort header names to ensure deterministic ordering
+		var headerNames []string
+		for k := range responseRef.Value.Headers {
+			headerNames = append(headerNames, k)
+		}
+		slices.Sort(headerNames)
+
+		for _, k := range headerNames {
+			v := responseRef.Value.Headers[k]
+			if strings.ToLower(k) == "set-cookie" {
+				response.SetCookie = true
+				continue
+			}
+
+			if strings.ToLower(k) == "content-encoding" {
+				continue
+			}
+
+			headers[k] = v
+		}
+
+		response.Headers = headers
+
+		// Sort content types to ensure deterministic content type processing order
+		var contentTypes []string
+		for contentType := range responseRef.Value.Content {
+			contentTypes = append(contentTypes, contentType)
+		}
+		slices.Sort(contentTypes)
+
+		for _, contentType := range contentTypes {
+			mediaType := responseRef.Value.Content[contentType]
+			var structName string
+			if "" == mediaType.Schema.Ref {
+				structName = name
+				structName += strings.Title(generator.normalizer.normalize(contentType))
+			} else {
+				structName = generator.normalizer.extractNameFromRef(mediaType.Schema.Ref)
+			}
+			response.ContentTypeBodyNameMap[contentType] = structName
+			response.ContentTypeSchemas[contentType] = mediaType.Schema
+		}
+
+		response.StatusCode = statusCode
+		response.Name = statusCode
+		switch {
+		case statusCode == "default":
+			response.Name = "Default"
+			response.IsDefault = true
+		case isStatusCodeRange(statusCode):
+			response.Name = strings.ToUpper(statusCode)
+			response.StatusClass = cast.ToInt(statusCode[:1])
+		}
+
+		operationResponses = append(operationResponses, response)
+	}
+
+	return operationResponses
+}
+
 func (generator *Generator) handlersTypes(swagger *openapi3.T) jen.Code {
 	var result []jen.Code
 
@@ -2328,10 +2506,19 @@ func (generator *Generator) interfaceMethodParams(requestTypeName string) []jen.
 // serviceCallParams generates the parameter list for service method calls based on configuration
 // When PassRawRequest is enabled, parsing is done on a cloned request to preserve the body
 // in the original request that gets passed to the handler
-func (generator *Generator) serviceCallParams(name string) []jen.Code {
+// With problem details or security principals the request is parsed ahead of the call.
+func (generator *Generator) serviceCallParams(name string, parsedAhead bool) []jen.Code {
 	var params []jen.Code
 
-	if generator.config.PassRawRequest {
+	if parsedAhead {
+		params = []jen.Code{
+			jen.Id("r").Dot("Context").Call(),
+			jen.Id("request"),
+		}
+		if generator.config.PassRawRequest {
+			params = append(params, jen.Id("r"))
+		}
+	} else if generator.config.PassRawRequest {
 		// Use cloned request for parsing to preserve body in original request
 		params = []jen.Code{
 			jen.Id("r").Dot("Context").Call(),
@@ -2370,12 +2557,12 @@ func (generator *Generator) handlersInterfaces(swagger *openapi3.T) jen.Code {
 							operation := entry.Value
 
 							if operation.RequestBody == nil {
-								return []jen.Code{jen.Id(name).Params(generator.interfaceMethodParams(name + "Request")...).Params(jen.Id(name + "Response"))}
+								return []jen.Code{jen.Id(name).Params(generator.interfaceMethodParams(name + "Request")...).Add(generator.serviceMethodResults(name + "Response"))}
 							}
 
 							//if we have only one content type we dont need to have it inside function name
 							if len(operation.RequestBody.Value.Content) == 1 {
-								return []jen.Code{jen.Id(name).Params(generator.interfaceMethodParams(name + "Request")...).Params(jen.Id(name + "Response"))}
+								return []jen.Code{jen.Id(name).Params(generator.interfaceMethodParams(name + "Request")...).Add(generator.serviceMethodResults(name + "Response"))}
 							}
 
 							var contentTypedInterfaceMethods []jen.Code
@@ -2389,7 +2576,7 @@ func (generator *Generator) handlersInterfaces(swagger *openapi3.T) jen.Code {
 							for _, contentType := range contentTypes {
 								contentTypedName := name + generator.normalizer.contentType(contentType)
 								contentTypedInterfaceMethods = append(contentTypedInterfaceMethods,
-									jen.Id(contentTypedName).Params(generator.interfaceMethodParams(contentTypedName+"Request")...).Params(jen.Id(name+"Response")))
+									jen.Id(contentTypedName).Params(generator.interfaceMethodParams(contentTypedName+"Request")...).Add(generator.serviceMethodResults(name+"Response")))
 							}
 
 							return contentTypedInterfaceMethods
@@ -2421,10 +2608,31 @@ func (generator *Generator) handlersInterfaces(swagger *openapi3.T) jen.Code {
 }
 
 func (generator *Generator) responseStruct() jen.Code {
+	statusCodeErr, statusCodeErrMethod := jen.Null(), jen.Null()
+	if generator.useStatusCodeErrors {
+		statusCodeErr = jen.Id("statusCodeErr").Error()
+		statusCodeErrMethod = jen.Id("statusCodeErr").Params().Error()
+	}
+
+	bodyStream, bodyStreamMethod := jen.Null(), jen.Null()
+	if generator.useStreams {
+		bodyStream = jen.Id("bodyStream").Qual("io", "Reader")
+		bodyStreamMethod = jen.Id("bodyStream").Params().Qual("io", "Reader")
+	}
+
+	events, eventsMethod := jen.Null(), jen.Null()
+	if generator.useEvents {
+		events = jen.Id("events").Func().Params(jen.Qual("context", "Context")).Params(jen.Any(), jen.Bool())
+		eventsMethod = jen.Id("events").Params().Func().Params(jen.Qual("context", "Context")).Params(jen.Any(), jen.Bool())
+	}
+
 	return jen.Type().Id("response").Struct(
 		jen.Id("statusCode").Id("int"),
+		statusCodeErr,
 		jen.Id("body").Interface(),
 		jen.Id("bodyRaw").Index().Byte(),
+		bodyStream,
+		events,
 		jen.Id("contentType").Id("string"),
 		jen.Id("redirectURL").Id("string"),
 		jen.Id("headers").Map(jen.Id("string")).Id("string"),
@@ -2432,8 +2640,11 @@ func (generator *Generator) responseStruct() jen.Code {
 	).Add(jen.Line().Line()).
 		Add(jen.Type().Id("responseInterface").Interface(
 			jen.Id("statusCode").Params().Id("int"),
+			statusCodeErrMethod,
 			jen.Id("body").Params().Interface(),
 			jen.Id("bodyRaw").Params().Index().Byte(),
+			bodyStreamMethod,
+			eventsMethod,
 			jen.Id("contentType").Params().Id("string"),
 			jen.Id("redirectURL").Params().Id("string"),
 			jen.Id("cookies").Params().Index().Qual("net/http", "Cookie"),
@@ -2463,6 +2674,7 @@ func (generator *Generator) responseType(name string) jen.Code {
 			jen.Id("int")).Block(
 			jen.Return().Id("response").Dot("response").Dot("statusCode"),
 		)).
+		Add(generator.responseStatusCodeErrMethod(decapicalizedName+"Response")).
 		Add(jen.Line(), jen.Line()).
 		Add(jen.Func().Params(
 			jen.Id("response").Id(decapicalizedName+"Response")).Id("body").Params().Params(jen.Interface()).Block(
@@ -2473,6 +2685,7 @@ func (generator *Generator) responseType(name string) jen.Code {
 			jen.Id("response").Id(decapicalizedName+"Response")).Id("bodyRaw").Params().Params(jen.Index().Byte()).Block(
 			jen.Return().Id("response").Dot("response").Dot("bodyRaw"),
 		)).
+		Add(generator.responseStreamMethods(decapicalizedName+"Response")).
 		Add(jen.Line(), jen.Line()).
 		Add(jen.Func().Params(
 			jen.Id("response").Id(decapicalizedName+"Response")).Id("contentType").Params().Params(
@@ -2546,7 +2759,7 @@ func (generator *Generator) responseBuilders(operationStruct operationStruct) je
 		var nextBuilderName string
 
 		if hasContentTypes {
-			contentTypeBuilderName := generator.contentTypeBuilderName(operationStruct.PrivateName + resp.StatusCode)
+			contentTypeBuilderName := generator.contentTypeBuilderName(operationStruct.PrivateName + resp.Name)
 			//content-type struct
 			responseResults = append(responseResults, jen.Type().Id(contentTypeBuilderName).Struct(jen.Id("response")))
 
@@ -2564,10 +2777,11 @@ func (generator *Generator) responseBuilders(operationStruct operationStruct) je
 				contentType := resp.ContentTypeBodyNameMap[contentTypeName]
 				var result []jen.Code
 
-				bodyBuilderName := generator.bodyGeneratorName(operationStruct.PrivateName+resp.StatusCode, contentTypeName)
-				assemblerName := generator.assemblerName(operationStruct.Name + resp.StatusCode + generator.normalizer.contentType(contentTypeName))
+				bodyBuilderName := generator.bodyGeneratorName(operationStruct.PrivateName+resp.Name, contentTypeName)
+				assemblerName := generator.assemblerName(operationStruct.Name + resp.Name + generator.normalizer.contentType(contentTypeName))
 
 				result = append(result, generator.responseContentTypeBuilder(contentTypeName, contentType, contentTypeBuilderName, bodyBuilderName, assemblerName, resp.Headers)...)
+				result = append(result, generator.streamBodyBuilders(contentTypeName, resp.ContentTypeSchemas[contentTypeName], contentType, bodyBuilderName, assemblerName)...)
 
 				//assembler struct, build
 				responseResults = append(responseResults, generator.responseAssembler(assemblerName, operationStruct.InterfaceResponseName, operationStruct.ResponseName)...)
@@ -2575,24 +2789,26 @@ func (generator *Generator) responseBuilders(operationStruct operationStruct) je
 				contentTypeBodyBuild = append(contentTypeBodyBuild, jen.Null().Add(generator.normalizer.doubleLineAfterEachElement(result...)...))
 			}
 
+			contentTypeBodyBuild = append(contentTypeBodyBuild, generator.negotiatedBodyBuilders(operationStruct, resp, contentTypeBuilderName)...)
+
 			responseResults = generator.normalizer.doubleLineAfterEachElement(append(responseResults, contentTypeBodyBuild...)...)
 			nextBuilderName = contentTypeBuilderName
 		} else {
 			//assembler struct, build
-			assemblerName := generator.assemblerName(operationStruct.Name + resp.StatusCode)
+			assemblerName := generator.assemblerName(operationStruct.Name + resp.Name)
 			responseResults = append(responseResults, generator.responseAssembler(assemblerName, operationStruct.InterfaceResponseName, operationStruct.ResponseName)...)
 			nextBuilderName = assemblerName
 		}
 
 		if resp.SetCookie {
-			cookiesBuilderName := generator.cookiesBuilderName(operationStruct.PrivateName + resp.StatusCode)
+			cookiesBuilderName := generator.cookiesBuilderName(operationStruct.PrivateName + resp.Name)
 			responseResults = append(generator.responseCookiesBuilder(cookiesBuilderName, nextBuilderName), responseResults...)
 			nextBuilderName = cookiesBuilderName
 		}
 
 		if hasHeaders {
-			headersStructName := generator.headersStructName(operationStruct.Name + resp.StatusCode)
-			headersBuilderName := generator.headersBuilderName(operationStruct.PrivateName + resp.StatusCode)
+			headersStructName := generator.headersStructName(operationStruct.Name + resp.Name)
+			headersBuilderName := generator.headersBuilderName(operationStruct.PrivateName + resp.Name)
 			responseResults = append(generator.responseHeadersBuilder(resp.Headers, headersStructName, headersBuilderName, nextBuilderName), responseResults...)
 			nextBuilderName = headersBuilderName
 		}
@@ -2659,6 +2875,28 @@ func (generator *Generator) responseStatusCodeBuilder(resp operationResponse, bu
 			jen.Id("builder").Dot("response").Dot("redirectURL").Op("=").Id("redirectURL"),
 			jen.Line().Return().Op("&").Id(nextBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
 		))
+	} else if resp.IsDefault {
+		results = append(results, jen.Comment("StatusCode answers with code, a code that is not between 100 and 599 fails the response.").Line().
+			Func().Params(
+			jen.Id("builder").Op("*").Id(builderName)).Id("StatusCode").Params(jen.Id("code").Int()).Params(
+			jen.Op("*").Id(nextBuilderName)).Block(
+			jen.If(jen.Id("code").Op("<").Lit(100).Op("||").Id("code").Op(">").Lit(599)).Block(
+				jen.Id("builder").Dot("response").Dot("statusCodeErr").Op("=").Qual("fmt", "Errorf").Call(jen.Lit("status code %d is not between 100 and 599"), jen.Id("code")),
+			),
+			jen.Line().Id("builder").Dot("response").Dot("statusCode").Op("=").Id("code"),
+			jen.Line().Return().Op("&").Id(nextBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
+		))
+	} else if resp.StatusClass > 0 {
+		results = append(results, jen.Comment("StatusCode"+resp.Name+" answers with code, a code that is not a "+resp.Name+" status code fails the response.").Line().
+			Func().Params(
+			jen.Id("builder").Op("*").Id(builderName)).Id("StatusCode"+resp.Name).Params(jen.Id("code").Int()).Params(
+			jen.Op("*").Id(nextBuilderName)).Block(
+			jen.If(jen.Id("code").Op("/").Lit(100).Op("!=").Lit(resp.StatusClass)).Block(
+				jen.Id("builder").Dot("response").Dot("statusCodeErr").Op("=").Qual("fmt", "Errorf").Call(jen.Lit("status code %d is not a "+resp.Name+" status code"), jen.Id("code")),
+			),
+			jen.Line().Id("builder").Dot("response").Dot("statusCode").Op("=").Id("code"),
+			jen.Line().Return().Op("&").Id(nextBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
+		))
 	} else {
 		results = append(results, jen.Func().Params(
 			jen.Id("builder").Op("*").Id(builderName)).Id("StatusCode"+resp.StatusCode).Params().Params(
@@ -2670,6 +2908,67 @@ func (generator *Generator) responseStatusCodeBuilder(resp operationResponse, bu
 	return
 }
 
+// isStatusCodeRange reports whether statusCode is a range like 4XX.
+func isStatusCodeRange(statusCode string) bool {
+	return len(statusCode) == 3 && strings.EqualFold(statusCode[1:], "XX")
+}
+
+// takesStatusCode reports whether operation has a default or a range response, whose builders take the status code.
+func takesStatusCode(operation *openapi3.Operation) bool {
+	for statusCode := range operation.Responses.Map() {
+		if statusCode == "default" || isStatusCodeRange(statusCode) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// responseStatusCodeErrMethod returns the error recorded by the status code builder of a default or range response.
+func (generator *Generator) responseStatusCodeErrMethod(responseName string) jen.Code {
+	if !generator.useStatusCodeErrors {
+		return jen.Null()
+	}
+
+	return jen.Line().Line().Func().Params(
+		jen.Id("response").Id(responseName)).Id("statusCodeErr").Params().Params(jen.Error()).Block(
+		jen.Return().Id("response").Dot("response").Dot("statusCodeErr"),
+	)
+}
+
+// wrapperStatusCodeCheck answers a response built with a status code its default or range response can not answer
+// with through the ResponseStatusCodeFailed hook, or with 500 when the hook is not set.
+func (generator *Generator) wrapperStatusCodeCheck(name string, operation *openapi3.Operation) []jen.Code {
+	if !takesStatusCode(operation) {
+		return nil
+	}
+
+	return []jen.Code{
+		jen.Line().If(jen.Id("err").Op(":=").Id("response").Dot("statusCodeErr").Call(), jen.Id("err").Op("!=").Nil()).Block(
+			jen.If(jen.Id("router").Dot("hooks").Dot("ResponseStatusCodeFailed").Op("!=").Id("nil")).Block(
+				jen.Id("router").Dot("hooks").Dot("ResponseStatusCodeFailed").Call(jen.Id("w"), jen.Id("r"), jen.Lit(name), jen.Id("err")),
+				jen.Return(),
+			),
+			jen.Line().Qual("net/http", "Error").Call(jen.Id("w"), jen.Qual("net/http", "StatusText").Call(jen.Qual("net/http", "StatusInternalServerError")), jen.Qual("net/http", "StatusInternalServerError")),
+			jen.Return(),
+		),
+	}
+}
+
+// statusCodeHook is the ResponseStatusCodeFailed hook of the Hooks when a default or range response takes the status
+// code. The hook answers the request, nothing is written to the http.ResponseWriter before it is called.
+func (generator *Generator) statusCodeHook() jen.Code {
+	if !generator.useStatusCodeErrors {
+		return jen.Null()
+	}
+
+	return jen.Id("ResponseStatusCodeFailed").Func().Params(
+		jen.Qual("net/http", "ResponseWriter"),
+		jen.Op("*").Qual("net/http", "Request"),
+		jen.Id("string"),
+		jen.Id("error"))
+}
+
 func (generator *Generator) responseHeadersBuilder(headers map[string]*openapi3.HeaderRef, headersStructName string, headersBuilderName string, nextBuilderName string) (results []jen.Code) {
 	//headers struct
 	results = append(results, generator.headersStruct(headersStructName, headers))
@@ -2730,15 +3029,18 @@ func (generator *Generator) securitySchemas(swagger *openapi3.T) jen.Code {
 
 	code = code.Const().Defs(consts...).Line().Line()
 
+	scopeFields, scopeMethods := generator.securityProcessorScopes()
+
 	code = code.Line().Line().
-		Type().Id("securityProcessor").Struct(
+		Type().Id("securityProcessor").Struct(append([]jen.Code{
 		jen.Id("scheme").Id("SecurityScheme"),
 		jen.Id("extract").Func().Params(jen.Id("r").Op("*").Qual("net/http", "Request")).
 			Params(jen.Id("string"), jen.Id("string"), jen.Id("bool")),
 		jen.Id("handle").Func().Params(jen.Id("r").Op("*").Qual("net/http", "Request"),
 			jen.Id("scheme").Id("SecurityScheme"), jen.Id("name").Id("string"),
 			jen.Id("value").Id("string")).Params(
-			jen.Id("error")))
+			generator.withSecurityPrincipal(jen.Any(), jen.Id("error"))...)}, scopeFields...)...).
+		Add(scopeMethods)
 
 	var extractorsHeadersFuncs []jen.Code
 	linq.From(sortedMapEntries(swagger.Components.SecuritySchemes)).
@@ -2746,10 +3048,10 @@ func (generator *Generator) securitySchemas(swagger *openapi3.T) jen.Code {
 			name := generator.normalizer.normalize(entry.Key)
 			schema := entry.Value
 
-			if schema.Value.Type == "http" {
+			if schema.Value.Type == "http" || isScopedScheme(schema.Value) {
 				ifStatement := jen.Null()
 				assignment := jen.Null()
-				if schema.Value.Scheme == "bearer" {
+				if schema.Value.Scheme == "bearer" || isScopedScheme(schema.Value) {
 					ifStatement = ifStatement.Op("!").Qual("strings", "HasPrefix").Call(jen.Id("value"), jen.Lit("Bearer "))
 					assignment = assignment.Id("value").Op("=").Id("value").Index(jen.Lit(7), jen.Empty())
 				} else {
@@ -2766,6 +3068,12 @@ func (generator *Generator) securitySchemas(swagger *openapi3.T) jen.Code {
 					jen.Return().List(jen.Lit(schema.Value.Name), jen.Id("value"), jen.Id("value").Op("!=").Lit("")))
 			}
 
+			if extractor := generator.securityExtractor(schema.Value); extractor != nil {
+				return jen.Line().Id("SecurityScheme"+strings.Title(name)).Op(":").Func().Params(
+					jen.Id("r").Op("*").Qual("net/http", "Request")).Params(jen.Id("string"), jen.Id("string"),
+					jen.Id("bool")).Block(extractor)
+			}
+
 			if schema.Value.Type == "apiKey" {
 				switch schema.Value.In {
 				case "header":
@@ -2803,13 +3111,7 @@ func (generator *Generator) securitySchemas(swagger *openapi3.T) jen.Code {
 	linq.From(sortedMapEntries(swagger.Components.SecuritySchemes)).
 		SelectT(func(entry sortedKeyValue[string, *openapi3.SecuritySchemeRef]) interface{} { return entry.Key }).
 		SelectT(func(name string) jen.Code {
-			return jen.Id("SecurityScheme"+strings.Title(name)).Params(
-				jen.Id("r").Op("*").Qual("net/http",
-					"Request"),
-				jen.Id("scheme").Id("SecurityScheme"),
-				jen.Id("name").Id("string"),
-				jen.Id("value").Id("string")).Params(
-				jen.Id("error"))
+			return generator.securitySchemeMethod(name)
 		}).ToSlice(&interfaceFuncs)
 
 	code = code.Line().Line().Type().Id("SecuritySchemas").Interface(interfaceFuncs...)
@@ -2819,6 +3121,10 @@ func (generator *Generator) securitySchemas(swagger *openapi3.T) jen.Code {
 		jen.Id("Value").Id("string"),
 	)
 
+	if helpers := generator.securityPrincipalHelpers(); len(helpers) > 0 {
+		code = code.Line().Line().Add(generator.normalizer.doubleLineAfterEachElement(helpers...)...)
+	}
+
 	return code
 }
 
@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mikekonan/go-oas3/configurator"
+)
+
+func TestResponseStatusCodeBuilder(t *testing.T) {
+	tests := []struct {
+		name           string
+		response       operationResponse
+		expectContains []string
+		expectMissing  []string
+	}{
+		{
+			name:     "Fixed status code",
+			response: operationResponse{StatusCode: "201", Name: "201"},
+			expectContains: []string{
+				`StatusCode201() *next`,
+				`builder.response.statusCode = 201`,
+			},
+			expectMissing: []string{
+				`statusCodeErr`,
+			},
+		},
+		{
+			name:     "Redirect status code",
+			response: operationResponse{StatusCode: "307", Name: "307"},
+			expectContains: []string{
+				`StatusCode307(redirectURL string) *next`,
+				`builder.response.redirectURL = redirectURL`,
+			},
+		},
+		{
+			name:     "Default response",
+			response: operationResponse{StatusCode: "default", Name: "Default", IsDefault: true},
+			expectContains: []string{
+				`StatusCode(code int) *next`,
+				`if code < 100 || code > 599 {`,
+				`fmt.Errorf("status code %d is not between 100 and 599", code)`,
+				`builder.response.statusCode = code`,
+			},
+		},
+		{
+			name:     "Range response",
+			response: operationResponse{StatusCode: "4XX", Name: "4XX", StatusClass: 4},
+			expectContains: []string{
+				`StatusCode4XX(code int) *next`,
+				`if code/100 != 4 {`,
+				`fmt.Errorf("status code %d is not a 4XX status code", code)`,
+				`builder.response.statusCode = code`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator := newTestGenerator(configurator.Config{})
+
+			var code strings.Builder
+			for _, result := range generator.responseStatusCodeBuilder(tt.response, "builder", "next") {
+				code.WriteString(fmt.Sprintf("%#v\n", result))
+			}
+
+			for _, expected := range tt.expectContains {
+				if !strings.Contains(code.String(), expected) {
+					t.Errorf("Expected the code to contain %s, got:\n%s", expected, code.String())
+				}
+			}
+
+			for _, missing := range tt.expectMissing {
+				if strings.Contains(code.String(), missing) {
+					t.Errorf("Expected the code not to contain %s, got:\n%s", missing, code.String())
+				}
+			}
+		})
+	}
+}
+
+func TestTakesStatusCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		statusCodes   []string
+		expectTakes   bool
+		expectChecked bool
+	}{
+		{
+			name:        "Fixed status codes",
+			statusCodes: []string{"200", "404"},
+		},
+		{
+			name:          "Default response",
+			statusCodes:   []string{"200", "default"},
+			expectTakes:   true,
+			expectChecked: true,
+		},
+		{
+			name:          "Range response",
+			statusCodes:   []string{"200", "4xx"},
+			expectTakes:   true,
+			expectChecked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := &openapi3.Operation{Responses: openapi3.NewResponsesWithCapacity(len(tt.statusCodes))}
+			for _, statusCode := range tt.statusCodes {
+				operation.Responses.Set(statusCode, &openapi3.ResponseRef{Value: openapi3.NewResponse()})
+			}
+
+			if takes := takesStatusCode(operation); takes != tt.expectTakes {
+				t.Errorf("Expected takesStatusCode to be %v, got %v", tt.expectTakes, takes)
+			}
+
+			generator := newTestGenerator(configurator.Config{})
+			code := fmt.Sprintf("%#v", generator.wrapperStatusCodeCheck("GetPets", operation))
+
+			if checked := strings.Contains(code, `router.hooks.ResponseStatusCodeFailed(w, r, "GetPets", err)`); checked != tt.expectChecked {
+				t.Errorf("Expected the status code check to be emitted %v, got:\n%s", tt.expectChecked, code)
+			}
+		})
+	}
+}
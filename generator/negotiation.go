@@ -0,0 +1,205 @@
+package generator
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// responseContentTypes are the media types declared by any response of operation.
+func responseContentTypes(operation *openapi3.Operation) []string {
+	contentTypes := map[string]bool{}
+	for _, response := range operation.Responses.Map() {
+		for contentType := range response.Value.Content {
+			contentTypes[contentType] = true
+		}
+	}
+
+	return sortedMapKeys(contentTypes)
+}
+
+// literals are the string literals of values.
+func literals(values []string) []jen.Code {
+	var codes []jen.Code
+	for _, value := range values {
+		codes = append(codes, jen.Lit(value))
+	}
+
+	return codes
+}
+
+// negotiationHook is the RequestNegotiationFailed hook of the Hooks with -content-negotiation.
+func (generator *Generator) negotiationHook() jen.Code {
+	if !generator.config.ContentNegotiation {
+		return jen.Null()
+	}
+
+	return jen.Id("RequestNegotiationFailed").Func().Params(jen.Op("*").Qual("net/http",
+		"Request"),
+		jen.Id("string"),
+		jen.Id("RequestProcessingResult"))
+}
+
+// negotiationFailedType is the processing result type of a request accepting none of the declared media types.
+func (generator *Generator) negotiationFailedType() jen.Code {
+	if !generator.config.ContentNegotiation {
+		return jen.Null()
+	}
+
+	return jen.Id("NegotiationFailed")
+}
+
+// negotiationFields are the Accept header and the negotiated media type of a request with -content-negotiation.
+func (generator *Generator) negotiationFields() []jen.Code {
+	if !generator.config.ContentNegotiation {
+		return nil
+	}
+
+	return []jen.Code{
+		jen.Id("Accept").Id("Accept"),
+		jen.Id("NegotiatedContentType").String(),
+	}
+}
+
+// wrapperNegotiation answers a request accepting none of the media types declared by the responses of operation with
+// 406 ahead of parsing, the failure is reported through RequestNegotiationFailed.
+func (generator *Generator) wrapperNegotiation(name string, operation *openapi3.Operation) []jen.Code {
+	contentTypes := responseContentTypes(operation)
+	if !generator.config.ContentNegotiation || len(contentTypes) == 0 {
+		return nil
+	}
+
+	respond := jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Qual("net/http", "StatusText").Call(jen.Qual("net/http", "StatusNotAcceptable")), jen.Qual("net/http", "StatusNotAcceptable"))
+	if generator.config.ProblemDetails {
+		respond = jen.Id("router").Dot("problems").Dot("RespondProblem").Call(jen.Id("w"), jen.Id("r"), jen.Lit(name), jen.Id("result"))
+	}
+
+	return []jen.Code{
+		jen.If(jen.Id("Accept").Call(jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Accept"))).Dot("Negotiate").Call(literals(contentTypes)...).Op("==").Lit("")).Block(
+			jen.Id("result").Op(":=").Id("RequestProcessingResult").Values(
+				jen.Id("error").Op(":").Qual("fmt", "Errorf").Call(jen.Lit("none of %q is acceptable"), jen.Index().String().Values(literals(contentTypes)...)),
+				jen.Id("typee").Op(":").Id("NegotiationFailed")),
+			jen.If(jen.Id("router").Dot("hooks").Dot("RequestNegotiationFailed").Op("!=").Id("nil")).Block(
+				jen.Id("router").Dot("hooks").Dot("RequestNegotiationFailed").Call(jen.Id("r"), jen.Lit(name), jen.Id("result"))),
+			jen.Line().Add(respond),
+			jen.Return(),
+		).Line(),
+	}
+}
+
+// parserNegotiation sets the Accept header and the negotiated media type of a request.
+func (generator *Generator) parserNegotiation(operation *openapi3.Operation) jen.Code {
+	if !generator.config.ContentNegotiation {
+		return jen.Null()
+	}
+
+	code := jen.Id("request").Dot("Accept").Op("=").Id("Accept").Call(jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Accept"))).Line()
+	if contentTypes := responseContentTypes(operation); len(contentTypes) > 0 {
+		code = code.Id("request").Dot("NegotiatedContentType").Op("=").Id("request").Dot("Accept").Dot("Negotiate").Call(literals(contentTypes)...).Line()
+	}
+
+	return code
+}
+
+// negotiatedBodyBuilders let the service build a response declaring several media types of the same body in the media
+// type preferred by the Accept header of the request.
+func (generator *Generator) negotiatedBodyBuilders(operationStruct operationStruct, resp operationResponse, contentTypeBuilderName string) []jen.Code {
+	contentTypes := sortedMapKeys(resp.ContentTypeBodyNameMap)
+	if !generator.config.ContentNegotiation || len(contentTypes) < 2 {
+		return nil
+	}
+
+	bodyType := resp.ContentTypeBodyNameMap[contentTypes[0]]
+	for _, contentType := range contentTypes {
+		if resp.ContentTypeBodyNameMap[contentType] != bodyType || isEventStream(contentType) {
+			return nil
+		}
+	}
+
+	bodyBuilderName := generator.bodyGeneratorName(operationStruct.PrivateName+resp.Name, "negotiated")
+	nextBuilderName := generator.assemblerName(operationStruct.Name + resp.Name + "Negotiated")
+
+	return append([]jen.Code{
+		jen.Comment("Negotiated picks the media type preferred by accept, the first declared one when none is acceptable.").Line().
+			Func().Params(
+			jen.Id("builder").Op("*").Id(contentTypeBuilderName)).Id("Negotiated").Params(
+			jen.Id("accept").Id("Accept")).Params(
+			jen.Op("*").Id(bodyBuilderName)).Block(
+			jen.Id("builder").Dot("response").Dot("contentType").Op("=").Id("accept").Dot("Negotiate").Call(literals(contentTypes)...),
+			jen.If(jen.Id("builder").Dot("response").Dot("contentType").Op("==").Lit("")).Block(
+				jen.Id("builder").Dot("response").Dot("contentType").Op("=").Lit(contentTypes[0]),
+			),
+			jen.Line().Return().Op("&").Id(bodyBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
+		),
+		jen.Type().Id(bodyBuilderName).Struct(
+			jen.Id("response"),
+		),
+		jen.Func().Params(
+			jen.Id("builder").Op("*").Id(bodyBuilderName)).Id("Body").Params(
+			jen.Id("body").Qual(generator.config.ComponentsPackage, bodyType)).Params(
+			jen.Op("*").Id(nextBuilderName)).Block(
+			jen.Id("builder").Dot("response").Dot("body").Op("=").Id("body"),
+			jen.Line().Return().Op("&").Id(nextBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
+		),
+	}, generator.responseAssembler(nextBuilderName, operationStruct.InterfaceResponseName, operationStruct.ResponseName)...)
+}
+
+// negotiationHelpers are emitted into the routes with -content-negotiation.
+func (generator *Generator) negotiationHelpers() []jen.Code {
+	if !generator.config.ContentNegotiation {
+		return nil
+	}
+
+	return []jen.Code{
+		jen.Comment("Accept is the Accept header of a request.").Line().
+			Type().Id("Accept").String().Line(),
+		jen.Comment("Negotiate is the media type of contentTypes with the highest quality in accept, the quality of a media type").Line().
+			Comment("is the one of its most specific media range. Ties go to the earlier media type, a request without an Accept").Line().
+			Comment("header takes the first one and \"\" is returned when none is acceptable.").Line().
+			Func().Params(jen.Id("accept").Id("Accept")).Id("Negotiate").Params(jen.Id("contentTypes").Op("...").String()).String().Block(
+			jen.If(jen.Id("accept").Op("==").Lit("").Op("&&").Len(jen.Id("contentTypes")).Op(">").Lit(0)).Block(
+				jen.Return(jen.Id("contentTypes").Index(jen.Lit(0))),
+			),
+			jen.Line().Var().Defs(
+				jen.Id("best").String(),
+				jen.Id("bestQuality").Float64(),
+			),
+			jen.For(jen.List(jen.Id("_"), jen.Id("contentType")).Op(":=").Range().Id("contentTypes")).Block(
+				jen.List(jen.Id("quality"), jen.Id("specificity")).Op(":=").List(jen.Lit(0.0), jen.Lit(-1)),
+				jen.For(jen.List(jen.Id("_"), jen.Id("value")).Op(":=").Range().Qual("strings", "Split").Call(jen.String().Call(jen.Id("accept")), jen.Lit(","))).Block(
+					jen.List(jen.Id("mediaRange"), jen.Id("params"), jen.Id("err")).Op(":=").Qual("mime", "ParseMediaType").Call(jen.Qual("strings", "TrimSpace").Call(jen.Id("value"))),
+					jen.If(jen.Id("err").Op("!=").Nil()).Block(
+						jen.Continue(),
+					),
+					jen.Line().Id("rangeSpecificity").Op(":=").Lit(2),
+					jen.Switch().Block(
+						jen.Case(jen.Id("mediaRange").Op("==").Lit("*/*")).Block(
+							jen.Id("rangeSpecificity").Op("=").Lit(0),
+						),
+						jen.Case(jen.Qual("strings", "HasSuffix").Call(jen.Id("mediaRange"), jen.Lit("/*"))).Block(
+							jen.If(jen.Op("!").Qual("strings", "HasPrefix").Call(jen.Qual("strings", "ToLower").Call(jen.Id("contentType")), jen.Qual("strings", "TrimSuffix").Call(jen.Id("mediaRange"), jen.Lit("*")))).Block(
+								jen.Continue(),
+							),
+							jen.Id("rangeSpecificity").Op("=").Lit(1),
+						),
+						jen.Case(jen.Op("!").Qual("strings", "EqualFold").Call(jen.Id("mediaRange"), jen.Id("contentType"))).Block(
+							jen.Continue(),
+						),
+					),
+					jen.Line().If(jen.Id("rangeSpecificity").Op("<=").Id("specificity")).Block(
+						jen.Continue(),
+					),
+					jen.Line().List(jen.Id("specificity"), jen.Id("quality")).Op("=").List(jen.Id("rangeSpecificity"), jen.Lit(1.0)),
+					jen.If(jen.List(jen.Id("q"), jen.Id("ok")).Op(":=").Id("params").Index(jen.Lit("q")), jen.Id("ok")).Block(
+						jen.If(jen.List(jen.Id("quality"), jen.Id("err")).Op("=").Qual("strconv", "ParseFloat").Call(jen.Id("q"), jen.Lit(64)), jen.Id("err").Op("!=").Nil()).Block(
+							jen.Id("quality").Op("=").Lit(0.0),
+						),
+					),
+				),
+				jen.Line().If(jen.Id("quality").Op(">").Id("bestQuality")).Block(
+					jen.List(jen.Id("best"), jen.Id("bestQuality")).Op("=").List(jen.Id("contentType"), jen.Id("quality")),
+				),
+			),
+			jen.Line().Return(jen.Id("best")),
+		).Line(),
+	}
+}
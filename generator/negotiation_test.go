@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/mikekonan/go-oas3/configurator"
+)
+
+func TestNegotiationHelpersWithoutContentNegotiation(t *testing.T) {
+	generator := newTestGenerator(configurator.Config{})
+
+	if helpers := generator.negotiationHelpers(); helpers != nil {
+		t.Errorf("Expected no helpers without -content-negotiation, got %d", len(helpers))
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	goBinary, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not installed")
+	}
+
+	contentTypes := []string{"application/json", "application/xml"}
+	tests := []struct {
+		name              string
+		accept            string
+		expectContentType string
+	}{
+		{name: "No Accept header takes the first media type", accept: "", expectContentType: "application/json"},
+		{name: "Exact media type", accept: "application/xml", expectContentType: "application/xml"},
+		{name: "Media type matched case insensitively", accept: "APPLICATION/XML", expectContentType: "application/xml"},
+		{name: "Highest quality wins", accept: "application/json;q=0.5, application/xml", expectContentType: "application/xml"},
+		{name: "Most specific range gives the quality", accept: "application/*;q=0.8, application/xml;q=0.1", expectContentType: "application/json"},
+		{name: "Ties go to the earlier media type", accept: "*/*", expectContentType: "application/json"},
+		{name: "Zero quality is not acceptable", accept: "application/json;q=0, application/xml;q=0", expectContentType: ""},
+		{name: "Nothing acceptable", accept: "text/html", expectContentType: ""},
+	}
+
+	generator := newTestGenerator(configurator.Config{ContentNegotiation: true})
+
+	var calls []jen.Code
+	for _, tt := range tests {
+		calls = append(calls, jen.Qual("fmt", "Printf").Call(jen.Lit("%q\n"), jen.Id("Accept").Call(jen.Lit(tt.accept)).Dot("Negotiate").Call(literals(contentTypes)...)))
+	}
+
+	file := jen.NewFile("main")
+	file.Add(generator.negotiationHelpers()...)
+	file.Func().Id("main").Params().Block(calls...)
+
+	dir := t.TempDir()
+	if err := file.Save(filepath.Join(dir, "main.go")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// run outside of the module of the repository, the helpers only import the standard library
+	command := exec.Command(goBinary, "run", "main.go")
+	command.Dir = dir
+	output, err := command.CombinedOutput()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n%s", err, output)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) != len(tests) {
+		t.Fatalf("Expected %d results, got:\n%s", len(tests), output)
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if expected := `"` + tt.expectContentType + `"`; lines[i] != expected {
+				t.Errorf("Expected %s for %q, got %s", expected, tt.accept, lines[i])
+			}
+		})
+	}
+}
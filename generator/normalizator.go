@@ -100,8 +100,12 @@ func (normalizer *Normalizer) contentType(str string) string {
 		return ""
 	}
 
+	// wildcards are named Any, */* as a whole
+	str = strings.ReplaceAll(strings.Replace(str, "*/*", "*", 1), "*", "any")
+
+	// content types are split on / and -, other characters that can not be part of an identifier split them as well
 	var split = func(r rune) bool {
-		return r == '/' || r == '-'
+		return r == '/' || r == '-' || r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
 	}
 
 	return cast.ToString(linq.From(strings.FieldsFunc(str, split)).
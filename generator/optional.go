@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"fmt"
+	"html"
+	"slices"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// isOptionalProperty reports whether a property is generated as Optional[T]: non-required and nullable properties
+// are when optionals are enabled, unless x-go-pointer asks for a pointer.
+func (generator *Generator) isOptionalProperty(schema *openapi3.Schema, property string) bool {
+	propertySchema := schema.Properties[property]
+
+	return generator.config.Optional && !generator.typee.getXGoPointer(propertySchema.Value) &&
+		(!slices.Contains(schema.Required, property) || propertySchema.Value.Nullable)
+}
+
+// optionalProperty fills the Optional[T] field of a property, absent values are omitted when marshalling.
+func (generator *Generator) optionalProperty(into *jen.Statement, typeName string, name string, schemaRef *openapi3.SchemaRef, originName string) {
+	generator.useOptional = true
+
+	valueType := jen.Null()
+	generator.typee.fillGoType(valueType, typeName, name, schemaRef, false, false)
+
+	into.Qual(generator.config.ComponentsPackage, "Optional").Types(valueType).Tag(map[string]string{"json": formatTagName(originName) + ",omitzero"})
+}
+
+// optionalUnmarshalAssignment copies an Optional[T] property from the unmarshal helper, a required nullable
+// property has to be present but may be null. An absent property gets its default, an explicit null is kept.
+func (generator *Generator) optionalUnmarshalAssignment(property string, propertyName string, schema *openapi3.SchemaRef, required bool) *jen.Statement {
+	code := jen.Null()
+
+	if required {
+		code = code.If(jen.Op("!").Id("value").Dot(propertyName).Dot("IsPresent").Call()).Block(
+			jen.Return(generator.newViolation("", jsonPointer(formatTagName(property)), "required", jen.Lit("is required"))),
+		).Line().Line()
+	}
+
+	if !required && schema.Value.Default != nil {
+		code = code.If(jen.Op("!").Id("value").Dot(propertyName).Dot("IsPresent").Call()).Block(
+			jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Index().Byte().Call(jen.Lit(defaultJSON(schema.Value))), jen.Op("&").Id("value").Dot(propertyName)),
+				jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Id("err")),
+			),
+		).Line().Line()
+	}
+
+	if regex := generator.getXGoRegex(schema); regex != "" {
+		code = code.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("value").Dot(propertyName).Dot("Get").Call(),
+			jen.Id("ok").Op("&&").Op("!").Id(generator.useRegex[regex]).Dot("MatchString").Call(jen.Id("v"))).Block(
+			jen.Return(generator.newViolation("", jsonPointer(formatTagName(property)), "pattern", jen.Lit(fmt.Sprintf(`not matched by the '%s' regex`, html.EscapeString(regex))))),
+		).Line().Line()
+	}
+
+	if generator.getXGoStringTrimmable(schema) {
+		code = code.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("value").Dot(propertyName).Dot("Get").Call(), jen.Id("ok")).Block(
+			jen.Id("value").Dot(propertyName).Op("=").Id("OptionalOf").Call(jen.Qual("strings", "TrimSpace").Call(jen.Id("v"))),
+		).Line().Line()
+	}
+
+	return code.Id("body").Dot(propertyName).Op("=").Id("value").Dot(propertyName).Line()
+}
+
+// optionalHelpers is emitted once into the components when at least one Optional[T] property is generated.
+func (generator *Generator) optionalHelpers() jen.Code {
+	if !generator.useOptional {
+		return jen.Null()
+	}
+
+	optional := jen.Id("Optional").Types(jen.Id("T"))
+	receiver := jen.Id("optional").Add(optional)
+
+	return jen.Add(generator.normalizer.doubleLineAfterEachElement(
+		jen.Comment("Optional is a property that is either absent, null or set to a value. The zero Optional is absent,").Line().
+			Comment("absent properties are omitted when marshalling with the omitzero tag option.").Line().
+			Type().Id("Optional").Types(jen.Id("T").Any()).Struct(
+			jen.Id("value").Id("T"),
+			jen.Id("present").Bool(),
+			jen.Id("null").Bool(),
+		),
+		jen.Func().Id("OptionalOf").Types(jen.Id("T").Any()).Params(jen.Id("value").Id("T")).Add(optional).Block(
+			jen.Return(jen.Add(optional).Values(jen.Id("value").Op(":").Id("value"), jen.Id("present").Op(":").True())),
+		),
+		jen.Func().Id("OptionalNull").Types(jen.Id("T").Any()).Params().Add(optional).Block(
+			jen.Return(jen.Add(optional).Values(jen.Id("present").Op(":").True(), jen.Id("null").Op(":").True())),
+		),
+		jen.Func().Params(receiver).Id("IsPresent").Params().Bool().Block(
+			jen.Return(jen.Id("optional").Dot("present")),
+		),
+		jen.Func().Params(receiver).Id("IsNull").Params().Bool().Block(
+			jen.Return(jen.Id("optional").Dot("null")),
+		),
+		jen.Func().Params(receiver).Id("IsZero").Params().Bool().Block(
+			jen.Return(jen.Op("!").Id("optional").Dot("present")),
+		),
+		jen.Func().Params(receiver).Id("Get").Params().Params(jen.Id("T"), jen.Bool()).Block(
+			jen.Return(jen.Id("optional").Dot("value"), jen.Id("optional").Dot("present").Op("&&").Op("!").Id("optional").Dot("null")),
+		),
+		jen.Func().Params(receiver).Id("OrElse").Params(jen.Id("value").Id("T")).Id("T").Block(
+			jen.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("optional").Dot("Get").Call(), jen.Id("ok")).Block(
+				jen.Return(jen.Id("v")),
+			),
+			jen.Line().Return(jen.Id("value")),
+		),
+		jen.Func().Params(receiver).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+			jen.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("optional").Dot("Get").Call(), jen.Id("ok")).Block(
+				jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("v"))),
+			),
+			jen.Line().Return(jen.Index().Byte().Call(jen.Lit("null")), jen.Nil()),
+		),
+		jen.Func().Params(jen.Id("optional").Op("*").Add(optional)).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(
+			jen.Op("*").Id("optional").Op("=").Add(optional).Values(jen.Id("present").Op(":").True()),
+			jen.If(jen.String().Call(jen.Id("data")).Op("==").Lit("null")).Block(
+				jen.Id("optional").Dot("null").Op("=").True(),
+				jen.Return(jen.Nil()),
+			),
+			jen.Line().Return(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("optional").Dot("value"))),
+		),
+		jen.Comment("Value lets validation rules see the value of a set Optional and skip absent and null ones. The value is").Line().
+			Comment("returned as is and not converted to a driver type, so Optional can not be stored with database/sql.").Line().
+			Func().Params(receiver).Id("Value").Params().Params(jen.Qual("database/sql/driver", "Value"), jen.Error()).Block(
+			jen.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("optional").Dot("Get").Call(), jen.Id("ok")).Block(
+				jen.Return(jen.Id("v"), jen.Nil()),
+			),
+			jen.Line().Return(jen.Nil(), jen.Nil()),
+		),
+		jen.Func().Params(receiver).Id("Validate").Params().Error().Block(
+			jen.If(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("optional").Dot("Get").Call(), jen.Id("ok")).Block(
+				jen.Return(jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Validate").Call(jen.Id("v"))),
+			),
+			jen.Line().Return(jen.Nil()),
+		),
+	)...)
+}
@@ -0,0 +1,416 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// serializationMethod returns the style and explode of a parameter, falling back to the defaults of its location.
+func serializationMethod(parameter *openapi3.Parameter) *openapi3.SerializationMethod {
+	method, err := parameter.SerializationMethod()
+	if err != nil {
+		panic(err)
+	}
+
+	return method
+}
+
+func isDeepObjectParameter(parameter *openapi3.Parameter) bool {
+	return serializationMethod(parameter).Style == openapi3.SerializationDeepObject
+}
+
+// hasDeepObjectStruct tells whether an inline deepObject parameter gets its own struct, one without properties is a map
+// of its additionalProperties.
+func hasDeepObjectStruct(parameter *openapi3.Parameter) bool {
+	return isDeepObjectParameter(parameter) && parameter.Schema.Ref == "" && len(parameter.Schema.Value.Properties) > 0
+}
+
+// isFlaggedParameter tells whether a parameter gets a <Name>Set field flagging that it was sent. The flag lets the
+// client send a zero value, so it is generated with -client only. Path parameters and required ones are always sent.
+func (generator *Generator) isFlaggedParameter(parameter *openapi3.Parameter) bool {
+	return generator.config.GenerateClient && !parameter.Required && parameter.In != "path"
+}
+
+// parameterSent returns whether a parameter was sent with the request, a deepObject parameter is sent with any of
+// its properties and one of a map with any name[key] value.
+func (generator *Generator) parameterSent(in string, parameter *openapi3.Parameter) jen.Code {
+	switch {
+	case isDeepObjectParameter(parameter) && len(parameter.Schema.Value.Properties) == 0:
+		generator.useDeepObjectMaps = true
+		return jen.Id("deepObjectSent").Call(jen.Id("r"), jen.Lit(parameter.Name))
+	case isDeepObjectParameter(parameter):
+		var sent *jen.Statement
+		for _, property := range sortedMapKeys(parameter.Schema.Value.Properties) {
+			has := jen.Id("r").Dot("URL").Dot("Query").Call().Dot("Has").Call(jen.Lit(parameter.Name + "[" + property + "]"))
+			if sent == nil {
+				sent = has
+				continue
+			}
+
+			sent = sent.Op("||").Add(has)
+		}
+
+		return sent
+	case in == "header":
+		return jen.Len(jen.Id("r").Dot("Header").Dot("Values").Call(jen.Lit(parameter.Name))).Op(">").Lit(0)
+	case in == "cookie":
+		generator.useCookies = true
+		return jen.Id("cookieSent").Call(jen.Id("r"), jen.Lit(parameter.Name))
+	}
+
+	return jen.Id("r").Dot("URL").Dot("Query").Call().Dot("Has").Call(jen.Lit(parameter.Name))
+}
+
+// parameterGoType fills the go type of a parameter field. Unlike components, integer and number parameters are sized by their format.
+func (generator *Generator) parameterGoType(into *jen.Statement, typeName string, schemaRef *openapi3.SchemaRef) {
+	schema := schemaRef.Value
+	if schemaRef.Ref == "" && len(schema.Enum) == 0 && !generator.typee.hasXGoType(schema) && !generator.typee.getXGoPointer(schema) {
+		switch {
+		case isSchemaType(schema.Type, "array"):
+			into.Index()
+			generator.parameterGoType(into, typeName, schema.Items)
+			return
+		case isSchemaType(schema.Type, "integer") && schema.Format == "int32":
+			into.Int32()
+			return
+		case isSchemaType(schema.Type, "integer") && schema.Format == "int64":
+			into.Int64()
+			return
+		case isSchemaType(schema.Type, "number") && schema.Format == "float":
+			into.Float32()
+			return
+		}
+	}
+
+	generator.typee.fillGoType(into, "", typeName, schemaRef, false, false)
+}
+
+// parameterSource returns the raw string value of a parameter read from the request.
+func (generator *Generator) parameterSource(in string, name string) jen.Code {
+	switch in {
+	case "header":
+		return jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit(name))
+	case "query":
+		return jen.Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(name))
+	case "path":
+		return generator.routerBackend().pathParameter(name)
+	case "cookie":
+		generator.useCookies = true
+		return jen.Id("cookieValue").Call(jen.Id("r"), jen.Lit(name))
+	}
+
+	panic("unsupported " + in + " type")
+}
+
+// parameterHelpers are emitted once into the routes when a cookie parameter, a deepObject parameter of a map or a
+// parameter default is parsed.
+func (generator *Generator) parameterHelpers() (helpers []jen.Code) {
+	if generator.useCookies {
+		helpers = append(helpers, jen.Func().Id("cookieValue").Params(jen.Id("r").Op("*").Qual("net/http", "Request"), jen.Id("name").String()).String().Block(
+			jen.List(jen.Id("cookie"), jen.Id("err")).Op(":=").Id("r").Dot("Cookie").Call(jen.Id("name")),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Lit("")),
+			),
+			jen.Line().Return(jen.Id("cookie").Dot("Value")),
+		), jen.Func().Id("cookieSent").Params(jen.Id("r").Op("*").Qual("net/http", "Request"), jen.Id("name").String()).Bool().Block(
+			jen.List(jen.Id("_"), jen.Id("err")).Op(":=").Id("r").Dot("Cookie").Call(jen.Id("name")),
+			jen.Return(jen.Id("err").Op("==").Nil()),
+		))
+	}
+
+	if generator.useDeepObjectMaps {
+		helpers = append(helpers, jen.Func().Id("deepObjectSent").Params(jen.Id("r").Op("*").Qual("net/http", "Request"), jen.Id("name").String()).Bool().Block(
+			jen.For(jen.Id("key").Op(":=").Range().Id("r").Dot("URL").Dot("Query").Call()).Block(
+				jen.If(jen.Qual("strings", "HasPrefix").Call(jen.Id("key"), jen.Id("name").Op("+").Lit("["))).Block(
+					jen.Return(jen.True()),
+				),
+			),
+			jen.Line().Return(jen.False()),
+		))
+	}
+
+	if generator.useDefaults {
+		helpers = append(helpers, jen.Func().Id("valueOrDefault").Params(jen.Id("value").String(), jen.Id("defaultValue").String()).String().Block(
+			jen.If(jen.Id("value").Op("==").Lit("")).Block(
+				jen.Return(jen.Id("defaultValue")),
+			),
+			jen.Line().Return(jen.Id("value")),
+		))
+	}
+
+	return helpers
+}
+
+// parameterParseFailed reports the err variable in scope as a violation of keyword at pointer through Request<In>ParseFailed
+// and stops the parsing.
+func (generator *Generator) parameterParseFailed(in string, wrapperName string, parameterName string, pointer jen.Code) func(keyword string) []jen.Code {
+	return func(keyword string) []jen.Code {
+		violation := jen.Qual(generator.config.ComponentsPackage, "NewViolation").Call(jen.Lit(in), pointer, jen.Lit(keyword), jen.Id("err").Dot("Error").Call())
+
+		return generator.requestFailed(strings.Title(in)+"ParseFailed", violation, "Request"+strings.Title(in)+"ParseFailed", jen.Id("r"), jen.Lit(wrapperName), jen.Lit(parameterName))
+	}
+}
+
+// parameterValueParser parses a single raw parameter value according to its schema and hands the result to assign.
+// Failures of strconv violate the type of the parameter, failures of other parsers its format.
+func (generator *Generator) parameterValueParser(schema *openapi3.SchemaRef, goType jen.Code, value jen.Code, assign func(parsed jen.Code) jen.Code, failed func(keyword string) []jen.Code) jen.Code {
+	keyword := "format"
+	parsedWith := func(parse jen.Code, assign func(parsed jen.Code) jen.Code) jen.Code {
+		return jen.Null().
+			Add(jen.List(jen.Id("parsed"), jen.Id("err")).Op(":=").Add(parse)).Line().
+			Add(jen.If(jen.Id("err").Op("!=").Nil()).Block(failed(keyword)...)).Line().Line().
+			Add(assign(jen.Id("parsed")))
+	}
+
+	if len(schema.Value.Enum) > 0 {
+		return jen.Null().
+			Add(jen.Id("parsed").Op(":=").Add(goType).Call(value)).Line().
+			Add(jen.If(jen.Id("err").Op(":=").Id("parsed").Dot("Check").Call(), jen.Id("err").Op("!=").Nil()).Block(failed("enum")...)).Line().Line().
+			Add(assign(jen.Id("parsed")))
+	}
+
+	if pkg, parse, ok := generator.typee.getXGoTypeStringParse(schema.Value); ok {
+		return parsedWith(jen.Qual(pkg, parse).Call(value), assign)
+	}
+
+	// strconv returns int64/float64 for sized formats, they are converted to the type of the field
+	converted := func(parsed jen.Code) jen.Code { return assign(jen.Add(goType).Call(parsed)) }
+
+	if parse, ok := generator.preciseTimeParser(schema.Value, value); ok {
+		return parsedWith(parse, assign)
+	}
+
+	if isSchemaType(schema.Value.Type, "string") && schema.Value.Format == "uuid" {
+		return parsedWith(jen.Qual("github.com/google/uuid", "Parse").Call(value), assign)
+	}
+
+	keyword = "type"
+	switch {
+	case isSchemaType(schema.Value.Type, "integer") && schema.Value.Format == "int32":
+		return parsedWith(jen.Qual("strconv", "ParseInt").Call(value, jen.Lit(10), jen.Lit(32)), converted)
+	case isSchemaType(schema.Value.Type, "integer") && schema.Value.Format == "int64":
+		return parsedWith(jen.Qual("strconv", "ParseInt").Call(value, jen.Lit(10), jen.Lit(64)), converted)
+	case isSchemaType(schema.Value.Type, "integer"):
+		return parsedWith(jen.Qual("strconv", "Atoi").Call(value), converted)
+	case isSchemaType(schema.Value.Type, "number") && schema.Value.Format == "float":
+		return parsedWith(jen.Qual("strconv", "ParseFloat").Call(value, jen.Lit(32)), converted)
+	case isSchemaType(schema.Value.Type, "number"):
+		return parsedWith(jen.Qual("strconv", "ParseFloat").Call(value, jen.Lit(64)), converted)
+	case isSchemaType(schema.Value.Type, "boolean"):
+		return parsedWith(jen.Qual("strconv", "ParseBool").Call(value), converted)
+	case isSchemaType(schema.Value.Type, "string"):
+		if schema.Ref != "" {
+			return assign(jen.Add(goType).Call(value))
+		}
+
+		return assign(value)
+	}
+
+	panic(fmt.Sprintf("unsupported parameter value type '%v'", schema.Value.Type))
+}
+
+// parameterRawValues declares <paramName>Values with the raw values of an array parameter split according to its style.
+func (generator *Generator) parameterRawValues(in string, paramName string, parameter *openapi3.Parameter) jen.Code {
+	method := serializationMethod(parameter)
+
+	if in == "query" && method.Style == openapi3.SerializationForm && method.Explode {
+		values := jen.Id(paramName + "Values").Op(":=").Id("r").Dot("URL").Dot("Query").Call().Index(jen.Lit(parameter.Name))
+		if defaultValue, ok := parameterDefault(parameter); ok {
+			values = values.Line().If(jen.Len(jen.Id(paramName + "Values")).Op("==").Lit(0)).Block(
+				jen.Id(paramName+"Values").Op("=").Qual("strings", "Split").Call(jen.Lit(defaultValue), jen.Lit(",")),
+			)
+		}
+
+		return values
+	}
+
+	// a header may be sent several times, each of its values is a list of its own
+	if in == "header" {
+		values := jen.Var().Id(paramName + "Values").Index().String().Line().
+			For(jen.List(jen.Id("_"), jen.Id("value")).Op(":=").Range().Id("r").Dot("Header").Dot("Values").Call(jen.Lit(parameter.Name))).Block(
+			jen.If(jen.Id("value").Op("!=").Lit("")).Block(
+				jen.Id(paramName+"Values").Op("=").Append(jen.Id(paramName+"Values"), jen.Qual("strings", "Split").Call(jen.Id("value"), jen.Lit(",")).Op("...")),
+			),
+		)
+		if defaultValue, ok := parameterDefault(parameter); ok {
+			values = values.Line().If(jen.Len(jen.Id(paramName + "Values")).Op("==").Lit(0)).Block(
+				jen.Id(paramName+"Values").Op("=").Qual("strings", "Split").Call(jen.Lit(defaultValue), jen.Lit(",")),
+			)
+		}
+
+		return values
+	}
+
+	return jen.Var().Id(paramName+"Values").Index().String().Line().
+		If(jen.Id("value").Op(":=").Add(generator.parameterValue(in, parameter)), jen.Id("value").Op("!=").Lit("")).Block(
+		jen.Id(paramName+"Values").Op("=").Qual("strings", "Split").Call(jen.Id("value"), jen.Lit(parameterSeparator(method.Style))),
+	)
+}
+
+func parameterSeparator(style string) string {
+	switch style {
+	case openapi3.SerializationSpaceDelimited:
+		return " "
+	case openapi3.SerializationPipeDelimited:
+		return "|"
+	}
+
+	return ","
+}
+
+// wrapperScalar parses integer, number and boolean parameters with strconv. Time parameters are parsed
+// when precise types are enabled and kept as strings otherwise.
+func (generator *Generator) wrapperScalar(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	failed := generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(jsonPointer(parameter.Value.Name)))
+
+	goType := jen.Null()
+	generator.parameterGoType(goType, name, parameter.Value.Schema)
+
+	parse := jen.If(jen.Id(paramName).Op("!=").Lit("")).Block(
+		generator.parameterValueParser(parameter.Value.Schema, goType, jen.Id(paramName), func(parsed jen.Code) jen.Code {
+			return jen.Id("request").Dot(strings.Title(in)).Dot(name).Op("=").Add(parsed)
+		}, failed),
+	)
+
+	if parameter.Value.Required {
+		parse = parse.Else().Block(
+			append([]jen.Code{jen.Id("err").Op(":=").Qual("fmt", "Errorf").Call(jen.Lit("is empty")).Line()}, failed("required")...)...,
+		)
+	}
+
+	return jen.Id(paramName).Op(":=").Add(generator.parameterValue(in, parameter.Value)).Line().Add(parse).Line()
+}
+
+func (generator *Generator) wrapperArray(in string, name string, paramName string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	items := parameter.Value.Schema.Value.Items
+	pointer := jsonPointer(parameter.Value.Name)
+	failed := generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(pointer))
+	// the index is declared only if parsing an item can fail
+	index := jen.Id("_")
+	itemFailed := func(keyword string) []jen.Code {
+		index = jen.Id("i")
+		return generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(pointer+"/").Op("+").Qual("strconv", "Itoa").Call(jen.Id("i")))(keyword)
+	}
+
+	itemType := jen.Null()
+	generator.parameterGoType(itemType, generator.normalizer.extractNameFromRef(items.Ref), items)
+
+	value := jen.Id("value")
+	if in == "header" {
+		value = jen.Qual("strings", "TrimSpace").Call(jen.Id("value"))
+	}
+
+	result := jen.Null().Add(generator.parameterRawValues(in, paramName, parameter.Value)).Line()
+
+	if parameter.Value.Required {
+		result = result.Line().If(jen.Len(jen.Id(paramName + "Values")).Op("==").Lit(0)).Block(
+			append([]jen.Code{jen.Id("err").Op(":=").Qual("fmt", "Errorf").Call(jen.Lit("is empty")).Line()}, failed("required")...)...,
+		).Line()
+	}
+
+	parse := generator.parameterValueParser(items, itemType, value, func(parsed jen.Code) jen.Code {
+		return jen.Id("request").Dot(strings.Title(in)).Dot(name).Op("=").Append(jen.Id("request").Dot(strings.Title(in)).Dot(name), parsed)
+	}, itemFailed)
+
+	return result.Line().
+		For(jen.List(index, jen.Id("value")).Op(":=").Range().Id(paramName + "Values")).Block(parse).Line()
+}
+
+// wrapperDeepObject parses name[property]=value query parameters into the properties of an object parameter,
+// an optional parameter is parsed only when it was sent.
+func (generator *Generator) wrapperDeepObject(in string, name string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	schema := parameter.Value.Schema.Value
+	if len(schema.Properties) == 0 {
+		return generator.wrapperDeepObjectMap(in, name, wrapperName, parameter)
+	}
+
+	typeName := generator.normalizer.extractNameFromRef(parameter.Value.Schema.Ref)
+	if typeName == "" {
+		typeName = wrapperName + "Request" + strings.Title(in) + name
+	}
+
+	result := jen.Null()
+	for _, property := range sortedMapKeys(schema.Properties) {
+		propertySchema := schema.Properties[property]
+		propertyName := generator.normalizer.normalize(property)
+		key := parameter.Value.Name + "[" + property + "]"
+		failed := generator.parameterParseFailed(in, wrapperName, key, jen.Lit(jsonPointer(parameter.Value.Name, property)))
+
+		enumName := propertyName
+		if len(propertySchema.Value.Enum) > 0 {
+			if propertySchema.Ref != "" {
+				enumName = generator.normalizer.extractNameFromRef(propertySchema.Ref)
+			} else {
+				enumName = strings.Title(typeName) + strings.Title(propertyName) + "Enum"
+			}
+		}
+
+		propertyType := jen.Null()
+		generator.typee.fillGoType(propertyType, typeName, enumName, propertySchema, false, false)
+
+		isOptional := generator.isOptionalProperty(schema, property)
+		var source jen.Code = jen.Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(key))
+		if propertySchema.Value.Default != nil && !slices.Contains(schema.Required, property) {
+			source = generator.withDefault(source, defaultString(propertySchema.Value.Default))
+		}
+
+		parse := jen.If(jen.Id("value").Op(":=").Add(source), jen.Id("value").Op("!=").Lit("")).Block(
+			generator.parameterValueParser(propertySchema, propertyType, jen.Id("value"), func(parsed jen.Code) jen.Code {
+				if isOptional {
+					parsed = jen.Qual(generator.config.ComponentsPackage, "OptionalOf").Call(parsed)
+				}
+
+				return jen.Id("request").Dot(strings.Title(in)).Dot(name).Dot(propertyName).Op("=").Add(parsed)
+			}, failed),
+		)
+
+		if slices.Contains(schema.Required, property) {
+			parse = parse.Else().Block(
+				append([]jen.Code{jen.Id("err").Op(":=").Qual("fmt", "Errorf").Call(jen.Lit("is empty")).Line()}, failed("required")...)...,
+			)
+		}
+
+		result = result.Add(parse).Line().Line()
+	}
+
+	// the required properties of an optional object only apply when any of them was sent
+	if !parameter.Value.Required {
+		return jen.If(generator.parameterSent(in, parameter.Value)).Block(result).Line()
+	}
+
+	return result
+}
+
+// wrapperDeepObjectMap parses the name[key]=value query parameters of an object parameter without properties into the
+// map of its additionalProperties.
+func (generator *Generator) wrapperDeepObjectMap(in string, name string, wrapperName string, parameter *openapi3.ParameterRef) jen.Code {
+	schema := parameter.Value.Schema.Value
+	if schema.AdditionalProperties.Schema == nil {
+		panic(fmt.Sprintf("unsupported deepObject parameter '%s' without properties and additionalProperties", parameter.Value.Name))
+	}
+
+	mapType := jen.Null()
+	generator.typee.fillGoType(mapType, "", name, parameter.Value.Schema, false, false)
+	valueType := jen.Null()
+	generator.typee.fillGoType(valueType, "", name, schema.AdditionalProperties.Schema, false, false)
+	field := jen.Id("request").Dot(strings.Title(in)).Dot(name)
+
+	return jen.For(jen.List(jen.Id("key"), jen.Id("values")).Op(":=").Range().Id("r").Dot("URL").Dot("Query").Call()).Block(
+		jen.List(jen.Id("property"), jen.Id("ok")).Op(":=").Qual("strings", "CutPrefix").Call(jen.Id("key"), jen.Lit(parameter.Value.Name+"[")),
+		jen.If(jen.Op("!").Id("ok").Op("||").Op("!").Qual("strings", "HasSuffix").Call(jen.Id("property"), jen.Lit("]"))).Block(
+			jen.Continue(),
+		),
+		jen.Id("property").Op("=").Qual("strings", "TrimSuffix").Call(jen.Id("property"), jen.Lit("]")),
+		jen.Line().If(jen.Add(field).Op("==").Nil()).Block(
+			jen.Add(field).Op("=").Make(mapType),
+		),
+		jen.Line().Add(generator.parameterValueParser(schema.AdditionalProperties.Schema, valueType, jen.Id("values").Index(jen.Lit(0)), func(parsed jen.Code) jen.Code {
+			return jen.Add(field).Index(jen.Id("property")).Op("=").Add(parsed)
+		}, generator.parameterParseFailed(in, wrapperName, parameter.Value.Name, jen.Lit(jsonPointer(parameter.Value.Name)+"/").Op("+").
+			Qual("strings", "NewReplacer").Call(jen.Lit("~"), jen.Lit("~0"), jen.Lit("/"), jen.Lit("~1")).Dot("Replace").Call(jen.Id("property"))))),
+	).Line()
+}
@@ -0,0 +1,180 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mikekonan/go-oas3/configurator"
+)
+
+func newTestGenerator(config configurator.Config) *Generator {
+	normalizer := &Normalizer{}
+
+	return &Generator{
+		normalizer: normalizer,
+		typee:      &Type{normalizer: normalizer, config: &config},
+		config:     &config,
+	}
+}
+
+func deepObjectParameter(name string, required bool, schema *openapi3.Schema) *openapi3.Parameter {
+	explode := true
+
+	return &openapi3.Parameter{
+		Name:     name,
+		In:       "query",
+		Style:    openapi3.SerializationDeepObject,
+		Explode:  &explode,
+		Required: required,
+		Schema:   schema.NewRef(),
+	}
+}
+
+func filterSchema() *openapi3.Schema {
+	return openapi3.NewObjectSchema().
+		WithProperty("q", openapi3.NewStringSchema()).
+		WithProperty("kind", openapi3.NewStringSchema()).
+		WithRequired([]string{"q"})
+}
+
+func labelsSchema() *openapi3.Schema {
+	return openapi3.NewObjectSchema().WithAdditionalProperties(openapi3.NewStringSchema())
+}
+
+func TestParameterSent(t *testing.T) {
+	tests := []struct {
+		name                 string
+		in                   string
+		parameter            *openapi3.Parameter
+		expectCode           string
+		expectDeepObjectMaps bool
+		expectCookies        bool
+	}{
+		{
+			name:       "Query parameter",
+			in:         "query",
+			parameter:  &openapi3.Parameter{Name: "limit", In: "query", Schema: openapi3.NewIntegerSchema().NewRef()},
+			expectCode: `r.URL.Query().Has("limit")`,
+		},
+		{
+			name:       "Header parameter",
+			in:         "header",
+			parameter:  &openapi3.Parameter{Name: "X-Flags", In: "header", Schema: openapi3.NewStringSchema().NewRef()},
+			expectCode: `len(r.Header.Values("X-Flags")) > 0`,
+		},
+		{
+			name:          "Cookie parameter",
+			in:            "cookie",
+			parameter:     &openapi3.Parameter{Name: "session", In: "cookie", Schema: openapi3.NewStringSchema().NewRef()},
+			expectCode:    `cookieSent(r, "session")`,
+			expectCookies: true,
+		},
+		{
+			name:       "DeepObject parameter sent with any of its properties",
+			in:         "query",
+			parameter:  deepObjectParameter("where", false, filterSchema()),
+			expectCode: `r.URL.Query().Has("where[kind]") || r.URL.Query().Has("where[q]")`,
+		},
+		{
+			name:                 "DeepObject parameter of a map sent with any key",
+			in:                   "query",
+			parameter:            deepObjectParameter("labels", false, labelsSchema()),
+			expectCode:           `deepObjectSent(r, "labels")`,
+			expectDeepObjectMaps: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator := newTestGenerator(configurator.Config{})
+
+			code := fmt.Sprintf("%#v", generator.parameterSent(tt.in, tt.parameter))
+
+			if code != tt.expectCode {
+				t.Errorf("Expected %s, got %s", tt.expectCode, code)
+			}
+
+			if generator.useDeepObjectMaps != tt.expectDeepObjectMaps {
+				t.Errorf("Expected useDeepObjectMaps to be %v", tt.expectDeepObjectMaps)
+			}
+
+			if generator.useCookies != tt.expectCookies {
+				t.Errorf("Expected useCookies to be %v", tt.expectCookies)
+			}
+		})
+	}
+}
+
+func TestWrapperDeepObject(t *testing.T) {
+	tests := []struct {
+		name           string
+		parameter      *openapi3.Parameter
+		expectContains []string
+		expectMissing  []string
+	}{
+		{
+			name:      "Optional object parsed when sent",
+			parameter: deepObjectParameter("where", false, filterSchema()),
+			expectContains: []string{
+				`if r.URL.Query().Has("where[kind]") || r.URL.Query().Has("where[q]") {`,
+				`r.URL.Query().Get("where[q]")`,
+				`NewViolation("query", "/where/q", "required", err.Error())`,
+			},
+		},
+		{
+			name:      "Required object always parsed",
+			parameter: deepObjectParameter("where", true, filterSchema()),
+			expectContains: []string{
+				`NewViolation("query", "/where/q", "required", err.Error())`,
+			},
+			expectMissing: []string{
+				`if r.URL.Query().Has("where[kind]")`,
+			},
+		},
+		{
+			name:      "Object without properties parsed into a map",
+			parameter: deepObjectParameter("labels", false, labelsSchema()),
+			expectContains: []string{
+				`strings.CutPrefix(key, "labels[")`,
+				`request.Query.Labels = make(map[string]string)`,
+				`request.Query.Labels[property] = values[0]`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator := newTestGenerator(configurator.Config{})
+			name := generator.normalizer.normalize(tt.parameter.Name)
+
+			code := fmt.Sprintf("%#v", generator.wrapperDeepObject("query", name, "GetPets", &openapi3.ParameterRef{Value: tt.parameter}))
+
+			for _, expected := range tt.expectContains {
+				if !strings.Contains(code, expected) {
+					t.Errorf("Expected the code to contain %s, got:\n%s", expected, code)
+				}
+			}
+
+			for _, missing := range tt.expectMissing {
+				if strings.Contains(code, missing) {
+					t.Errorf("Expected the code not to contain %s, got:\n%s", missing, code)
+				}
+			}
+		})
+	}
+}
+
+func TestWrapperDeepObjectWithoutProperties(t *testing.T) {
+	defer func() {
+		if recovered := recover(); recovered == nil {
+			t.Error("Expected a deepObject without properties and additionalProperties to be rejected")
+		}
+	}()
+
+	generator := newTestGenerator(configurator.Config{})
+	parameter := deepObjectParameter("filter", false, openapi3.NewObjectSchema())
+
+	generator.wrapperDeepObject("query", "Filter", "GetPets", &openapi3.ParameterRef{Value: parameter})
+}
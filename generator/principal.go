@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// securedWithPrincipals reports whether the request of operation carries SecurityPrincipals.
+func (generator *Generator) securedWithPrincipals(operation *openapi3.Operation) bool {
+	return generator.config.SecurityPrincipals && operation.Security != nil && len(*operation.Security) > 0
+}
+
+// withSecurityPrincipal prepends principal to the results, result types or returned values of a SecuritySchemas method
+// with -security-principals.
+func (generator *Generator) withSecurityPrincipal(principal jen.Code, results ...jen.Code) []jen.Code {
+	if !generator.config.SecurityPrincipals {
+		return results
+	}
+
+	return append([]jen.Code{principal}, results...)
+}
+
+// securityPrincipalsField is the SecurityPrincipals of a request of a secured operation with -security-principals.
+func (generator *Generator) securityPrincipalsField(operation *openapi3.Operation) jen.Code {
+	if !generator.securedWithPrincipals(operation) {
+		return jen.Null()
+	}
+
+	return jen.Id("SecurityPrincipals").Id("SecurityPrincipals")
+}
+
+// securityPrincipalRecord records the principal returned by the SecuritySchemas method of a processor.
+func (generator *Generator) securityPrincipalRecord() jen.Code {
+	if !generator.config.SecurityPrincipals {
+		return jen.Null()
+	}
+
+	return jen.Line().If(jen.Id("request").Dot("SecurityPrincipals").Op("==").Nil()).Block(
+		jen.Id("request").Dot("SecurityPrincipals").Op("=").Id("SecurityPrincipals").Values()).
+		Line().Line().Id("request").Dot("SecurityPrincipals").Index(jen.Id("processor").Dot("scheme")).Op("=").Id("principal")
+}
+
+// wrapperPrincipals parses the request of a secured operation ahead of the service call and attaches its principals to
+// the context of r.
+func (generator *Generator) wrapperPrincipals(name string, operation *openapi3.Operation, parsed jen.Code) []jen.Code {
+	if !generator.securedWithPrincipals(operation) {
+		return nil
+	}
+
+	var code []jen.Code
+	if !generator.config.ProblemDetails {
+		code = append(code, jen.Id("request").Op(":=").Id("router").Dot("parse"+name+"Request").Call(parsed))
+	}
+
+	return append(code,
+		jen.Id("r").Op("=").Id("r").Dot("WithContext").Call(
+			jen.Id("ContextWithSecurityPrincipals").Call(jen.Id("r").Dot("Context").Call(), jen.Id("request").Dot("SecurityPrincipals"))),
+		jen.Line(),
+	)
+}
+
+// securityPrincipalHelpers are emitted next to the SecuritySchemas with -security-principals.
+func (generator *Generator) securityPrincipalHelpers() []jen.Code {
+	if !generator.config.SecurityPrincipals {
+		return nil
+	}
+
+	return []jen.Code{
+		jen.Comment("SecurityPrincipals are the principals returned by the SecuritySchemas methods of the schemes a request passed.").Line().
+			Type().Id("SecurityPrincipals").Map(jen.Id("SecurityScheme")).Any(),
+		jen.Type().Id("securityPrincipalsKey").Struct(),
+		jen.Comment("ContextWithSecurityPrincipals is a copy of ctx carrying principals.").Line().
+			Func().Id("ContextWithSecurityPrincipals").Params(
+			jen.Id("ctx").Qual("context", "Context"),
+			jen.Id("principals").Id("SecurityPrincipals")).Qual("context", "Context").Block(
+			jen.Return(jen.Qual("context", "WithValue").Call(jen.Id("ctx"), jen.Id("securityPrincipalsKey").Values(), jen.Id("principals"))),
+		),
+		jen.Comment("SecurityPrincipalsFromContext are the principals of the request ctx belongs to, nil outside of a secured operation.").Line().
+			Func().Id("SecurityPrincipalsFromContext").Params(
+			jen.Id("ctx").Qual("context", "Context")).Id("SecurityPrincipals").Block(
+			jen.List(jen.Id("principals"), jen.Id("_")).Op(":=").Id("ctx").Dot("Value").Call(jen.Id("securityPrincipalsKey").Values()).Assert(jen.Id("SecurityPrincipals")),
+			jen.Return(jen.Id("principals")),
+		),
+		jen.Comment("SecurityPrincipal is the principal returned for scheme, false when there is none or it is not a T.").Line().
+			Func().Id("SecurityPrincipal").Types(jen.Id("T").Any()).Params(
+			jen.Id("principals").Id("SecurityPrincipals"),
+			jen.Id("scheme").Id("SecurityScheme")).Params(jen.Id("T"), jen.Bool()).Block(
+			jen.List(jen.Id("principal"), jen.Id("ok")).Op(":=").Id("principals").Index(jen.Id("scheme")).Assert(jen.Id("T")),
+			jen.Return(jen.Id("principal"), jen.Id("ok")),
+		),
+	}
+}
@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// problemTypes is emitted into the routes with -problem-details. A failed request is answered by the ProblemResponder
+// passed to <Tag>Handler, DefaultProblemResponder writes the ProblemDetails of the result as application/problem+json.
+// The names are prefixed with Problem so they do not clash with a Problem schema of components sharing the package.
+func (generator *Generator) problemTypes() []jen.Code {
+	if !generator.config.ProblemDetails {
+		return nil
+	}
+
+	statusDoc := "rejected ones, 415 for an unsupported content type and 400 for parse and validation failures."
+	notAcceptable := jen.Null()
+	if generator.config.ContentNegotiation {
+		statusDoc = "rejected ones, 415 for an unsupported content type, 406 for an unacceptable one and 400 for parse and validation failures."
+		notAcceptable = jen.Case(jen.Id("NegotiationFailed")).Block(jen.Return(jen.Qual("net/http", "StatusNotAcceptable")))
+	}
+
+	return []jen.Code{
+		jen.Comment("ProblemDetails is an RFC 7807 problem details body, Violations lists the violations of failed parsing and validation.").Line().
+			Type().Id("ProblemDetails").Struct(
+			jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
+			jen.Id("Title").String().Tag(map[string]string{"json": "title"}),
+			jen.Id("Status").Int().Tag(map[string]string{"json": "status"}),
+			jen.Id("Detail").String().Tag(map[string]string{"json": "detail,omitempty"}),
+			jen.Id("Instance").String().Tag(map[string]string{"json": "instance,omitempty"}),
+			jen.Id("Violations").Index().Qual(generator.config.ComponentsPackage, "Violation").Tag(map[string]string{"json": "violations,omitempty"}),
+		),
+		jen.Comment("ProblemResponder answers requests failing parsing, validation or security checks, the service is not called for them.").Line().
+			Type().Id("ProblemResponder").Interface(
+			jen.Id("RespondProblem").Params(
+				jen.Id("w").Qual("net/http", "ResponseWriter"),
+				jen.Id("r").Op("*").Qual("net/http", "Request"),
+				jen.Id("operation").String(),
+				jen.Id("result").Id("RequestProcessingResult")),
+		),
+		jen.Comment("DefaultProblemResponder writes the ProblemDetails of the result as application/problem+json.").Line().
+			Type().Id("DefaultProblemResponder").Struct(),
+		jen.Func().Params(jen.Id("DefaultProblemResponder")).Id("RespondProblem").Params(
+			jen.Id("w").Qual("net/http", "ResponseWriter"),
+			jen.Id("r").Op("*").Qual("net/http", "Request"),
+			jen.Id("_").String(),
+			jen.Id("result").Id("RequestProcessingResult")).Block(
+			jen.Id("problem").Op(":=").Id("result").Dot("ProblemDetails").Call(jen.Id("r")),
+			jen.Line().Id("w").Dot("Header").Call().Dot("Set").Call(jen.Lit("Content-Type"), jen.Lit("application/problem+json")),
+			jen.Id("w").Dot("WriteHeader").Call(jen.Id("problem").Dot("Status")),
+			jen.Id("_").Op("=").Qual("encoding/json", "NewEncoder").Call(jen.Id("w")).Dot("Encode").Call(jen.Id("problem")),
+		),
+		jen.Comment("NewProblemDetails describes a request to r answered with status, the detail is the message of err if any.").Line().
+			Func().Id("NewProblemDetails").Params(jen.Id("r").Op("*").Qual("net/http", "Request"), jen.Id("status").Int(), jen.Id("err").Error()).Id("ProblemDetails").Block(
+			jen.Id("problem").Op(":=").Id("ProblemDetails").Values(jen.Dict{
+				jen.Id("Type"):     jen.Lit("about:blank"),
+				jen.Id("Title"):    jen.Qual("net/http", "StatusText").Call(jen.Id("status")),
+				jen.Id("Status"):   jen.Id("status"),
+				jen.Id("Instance"): jen.Id("r").Dot("URL").Dot("Path"),
+			}),
+			jen.Line().If(jen.Id("err").Op("!=").Nil()).Block(
+				jen.Id("problem").Dot("Detail").Op("=").Id("err").Dot("Error").Call(),
+			),
+			jen.Line().Return(jen.Id("problem")),
+		),
+		jen.Comment("ProblemDetails describes the failed processing result of request, with the violations of a ValidationError.").Line().
+			Func().Params(jen.Id("r").Id("RequestProcessingResult")).Id("ProblemDetails").Params(jen.Id("request").Op("*").Qual("net/http", "Request")).Id("ProblemDetails").Block(
+			jen.Id("problem").Op(":=").Id("NewProblemDetails").Call(jen.Id("request"), jen.Id("r").Dot("Status").Call(), jen.Id("r").Dot("Err").Call()),
+			jen.If(jen.List(jen.Id("validationErr"), jen.Id("ok")).Op(":=").Id("r").Dot("ValidationError").Call(), jen.Id("ok")).Block(
+				jen.Id("problem").Dot("Violations").Op("=").Id("validationErr").Dot("Violations"),
+			),
+			jen.Line().Return(jen.Id("problem")),
+		),
+		jen.Comment("Status is the HTTP status a failed processing result is answered with: 401 for missing credentials, 403 for").Line().
+			Comment(statusDoc).Line().
+			Func().Params(jen.Id("r").Id("RequestProcessingResult")).Id("Status").Params().Int().Block(
+			jen.Switch(jen.Id("r").Dot("typee")).Block(
+				jen.Case(jen.Id("ParseSucceed")).Block(jen.Return(jen.Qual("net/http", "StatusOK"))),
+				jen.Case(jen.Id("SecurityParseFailed")).Block(jen.Return(jen.Qual("net/http", "StatusUnauthorized"))),
+				jen.Case(jen.Id("SecurityCheckFailed")).Block(jen.Return(jen.Qual("net/http", "StatusForbidden"))),
+				jen.Case(jen.Id("BodyContentTypeUnsupported")).Block(jen.Return(jen.Qual("net/http", "StatusUnsupportedMediaType"))),
+				notAcceptable,
+			),
+			jen.Line().Return(jen.Qual("net/http", "StatusBadRequest")),
+		),
+	}
+}
+
+// problemResponded parses the request ahead of the service call and answers a failed one through router.problems.
+func (generator *Generator) problemResponded(name string, r jen.Code) []jen.Code {
+	return []jen.Code{
+		jen.Id("request").Op(":=").Id("router").Dot("parse" + name + "Request").Call(r),
+		jen.If(jen.Id("request").Dot("ProcessingResult").Dot("typee").Op("!=").Id("ParseSucceed")).Block(
+			jen.Id("router").Dot("problems").Dot("RespondProblem").Call(jen.Id("w"), jen.Id("r"), jen.Lit(name), jen.Id("request").Dot("ProcessingResult")),
+			jen.Return(),
+		),
+		jen.Line(),
+	}
+}
+
+// bodyContentTypeCheck fails the parsing of a body sent with another Content-Type than the one of the operation.
+func (generator *Generator) bodyContentTypeCheck(wrapperName string, contentType string) jen.Code {
+	if !generator.config.ProblemDetails {
+		return jen.Null()
+	}
+
+	return jen.If(jen.Op("!").Id("isContentTypeSupported").Call(jen.Id("r"), jen.Lit(mediaTypeOf(contentType)))).Block(
+		jen.Id("request").Dot("ProcessingResult").Op("=").Id("RequestProcessingResult").Values(
+			jen.Id("error").Op(":").Qual("fmt", "Errorf").Call(jen.Lit("content type %q is not supported"), jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Content-Type"))),
+			jen.Id("typee").Op(":").Id("BodyContentTypeUnsupported")),
+		jen.If(jen.Id("router").Dot("hooks").Dot("RequestBodyUnmarshalFailed").Op("!=").Id("nil")).Block(
+			jen.Id("router").Dot("hooks").Dot("RequestBodyUnmarshalFailed").Call(
+				jen.Id("r"),
+				jen.Lit(wrapperName),
+				jen.Id("request").Dot("ProcessingResult"))),
+		jen.Line().Return(),
+	).Line().Line()
+}
@@ -0,0 +1,336 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/mikekonan/go-oas3/configurator"
+)
+
+// routerBackend emits the router specific parts of the generated routes: the router type accepted by
+// <Tag>Handler, the http.Handler it returns, route registrations in mount(), path parameter access and the
+// helpers they rely on. checkPath reports paths the router can not serve before anything is generated.
+type routerBackend interface {
+	checkPath(path string) error
+	routerType() jen.Code
+	handler(router jen.Code) jen.Code
+	route(method string, path string, handler jen.Code) jen.Code
+	pathParameter(name string) jen.Code
+	helpers() []jen.Code
+}
+
+var routerBackends = map[string]routerBackend{
+	configurator.RouterChi:    chiRouter{},
+	configurator.RouterStdlib: stdlibRouter{},
+	configurator.RouterEcho:   echoRouter{},
+	configurator.RouterGin:    ginRouter{},
+	configurator.RouterFiber:  fiberRouter{},
+}
+
+func (generator *Generator) routerBackend() routerBackend {
+	return routerBackends[generator.config.Router]
+}
+
+// pathsChecker is implemented by routers that can not serve some paths side by side.
+type pathsChecker interface {
+	checkPaths(paths []string) error
+}
+
+// checkPaths returns the first path of the spec the configured router can not serve.
+func (generator *Generator) checkPaths(swagger *openapi3.T) error {
+	paths := sortedMapKeys(swagger.Paths.Map())
+	for _, path := range paths {
+		if err := generator.routerBackend().checkPath(path); err != nil {
+			return err
+		}
+	}
+
+	if checker, ok := generator.routerBackend().(pathsChecker); ok {
+		return checker.checkPaths(paths)
+	}
+
+	return nil
+}
+
+type chiRouter struct{}
+
+func (chiRouter) checkPath(string) error {
+	return nil
+}
+
+func (chiRouter) routerType() jen.Code {
+	return jen.Qual("github.com/go-chi/chi/v5", "Router")
+}
+
+func (chiRouter) handler(router jen.Code) jen.Code {
+	return router
+}
+
+func (chiRouter) route(method string, path string, handler jen.Code) jen.Code {
+	return jen.Id("router").Dot("router").Dot(method).Call(jen.Lit(path), handler)
+}
+
+func (chiRouter) pathParameter(name string) jen.Code {
+	return jen.Qual("github.com/go-chi/chi/v5", "URLParam").Call(jen.Id("r"), jen.Lit(name))
+}
+
+func (chiRouter) helpers() []jen.Code {
+	return nil
+}
+
+type stdlibRouter struct{}
+
+// checkPath rejects parameters of a path that map to the same wildcard, like {a-b} and {a_b}.
+func (router stdlibRouter) checkPath(path string) error {
+	names, err := pathParameterNames(path, "stdlib")
+	if err != nil {
+		return err
+	}
+
+	wildcards := map[string]string{}
+	for _, name := range names {
+		wildcard := router.wildcard(name)
+		if other, ok := wildcards[wildcard]; ok {
+			return fmt.Errorf("path '%s' has the parameters '%s' and '%s' that both map to the '%s' wildcard of the stdlib router", path, other, name, wildcard)
+		}
+
+		wildcards[wildcard] = name
+	}
+
+	return nil
+}
+
+func (stdlibRouter) routerType() jen.Code {
+	return jen.Op("*").Qual("net/http", "ServeMux")
+}
+
+func (stdlibRouter) handler(router jen.Code) jen.Code {
+	return router
+}
+
+func (router stdlibRouter) route(method string, path string, handler jen.Code) jen.Code {
+	return jen.Id("router").Dot("router").Dot("HandleFunc").Call(jen.Lit(strings.ToUpper(method)+" "+router.pattern(path)), handler)
+}
+
+func (router stdlibRouter) pathParameter(name string) jen.Code {
+	return jen.Id("r").Dot("PathValue").Call(jen.Lit(router.wildcard(name)))
+}
+
+func (stdlibRouter) helpers() []jen.Code {
+	return nil
+}
+
+// pattern converts an openapi path into a http.ServeMux pattern. ServeMux wildcards must be valid go identifiers,
+// a trailing slash is anchored with {$} to avoid subtree matching.
+func (router stdlibRouter) pattern(path string) string {
+	pattern := replacePathParameters(path, func(name string) string { return "{" + router.wildcard(name) + "}" })
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "{$}"
+	}
+
+	return pattern
+}
+
+func (stdlibRouter) wildcard(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return '_'
+	}, name)
+}
+
+// echoRouter, ginRouter and fiberRouter copy the path parameters matched by the router into the request with
+// r.SetPathValue, so the generated wrappers stay plain http.HandlerFunc and read them with r.PathValue.
+type echoRouter struct{}
+
+func (echoRouter) checkPath(path string) error {
+	_, err := pathParameterNames(path, "echo")
+	return err
+}
+
+func (echoRouter) routerType() jen.Code {
+	return jen.Op("*").Qual("github.com/labstack/echo/v4", "Echo")
+}
+
+func (echoRouter) handler(router jen.Code) jen.Code {
+	return router
+}
+
+func (echoRouter) route(method string, path string, handler jen.Code) jen.Code {
+	return jen.Id("router").Dot("router").Dot(strings.ToUpper(method)).
+		Call(jen.Lit(replacePathParameters(path, func(name string) string { return ":" + name })), jen.Id("echoHandler").Call(handler))
+}
+
+func (echoRouter) pathParameter(name string) jen.Code {
+	return jen.Id("r").Dot("PathValue").Call(jen.Lit(name))
+}
+
+func (echoRouter) helpers() []jen.Code {
+	return []jen.Code{
+		jen.Func().Id("echoHandler").Params(jen.Id("handler").Qual("net/http", "HandlerFunc")).Qual("github.com/labstack/echo/v4", "HandlerFunc").Block(
+			jen.Return(jen.Func().Params(jen.Id("c").Qual("github.com/labstack/echo/v4", "Context")).Error().Block(
+				jen.Id("r").Op(":=").Id("c").Dot("Request").Call(),
+				jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("c").Dot("ParamNames").Call()).Block(
+					jen.Id("r").Dot("SetPathValue").Call(jen.Id("name"), jen.Id("c").Dot("Param").Call(jen.Id("name"))),
+				),
+				jen.Line().Id("handler").Call(jen.Id("c").Dot("Response").Call(), jen.Id("r")),
+				jen.Line().Return(jen.Nil()),
+			)),
+		),
+	}
+}
+
+type ginRouter struct{}
+
+func (ginRouter) checkPath(path string) error {
+	_, err := pathParameterNames(path, "gin")
+	return err
+}
+
+// checkPaths rejects parameters with different names at the same segment of several paths, like /a/{id} and
+// /a/{name}/b, gin panics on them while the routes are registered.
+func (ginRouter) checkPaths(paths []string) error {
+	type wildcard struct{ path, name string }
+
+	wildcards := map[string]wildcard{}
+	for _, path := range paths {
+		var prefix string
+		for _, segment := range strings.Split(path, "/") {
+			if !strings.HasPrefix(segment, "{") {
+				prefix += segment + "/"
+				continue
+			}
+
+			name := segment[1 : len(segment)-1]
+			if other, ok := wildcards[prefix]; ok && other.name != name {
+				return fmt.Errorf("paths '%s' and '%s' have the parameters '%s' and '%s' at the same segment, it is not supported by the gin router", other.path, path, other.name, name)
+			}
+
+			wildcards[prefix] = wildcard{path: path, name: name}
+			prefix += ":/"
+		}
+	}
+
+	return nil
+}
+
+func (ginRouter) routerType() jen.Code {
+	return jen.Op("*").Qual("github.com/gin-gonic/gin", "Engine")
+}
+
+func (ginRouter) handler(router jen.Code) jen.Code {
+	return router
+}
+
+func (ginRouter) route(method string, path string, handler jen.Code) jen.Code {
+	return jen.Id("router").Dot("router").Dot(strings.ToUpper(method)).
+		Call(jen.Lit(replacePathParameters(path, func(name string) string { return ":" + name })), jen.Id("ginHandler").Call(handler))
+}
+
+func (ginRouter) pathParameter(name string) jen.Code {
+	return jen.Id("r").Dot("PathValue").Call(jen.Lit(name))
+}
+
+func (ginRouter) helpers() []jen.Code {
+	return []jen.Code{
+		jen.Func().Id("ginHandler").Params(jen.Id("handler").Qual("net/http", "HandlerFunc")).Qual("github.com/gin-gonic/gin", "HandlerFunc").Block(
+			jen.Return(jen.Func().Params(jen.Id("c").Op("*").Qual("github.com/gin-gonic/gin", "Context")).Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("param")).Op(":=").Range().Id("c").Dot("Params")).Block(
+					jen.Id("c").Dot("Request").Dot("SetPathValue").Call(jen.Id("param").Dot("Key"), jen.Id("param").Dot("Value")),
+				),
+				jen.Line().Id("handler").Call(jen.Id("c").Dot("Writer"), jen.Id("c").Dot("Request")),
+			)),
+		),
+	}
+}
+
+// fiberRouter serves the wrappers through the net/http adaptor of fiber, <Tag>Handler returns the fiber app
+// adapted to http.Handler. The adaptor buffers responses, streams are written once they end.
+type fiberRouter struct{}
+
+// checkPath rejects parameter names fiber would split, like {user-id} which fiber reads as the parameter user.
+func (fiberRouter) checkPath(path string) error {
+	names, err := pathParameterNames(path, "fiber")
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if strings.IndexFunc(name, func(r rune) bool { return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) }) >= 0 {
+			return fmt.Errorf("path '%s' has the parameter '%s' that is not a valid name of a fiber parameter", path, name)
+		}
+	}
+
+	return nil
+}
+
+func (fiberRouter) routerType() jen.Code {
+	return jen.Op("*").Qual("github.com/gofiber/fiber/v2", "App")
+}
+
+func (fiberRouter) handler(router jen.Code) jen.Code {
+	return jen.Qual("github.com/gofiber/fiber/v2/middleware/adaptor", "FiberApp").Call(router)
+}
+
+func (fiberRouter) route(method string, path string, handler jen.Code) jen.Code {
+	return jen.Id("router").Dot("router").Dot(method).
+		Call(jen.Lit(replacePathParameters(path, func(name string) string { return ":" + name })), jen.Id("fiberHandler").Call(handler))
+}
+
+func (fiberRouter) pathParameter(name string) jen.Code {
+	return jen.Id("r").Dot("PathValue").Call(jen.Lit(name))
+}
+
+func (fiberRouter) helpers() []jen.Code {
+	return []jen.Code{
+		jen.Func().Id("fiberHandler").Params(jen.Id("handler").Qual("net/http", "HandlerFunc")).Qual("github.com/gofiber/fiber/v2", "Handler").Block(
+			jen.Return(jen.Func().Params(jen.Id("c").Op("*").Qual("github.com/gofiber/fiber/v2", "Ctx")).Error().Block(
+				jen.Id("params").Op(":=").Id("c").Dot("AllParams").Call(),
+				jen.Line().Return(jen.Qual("github.com/gofiber/fiber/v2/middleware/adaptor", "HTTPHandlerFunc").Call(
+					jen.Func().Params(jen.Id("w").Qual("net/http", "ResponseWriter"), jen.Id("r").Op("*").Qual("net/http", "Request")).Block(
+						jen.For(jen.List(jen.Id("name"), jen.Id("value")).Op(":=").Range().Id("params")).Block(
+							jen.Id("r").Dot("SetPathValue").Call(jen.Id("name"), jen.Id("value")),
+						),
+						jen.Line().Id("handler").Call(jen.Id("w"), jen.Id("r")),
+					)).Call(jen.Id("c"))),
+			)),
+		),
+	}
+}
+
+// pathParameterNames returns the parameter names of an openapi path. Parameters that do not fill a whole segment
+// can not be expressed by the stdlib, echo, gin and fiber routers.
+func pathParameterNames(path string, routerName string) (names []string, err error) {
+	for _, segment := range strings.Split(path, "/") {
+		if !strings.ContainsAny(segment, "{}") {
+			continue
+		}
+
+		if !strings.HasPrefix(segment, "{") || strings.IndexAny(segment[1:], "{}") != len(segment)-2 {
+			return nil, fmt.Errorf("path '%s' has a parameter that does not fill a whole segment, it is not supported by the %s router", path, routerName)
+		}
+
+		names = append(names, segment[1:len(segment)-1])
+	}
+
+	return names, nil
+}
+
+// replacePathParameters rewrites every {name} segment of an openapi path with replace(name), the path is checked by
+// the router beforehand.
+func replacePathParameters(path string, replace func(name string) string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = replace(segment[1 : len(segment)-1])
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPathParameterNames(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		expectNames []string
+		expectError bool
+	}{
+		{
+			name: "Path without parameters",
+			path: "/users",
+		},
+		{
+			name:        "Whole segment parameters",
+			path:        "/users/{userId}/pets/{pet-id}",
+			expectNames: []string{"userId", "pet-id"},
+		},
+		{
+			name:        "Parameter with a suffix",
+			path:        "/files/{name}.json",
+			expectError: true,
+		},
+		{
+			name:        "Parameter with a prefix",
+			path:        "/files/v{version}",
+			expectError: true,
+		},
+		{
+			name:        "Two parameters in a segment",
+			path:        "/flights/{from}-{to}",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, err := pathParameterNames(tt.path, "stdlib")
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "does not fill a whole segment") {
+					t.Errorf("Expected a partial segment error, got %v %v", names, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(names, tt.expectNames) {
+				t.Errorf("Expected the names %v, got %v", tt.expectNames, names)
+			}
+		})
+	}
+}
+
+func TestRouterCheckPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		router      routerBackend
+		path        string
+		expectError string
+	}{
+		{
+			name:   "Stdlib wildcards of distinct parameters",
+			router: stdlibRouter{},
+			path:   "/users/{user-id}/pets/{petId}",
+		},
+		{
+			name:        "Stdlib parameters mapping to the same wildcard",
+			router:      stdlibRouter{},
+			path:        "/users/{a-b}/pets/{a_b}",
+			expectError: "both map to the 'a_b' wildcard",
+		},
+		{
+			name:        "Stdlib partial segment parameter",
+			router:      stdlibRouter{},
+			path:        "/files/{name}.json",
+			expectError: "not supported by the stdlib router",
+		},
+		{
+			name:        "Echo partial segment parameter",
+			router:      echoRouter{},
+			path:        "/files/{name}.json",
+			expectError: "not supported by the echo router",
+		},
+		{
+			name:        "Gin partial segment parameter",
+			router:      ginRouter{},
+			path:        "/files/{name}.json",
+			expectError: "not supported by the gin router",
+		},
+		{
+			name:   "Fiber parameter",
+			router: fiberRouter{},
+			path:   "/users/{user_id}",
+		},
+		{
+			name:        "Fiber parameter split at a dash",
+			router:      fiberRouter{},
+			path:        "/users/{user-id}",
+			expectError: "not a valid name of a fiber parameter",
+		},
+		{
+			name:   "Chi partial segment parameter",
+			router: chiRouter{},
+			path:   "/files/{name}.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.router.checkPath(tt.path)
+
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected an error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestGinCheckPaths(t *testing.T) {
+	tests := []struct {
+		name        string
+		paths       []string
+		expectError bool
+	}{
+		{
+			name:  "Same parameter names",
+			paths: []string{"/a/{id}", "/a/{id}/b", "/a/{id}/c/{name}"},
+		},
+		{
+			name:  "Static segment next to a parameter",
+			paths: []string{"/a/new", "/a/{id}"},
+		},
+		{
+			name:  "Different names at different segments",
+			paths: []string{"/a/{id}", "/b/{name}"},
+		},
+		{
+			name:        "Different names at the same segment",
+			paths:       []string{"/a/{id}", "/a/{name}/b"},
+			expectError: true,
+		},
+		{
+			name:        "Different names below a shared parameter",
+			paths:       []string{"/a/{id}/b/{x}", "/a/{id}/b/{y}/c"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ginRouter{}.checkPaths(tt.paths)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "not supported by the gin router") {
+					t.Errorf("Expected a gin wildcard conflict, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
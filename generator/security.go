@@ -0,0 +1,240 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// isScopedScheme reports whether scheme grants scopes: oauth2 and openIdConnect, both sending a bearer token.
+func isScopedScheme(scheme *openapi3.SecurityScheme) bool {
+	return scheme.Type == "oauth2" || scheme.Type == "openIdConnect"
+}
+
+// isMutualTLS reports whether scheme authenticates the client certificate of the TLS connection.
+func isMutualTLS(scheme *openapi3.SecurityScheme) bool {
+	return scheme.Type == "mutualTLS"
+}
+
+// securitySchemeNames are the names of the security schemes of swagger matching is.
+func securitySchemeNames(swagger *openapi3.T, is func(*openapi3.SecurityScheme) bool) map[string]bool {
+	schemes := map[string]bool{}
+	if swagger.Components == nil {
+		return schemes
+	}
+
+	for name, scheme := range swagger.Components.SecuritySchemes {
+		if scheme.Value != nil && is(scheme.Value) {
+			schemes[name] = true
+		}
+	}
+
+	return schemes
+}
+
+// usesScopes reports whether a security requirement of operation names an oauth2 or openIdConnect scheme.
+func (generator *Generator) usesScopes(operation *openapi3.Operation) bool {
+	if operation.Security == nil {
+		return false
+	}
+
+	for _, requirement := range *operation.Security {
+		for name := range requirement {
+			if generator.scopedSchemes[name] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// securityScopesField is the SecurityScopes of a request of an operation secured by an oauth2 or openIdConnect scheme.
+// The granted scopes are kept apart from SecurityCheckResults, which keeps holding the credential of each scheme.
+func (generator *Generator) securityScopesField(operation *openapi3.Operation) jen.Code {
+	if !generator.usesScopes(operation) {
+		return jen.Null()
+	}
+
+	return jen.Id("SecurityScopes").Map(jen.Id("SecurityScheme")).Index().String()
+}
+
+// securityRequirementProcessor is the processor of a scheme of a security requirement, scoped schemes carry the
+// scopes required by the requirement.
+func (generator *Generator) securityRequirementProcessor(name string, scopes []string) jen.Code {
+	processor := jen.Id("router").Dot("securityHandlers").Index(jen.Id("SecurityScheme" + strings.Title(name)))
+	if !generator.scopedSchemes[name] {
+		return processor
+	}
+
+	return processor.Dot("withScopes").Call(literals(scopes)...)
+}
+
+// securityProcessorHandle assigns the SecuritySchemas method of a scheme to its processor in <Tag>Handler, mutualTLS
+// methods are handed the leaf certificate the extractor found verified.
+func (generator *Generator) securityProcessorHandle(name string) jen.Code {
+	if generator.scopedSchemes[name] {
+		return jen.Id("authorize").Op(":").Id("securitySchemas").Dot("SecurityScheme" + strings.Title(name))
+	}
+
+	if generator.mutualTLSSchemes[name] {
+		return jen.Id("handle").Op(":").Func().Params(
+			jen.Id("r").Op("*").Qual("net/http", "Request"),
+			jen.Id("scheme").Id("SecurityScheme"),
+			jen.Id("_").String(),
+			jen.Id("_").String()).Params(generator.withSecurityPrincipal(jen.Any(), jen.Error())...).Block(
+			jen.Return(jen.Id("securitySchemas").Dot("SecurityScheme"+strings.Title(name)).Call(
+				jen.Id("r"), jen.Id("scheme"), jen.Id("r").Dot("TLS").Dot("PeerCertificates").Index(jen.Lit(0)))),
+		)
+	}
+
+	return jen.Id("handle").Op(":").Id("securitySchemas").Dot("SecurityScheme" + strings.Title(name))
+}
+
+// securityProcessorCheck runs the SecuritySchemas method of a processor, the granted scopes of scoped schemes are
+// recorded in SecurityScopes and the principals in SecurityPrincipals.
+func (generator *Generator) securityProcessorCheck(operation *openapi3.Operation, failed []jen.Code) (check jen.Code, record jen.Code) {
+	record = generator.securityPrincipalRecord()
+
+	if !generator.usesScopes(operation) {
+		if generator.config.SecurityPrincipals {
+			return jen.List(jen.Id("principal"), jen.Id("err")).Op(":=").Id("processor").Dot("handle").Call(jen.Id("r"),
+				jen.Id("processor").Dot("scheme"),
+				jen.Id("name"),
+				jen.Id("value")).Line().
+				If(jen.Id("err").Op("!=").Id("nil")).Block(failed...), record
+		}
+
+		return jen.If(jen.Id("err").Op(":=").Id("processor").Dot("handle").Call(jen.Id("r"),
+			jen.Id("processor").Dot("scheme"),
+			jen.Id("name"),
+			jen.Id("value")),
+			jen.Id("err").Op("!=").Id("nil")).Block(failed...), record
+	}
+
+	check = jen.List(generator.withSecurityPrincipal(jen.Id("principal"), jen.Id("granted"), jen.Id("err"))...).Op(":=").Id("processor").Dot("check").Call(jen.Id("r"), jen.Id("name"), jen.Id("value")).Line().
+		If(jen.Id("err").Op("!=").Id("nil")).Block(failed...)
+
+	scopes := jen.Line().If(jen.Id("processor").Dot("authorize").Op("!=").Nil()).Block(
+		jen.If(jen.Id("request").Dot("SecurityScopes").Op("==").Nil()).Block(
+			jen.Id("request").Dot("SecurityScopes").Op("=").Map(jen.Id("SecurityScheme")).Index().String().Values()),
+		jen.Line().Id("request").Dot("SecurityScopes").Index(jen.Id("processor").Dot("scheme")).Op("=").Id("granted"))
+	if generator.config.SecurityPrincipals {
+		scopes = scopes.Line().Add(record)
+	}
+
+	return check, scopes
+}
+
+// securityProcessorScopes are the fields and methods of securityProcessor for oauth2 and openIdConnect schemes: the
+// SecuritySchemas method returns the granted scopes, check fails when one of the required scopes is not granted.
+func (generator *Generator) securityProcessorScopes() (fields []jen.Code, methods jen.Code) {
+	if len(generator.scopedSchemes) == 0 {
+		return nil, jen.Null()
+	}
+
+	fields = []jen.Code{
+		jen.Id("authorize").Func().Params(jen.Id("r").Op("*").Qual("net/http", "Request"),
+			jen.Id("scheme").Id("SecurityScheme"), jen.Id("name").Id("string"),
+			jen.Id("value").Id("string"), jen.Id("scopes").Index().String()).Params(
+			generator.withSecurityPrincipal(jen.Any(), jen.Index().String(), jen.Id("error"))...),
+		jen.Id("scopes").Index().String(),
+	}
+
+	methods = jen.Line().Line().
+		Comment("withScopes is the processor of a security requirement requiring scopes.").Line().
+		Func().Params(jen.Id("processor").Id("securityProcessor")).Id("withScopes").Params(jen.Id("scopes").Op("...").String()).Id("securityProcessor").Block(
+		jen.Id("processor").Dot("scopes").Op("=").Id("scopes"),
+		jen.Line().Return(jen.Id("processor")),
+	).Line().Line().
+		Comment("check runs the SecuritySchemas method of the processor, the granted scopes have to contain the required ones.").Line().
+		Func().Params(jen.Id("processor").Id("securityProcessor")).Id("check").Params(
+		jen.Id("r").Op("*").Qual("net/http", "Request"), jen.Id("name").String(), jen.Id("value").String()).Params(
+		generator.withSecurityPrincipal(jen.Any(), jen.Index().String(), jen.Error())...).Block(
+		generator.securityProcessorHandled(),
+		jen.Line().List(generator.withSecurityPrincipal(jen.Id("principal"), jen.Id("granted"), jen.Id("err"))...).Op(":=").Id("processor").Dot("authorize").Call(jen.Id("r"), jen.Id("processor").Dot("scheme"), jen.Id("name"), jen.Id("value"), jen.Id("processor").Dot("scopes")),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(generator.withSecurityPrincipal(jen.Nil(), jen.Nil(), jen.Id("err"))...),
+		),
+		jen.Line().Var().Id("missing").Index().String(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("scope")).Op(":=").Range().Id("processor").Dot("scopes")).Block(
+			jen.If(jen.Op("!").Qual("slices", "Contains").Call(jen.Id("granted"), jen.Id("scope"))).Block(
+				jen.Id("missing").Op("=").Append(jen.Id("missing"), jen.Id("scope")),
+			),
+		),
+		jen.Line().If(jen.Len(jen.Id("missing")).Op(">").Lit(0)).Block(
+			jen.Return(generator.withSecurityPrincipal(jen.Nil(), jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("missing scopes %q"), jen.Id("missing")))...),
+		),
+		jen.Line().Return(generator.withSecurityPrincipal(jen.Id("principal"), jen.Id("granted"), jen.Nil())...),
+	)
+
+	return fields, methods
+}
+
+// securityProcessorHandled runs the SecuritySchemas method of a processor of an unscoped scheme in check.
+func (generator *Generator) securityProcessorHandled() jen.Code {
+	handle := jen.Id("processor").Dot("handle").Call(jen.Id("r"), jen.Id("processor").Dot("scheme"), jen.Id("name"), jen.Id("value"))
+	if !generator.config.SecurityPrincipals {
+		return jen.If(jen.Id("processor").Dot("authorize").Op("==").Nil()).Block(
+			jen.Return(jen.Nil(), handle),
+		)
+	}
+
+	return jen.If(jen.Id("processor").Dot("authorize").Op("==").Nil()).Block(
+		jen.List(jen.Id("principal"), jen.Id("err")).Op(":=").Add(handle),
+		jen.Return(jen.Id("principal"), jen.Nil(), jen.Id("err")),
+	)
+}
+
+// securitySchemeMethod is the SecuritySchemas method of a scheme, oauth2 and openIdConnect methods take the scopes
+// required by the security requirement and return the scopes granted to the token, mutualTLS methods take the client
+// certificate.
+func (generator *Generator) securitySchemeMethod(name string) jen.Code {
+	params := []jen.Code{
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+		jen.Id("scheme").Id("SecurityScheme"),
+		jen.Id("name").Id("string"),
+		jen.Id("value").Id("string"),
+	}
+
+	if generator.scopedSchemes[name] {
+		return jen.Id("SecurityScheme" + strings.Title(name)).Params(append(params, jen.Id("scopes").Index().String())...).Params(
+			generator.withSecurityPrincipal(jen.Id("principal").Any(), jen.Id("granted").Index().String(), jen.Id("err").Error())...)
+	}
+
+	if generator.mutualTLSSchemes[name] {
+		return jen.Id("SecurityScheme"+strings.Title(name)).Params(
+			jen.Id("r").Op("*").Qual("net/http", "Request"),
+			jen.Id("scheme").Id("SecurityScheme"),
+			jen.Id("certificate").Op("*").Qual("crypto/x509", "Certificate")).Params(generator.securitySchemeMethodResults()...)
+	}
+
+	return jen.Id("SecurityScheme" + strings.Title(name)).Params(params...).Params(generator.securitySchemeMethodResults()...)
+}
+
+// securitySchemeMethodResults are the results of a SecuritySchemas method of an unscoped scheme.
+func (generator *Generator) securitySchemeMethodResults() []jen.Code {
+	if !generator.config.SecurityPrincipals {
+		return []jen.Code{jen.Id("error")}
+	}
+
+	return generator.withSecurityPrincipal(jen.Id("principal").Any(), jen.Id("err").Error())
+}
+
+// securityExtractor reads the credential of an apiKey in query or a mutualTLS scheme, the credential of a client
+// certificate is its subject. Certificates count only once verified against the ClientCAs of the TLS config.
+func (generator *Generator) securityExtractor(scheme *openapi3.SecurityScheme) jen.Code {
+	switch {
+	case scheme.Type == "apiKey" && scheme.In == "query":
+		return jen.Id("value").Op(":=").Id("r").Dot("URL").Dot("Query").Call().Dot("Get").Call(jen.Lit(scheme.Name)).Line().
+			Return().List(jen.Lit(scheme.Name), jen.Id("value"), jen.Id("value").Op("!=").Lit(""))
+	case isMutualTLS(scheme):
+		return jen.If(jen.Id("r").Dot("TLS").Op("==").Nil().Op("||").Len(jen.Id("r").Dot("TLS").Dot("PeerCertificates")).Op("==").Lit(0).Op("||").
+			Len(jen.Id("r").Dot("TLS").Dot("VerifiedChains")).Op("==").Lit(0)).Block(
+			jen.Return().List(jen.Lit(""), jen.Lit(""), jen.Id("false"))).Line().Line().
+			Return().List(jen.Lit(""), jen.Id("r").Dot("TLS").Dot("PeerCertificates").Index(jen.Lit(0)).Dot("Subject").Dot("String").Call(), jen.Id("true"))
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// isEventStream reports whether responses of contentType are Server-Sent Events.
+func isEventStream(contentType string) bool {
+	return contentType == "text/event-stream"
+}
+
+// isStreamable reports whether responses of contentType may be streamed from an io.Reader: octet-stream, images,
+// audio, video and every binary schema.
+func isStreamable(contentType string, schema *openapi3.SchemaRef) bool {
+	for _, prefix := range []string{"image/", "audio/", "video/"} {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+
+	return contentType == "application/octet-stream" || isBinarySchema(schema)
+}
+
+// responseStreams reports whether any response of operation is streamed or sent as events.
+func responseStreams(operation *openapi3.Operation) (streams bool, events bool) {
+	for _, response := range operation.Responses.Map() {
+		for contentType, mediaType := range response.Value.Content {
+			streams = streams || isStreamable(contentType, mediaType.Schema)
+			events = events || isEventStream(contentType)
+		}
+	}
+
+	return streams, events
+}
+
+// streamBodyBuilders are the BodyStream and Events builders of a streamable or an event stream content type.
+func (generator *Generator) streamBodyBuilders(contentTypeName string, schema *openapi3.SchemaRef, bodyType string, bodyBuilderName string, nextBuilderName string) (results []jen.Code) {
+	if isStreamable(contentTypeName, schema) {
+		results = append(results, jen.Comment("BodyStream copies body into the response, an io.Closer body is closed after it is sent.").Line().
+			Func().Params(
+			jen.Id("builder").Op("*").Id(bodyBuilderName)).Id("BodyStream").Params(
+			jen.Id("body").Qual("io", "Reader")).Params(
+			jen.Op("*").Id(nextBuilderName)).Block(
+			jen.Id("builder").Dot("response").Dot("bodyStream").Op("=").Id("body"),
+			jen.Line().Return().Op("&").Id(nextBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
+		))
+	}
+
+	if isEventStream(contentTypeName) {
+		results = append(results, jen.Comment("Events sends every event received from events until it is closed or the request is canceled.").Line().
+			Func().Params(
+			jen.Id("builder").Op("*").Id(bodyBuilderName)).Id("Events").Params(
+			jen.Id("events").Op("<-").Chan().Qual(generator.config.ComponentsPackage, bodyType)).Params(
+			jen.Op("*").Id(nextBuilderName)).Block(
+			jen.Id("builder").Dot("response").Dot("events").Op("=").Func().Params(jen.Id("ctx").Qual("context", "Context")).Params(jen.Any(), jen.Bool()).Block(
+				jen.Select().Block(
+					jen.Case(jen.List(jen.Id("event"), jen.Id("ok")).Op(":=").Op("<-").Id("events")).Block(
+						jen.Return(jen.Id("event"), jen.Id("ok")),
+					),
+					jen.Case(jen.Op("<-").Id("ctx").Dot("Done").Call()).Block(
+						jen.Return(jen.Nil(), jen.False()),
+					),
+				),
+			),
+			jen.If(jen.Id("builder").Dot("response").Dot("headers").Op("==").Nil()).Block(
+				jen.Id("builder").Dot("response").Dot("headers").Op("=").Make(jen.Map(jen.String()).String()),
+			),
+			jen.Id("builder").Dot("response").Dot("headers").Index(jen.Lit("Cache-Control")).Op("=").Lit("no-cache"),
+			jen.Line().Return().Op("&").Id(nextBuilderName).Values(jen.Id("response").Op(":").Id("builder").Dot("response")),
+		))
+	}
+
+	return results
+}
+
+// wrapperStreams writes a streamed body or the events of a response, the status code and headers are already written.
+// Write failures are reported through ResponseBodyWriteFailed and stop the response.
+func (generator *Generator) wrapperStreams(name string, operation *openapi3.Operation) (code []jen.Code) {
+	streams, events := responseStreams(operation)
+
+	writeFailed := func(count jen.Code) jen.Code {
+		return jen.If(jen.Id("router").Dot("hooks").Dot("ResponseBodyWriteFailed").Op("!=").Id("nil")).Block(
+			jen.Id("router").Dot("hooks").Dot("ResponseBodyWriteFailed").Call(jen.Id("r"), jen.Lit(name), count, jen.Id("err")))
+	}
+
+	completed := jen.If(jen.Id("router").Dot("hooks").Dot("ServiceCompleted").Op("!=").Id("nil")).
+		Block(jen.Id("router").Dot("hooks").Dot("ServiceCompleted").Call(jen.Id("r"), jen.Lit(name)))
+
+	if streams {
+		code = append(code, jen.If(jen.Id("stream").Op(":=").Id("response").Dot("bodyStream").Call(), jen.Id("stream").Op("!=").Nil()).Block(
+			jen.If(jen.List(jen.Id("closer"), jen.Id("ok")).Op(":=").Id("stream").Assert(jen.Qual("io", "Closer")), jen.Id("ok")).Block(
+				jen.Defer().Id("closer").Dot("Close").Call(),
+			),
+			jen.Line().List(jen.Id("count"), jen.Id("err")).Op(":=").Qual("io", "Copy").Call(jen.Id("w"), jen.Id("contextReader").Values(jen.Id("ctx").Op(":").Id("r").Dot("Context").Call(), jen.Id("reader").Op(":").Id("stream"))),
+			jen.If(jen.Id("err").Op("!=").Nil()).Block(writeFailed(jen.Int().Parens(jen.Id("count")))).
+				Else().If(jen.Id("router").Dot("hooks").Dot("ResponseBodyWriteCompleted").Op("!=").Id("nil")).Block(
+				jen.Id("router").Dot("hooks").Dot("ResponseBodyWriteCompleted").Call(jen.Id("r"), jen.Lit(name), jen.Int().Parens(jen.Id("count")))),
+			jen.Line().Add(completed),
+			jen.Line().Return(),
+		).Line())
+	}
+
+	if events {
+		marshal := jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("event"))
+		if generator.config.Codecs {
+			marshal = jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Id("router").Dot("codecs").Dot("encode").Call(jen.Lit("application/json"), jen.Id("event"))
+		}
+
+		code = append(code, jen.If(jen.Id("next").Op(":=").Id("response").Dot("events").Call(), jen.Id("next").Op("!=").Nil()).Block(
+			jen.Id("controller").Op(":=").Qual("net/http", "NewResponseController").Call(jen.Id("w")),
+			jen.Id("_").Op("=").Id("controller").Dot("Flush").Call(),
+			jen.Line().For().Block(
+				jen.List(jen.Id("event"), jen.Id("ok")).Op(":=").Id("next").Call(jen.Id("r").Dot("Context").Call()),
+				jen.If(jen.Op("!").Id("ok")).Block(jen.Break()),
+				jen.Line().Add(marshal),
+				jen.If(jen.Id("err").Op("!=").Nil()).Block(
+					jen.If(jen.Id("router").Dot("hooks").Dot("ResponseBodyMarshalFailed").Op("!=").Id("nil")).Block(
+						jen.Id("router").Dot("hooks").Dot("ResponseBodyMarshalFailed").Call(jen.Id("w"), jen.Id("r"), jen.Lit(name), jen.Id("err"))),
+					jen.Break(),
+				),
+				jen.Line().List(jen.Id("count"), jen.Id("err")).Op(":=").Id("writeEvent").Call(jen.Id("w"), jen.Id("data")),
+				jen.If(jen.Id("err").Op("!=").Nil()).Block(writeFailed(jen.Id("count")), jen.Break()),
+				jen.Line().Id("_").Op("=").Id("controller").Dot("Flush").Call(),
+			),
+			jen.Line().Add(completed),
+			jen.Line().Return(),
+		).Line())
+	}
+
+	return code
+}
+
+// streamHelpers are emitted into the routes when a response is streamed or sent as events.
+func (generator *Generator) streamHelpers() (helpers []jen.Code) {
+	if generator.useStreams {
+		helpers = append(helpers, jen.Comment("contextReader stops reading a streamed body once the request is canceled.").Line().
+			Type().Id("contextReader").Struct(
+			jen.Id("ctx").Qual("context", "Context"),
+			jen.Id("reader").Qual("io", "Reader"),
+		).Line())
+
+		helpers = append(helpers, jen.Func().Params(jen.Id("reader").Id("contextReader")).Id("Read").Params(jen.Id("p").Index().Byte()).Params(jen.Int(), jen.Error()).Block(
+			jen.If(jen.Id("err").Op(":=").Id("reader").Dot("ctx").Dot("Err").Call(), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Lit(0), jen.Id("err")),
+			),
+			jen.Line().Return(jen.Id("reader").Dot("reader").Dot("Read").Call(jen.Id("p"))),
+		).Line())
+	}
+
+	if generator.useEvents {
+		helpers = append(helpers, jen.Comment("writeEvent writes data as a Server-Sent Event, every line of data is a data field.").Line().
+			Func().Id("writeEvent").Params(jen.Id("w").Qual("io", "Writer"), jen.Id("data").Index().Byte()).Params(jen.Int(), jen.Error()).Block(
+			jen.Var().Id("event").Qual("bytes", "Buffer"),
+			jen.For(jen.List(jen.Id("_"), jen.Id("line")).Op(":=").Range().Qual("bytes", "Split").Call(jen.Id("data"), jen.Index().Byte().Parens(jen.Lit("\n")))).Block(
+				jen.Id("event").Dot("WriteString").Call(jen.Lit("data: ")),
+				jen.Id("event").Dot("Write").Call(jen.Id("line")),
+				jen.Id("event").Dot("WriteByte").Call(jen.LitRune('\n')),
+			),
+			jen.Id("event").Dot("WriteByte").Call(jen.LitRune('\n')),
+			jen.Line().Return(jen.Id("w").Dot("Write").Call(jen.Id("event").Dot("Bytes").Call())),
+		).Line())
+	}
+
+	return helpers
+}
+
+// responseStreamMethods implement the bodyStream and events methods of responseInterface for a response type.
+func (generator *Generator) responseStreamMethods(responseName string) jen.Code {
+	result := jen.Null()
+	if generator.useStreams {
+		result = result.Add(jen.Line(), jen.Line()).Add(jen.Func().Params(
+			jen.Id("response").Id(responseName)).Id("bodyStream").Params().Params(jen.Qual("io", "Reader")).Block(
+			jen.Return().Id("response").Dot("response").Dot("bodyStream"),
+		))
+	}
+
+	if generator.useEvents {
+		result = result.Add(jen.Line(), jen.Line()).Add(jen.Func().Params(
+			jen.Id("response").Id(responseName)).Id("events").Params().Params(jen.Func().Params(jen.Qual("context", "Context")).Params(jen.Any(), jen.Bool())).Block(
+			jen.Return().Id("response").Dot("response").Dot("events"),
+		))
+	}
+
+	return result
+}
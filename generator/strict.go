@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// serviceMethodResults are the results of a <Tag>Service method, -strict-service adds an error to the response.
+func (generator *Generator) serviceMethodResults(responseName string) jen.Code {
+	if !generator.config.StrictService {
+		return jen.Params(jen.Id(responseName))
+	}
+
+	return jen.Params(jen.Id(responseName), jen.Error())
+}
+
+// serviceCall calls the service method of name with the request parsed ahead or inline. With -strict-service a
+// returned error is reported through the ServiceFailed hook and answered by router.serviceErrors instead of the response.
+func (generator *Generator) serviceCall(name string, parsedAhead bool) []jen.Code {
+	call := jen.Id("router").Dot("service").Dot(name).Call(generator.serviceCallParams(name, parsedAhead)...)
+
+	if !generator.config.StrictService {
+		return []jen.Code{jen.Id("response").Op(":=").Add(call)}
+	}
+
+	return []jen.Code{
+		jen.List(jen.Id("response"), jen.Id("err")).Op(":=").Add(call),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.If(jen.Id("router").Dot("hooks").Dot("ServiceFailed").Op("!=").Id("nil")).Block(
+				jen.Id("router").Dot("hooks").Dot("ServiceFailed").Call(jen.Id("r"), jen.Lit(name), jen.Id("err"))),
+			jen.Line().Id("router").Dot("serviceErrors").Dot("HandleServiceError").Call(jen.Id("w"), jen.Id("r"), jen.Lit(name), jen.Id("err")),
+			jen.Return(),
+		),
+	}
+}
+
+// serviceFailedHook is the ServiceFailed hook of the Hooks with -strict-service.
+func (generator *Generator) serviceFailedHook() jen.Code {
+	if !generator.config.StrictService {
+		return jen.Null()
+	}
+
+	return jen.Id("ServiceFailed").Func().Params(jen.Op("*").Qual("net/http",
+		"Request"),
+		jen.Id("string"),
+		jen.Id("error"))
+}
+
+// serviceErrorTypes is emitted into the routes with -strict-service. An error returned by a service method is answered
+// by the ServiceErrorHandler passed to <Tag>Handler, DefaultServiceErrorHandler answers 500.
+func (generator *Generator) serviceErrorTypes() []jen.Code {
+	if !generator.config.StrictService {
+		return nil
+	}
+
+	respond := []jen.Code{
+		jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Qual("net/http", "StatusText").Call(jen.Qual("net/http", "StatusInternalServerError")), jen.Qual("net/http", "StatusInternalServerError")),
+	}
+	if generator.config.ProblemDetails {
+		respond = []jen.Code{
+			jen.Id("problem").Op(":=").Id("NewProblemDetails").Call(jen.Id("r"), jen.Qual("net/http", "StatusInternalServerError"), jen.Nil()),
+			jen.Line().Id("w").Dot("Header").Call().Dot("Set").Call(jen.Lit("Content-Type"), jen.Lit("application/problem+json")),
+			jen.Id("w").Dot("WriteHeader").Call(jen.Id("problem").Dot("Status")),
+			jen.Id("_").Op("=").Qual("encoding/json", "NewEncoder").Call(jen.Id("w")).Dot("Encode").Call(jen.Id("problem")),
+		}
+	}
+
+	defaultComment := "DefaultServiceErrorHandler answers 500 without exposing the error."
+	if generator.config.ProblemDetails {
+		defaultComment = "DefaultServiceErrorHandler answers a 500 application/problem+json without exposing the error."
+	}
+
+	return []jen.Code{
+		jen.Comment("ServiceErrorHandler answers requests whose service method returned an error, e.g. with the default response").Line().
+			Comment("of the operation. Nothing is written to w before it is called.").Line().
+			Type().Id("ServiceErrorHandler").Interface(
+			jen.Id("HandleServiceError").Params(
+				jen.Id("w").Qual("net/http", "ResponseWriter"),
+				jen.Id("r").Op("*").Qual("net/http", "Request"),
+				jen.Id("operation").String(),
+				jen.Id("err").Error()),
+		),
+		jen.Comment(defaultComment).Line().
+			Type().Id("DefaultServiceErrorHandler").Struct(),
+		jen.Func().Params(jen.Id("DefaultServiceErrorHandler")).Id("HandleServiceError").Params(
+			jen.Id("w").Qual("net/http", "ResponseWriter"),
+			jen.Id("r").Op("*").Qual("net/http", "Request"),
+			jen.Id("_").String(),
+			jen.Id("_").Error()).Block(respond...),
+	}
+}
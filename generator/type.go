@@ -24,6 +24,9 @@ const (
 	goSkipSecurityCheck = "x-go-skip-security-check"
 )
 
+// customTypeFormats are the string formats rendered as a type other than string.
+var customTypeFormats = []string{"byte", "binary", "date", "date-time", "duration", "iso4217-currency-code", "iso3166-alpha-2", "iso3166-alpha-3", "uuid", "json"}
+
 // isSchemaType safely checks if schema type matches the given type string.
 // Handles nil Type pointer (returns false if nil).
 func isSchemaType(t *openapi3.Types, typ string) bool {
@@ -67,6 +70,12 @@ func parseExtensionBool(ext any) bool {
 type Type struct {
 	normalizer *Normalizer          `di.inject:"normalizer"`
 	config     *configurator.Config `di.inject:"config"`
+
+	// civil types are emitted into the components only when a schema uses them
+	useDate     bool
+	useDuration bool
+	// binary strings are uploaded files while an inline multipart body is generated
+	binaryAsFile bool
 }
 
 func (typ *Type) fillJsonTag(into *jen.Statement, schemaRef *openapi3.SchemaRef, name string) {
@@ -132,13 +141,20 @@ func (typ *Type) fillGoType(into *jen.Statement, parentTypeName string, typeName
 
 	schema := schemaRef.Value
 
-	if schema.AnyOf != nil || schema.OneOf != nil {
-		into.Interface()
+	if schemaRef.Ref != "" {
+		into.Qual(typ.config.ComponentsPackage, typ.normalizer.extractNameFromRef(schemaRef.Ref))
 		return
 	}
 
-	if schemaRef.Ref != "" {
-		into.Qual(typ.config.ComponentsPackage, typ.normalizer.extractNameFromRef(schemaRef.Ref))
+	// inline unions of properties are generated as <Parent><Property> tagged unions next to their parent,
+	// other inline unions have no named type to unmarshal into
+	if isUnionSchema(schema) {
+		if parentTypeName != "" {
+			into.Qual(typ.config.ComponentsPackage, typeName)
+			return
+		}
+
+		into.Interface()
 		return
 	}
 
@@ -157,6 +173,11 @@ func (typ *Type) fillGoType(into *jen.Statement, parentTypeName string, typeName
 		}
 
 		if refSchema != nil {
+			// a component of a single reference is an alias, so it keeps the methods of the referenced component
+			if needAliasing && !typ.hasXGoType(refSchema.Value) {
+				into.Op("=")
+			}
+
 			typ.fillGoType(into, parentTypeName, typeName, refSchema, false, needAliasing)
 
 			if len(inlineSchemas) > 0 {
@@ -233,9 +254,20 @@ func (typ *Type) fillGoType(into *jen.Statement, parentTypeName string, typeName
 		typ.fillGoType(into, parentTypeName, typeName, schema.Items, false, false)
 		return
 	} else if isSchemaType(schema.Type, "integer") {
-		into.Int()
+		switch {
+		case typ.config.PreciseTypes && schema.Format == "int32":
+			into.Int32()
+		case typ.config.PreciseTypes && schema.Format == "int64":
+			into.Int64()
+		default:
+			into.Int()
+		}
 		return
 	} else if isSchemaType(schema.Type, "number") {
+		if typ.config.PreciseTypes && schema.Format == "float" {
+			into.Float32()
+			return
+		}
 		into.Float64()
 		return
 	} else if isSchemaType(schema.Type, "boolean") {
@@ -251,15 +283,36 @@ func (typ *Type) fillGoType(into *jen.Statement, parentTypeName string, typeName
 			into.Index().Byte()
 			return
 		case "binary":
+			if typ.binaryAsFile {
+				into.Op("*").Qual("mime/multipart", "FileHeader")
+				return
+			}
 			into.Index().Byte()
 			return
 		case "email":
 			into.String()
 			return
 		case "date":
+			if typ.config.PreciseTypes {
+				typ.useDate = true
+				into.Qual(typ.config.ComponentsPackage, "Date")
+				return
+			}
 			into.String()
 			return
 		case "date-time":
+			if typ.config.PreciseTypes {
+				into.Qual("time", "Time")
+				return
+			}
+			into.String()
+			return
+		case "duration":
+			if typ.config.PreciseTypes {
+				typ.useDuration = true
+				into.Qual(typ.config.ComponentsPackage, "Duration")
+				return
+			}
 			into.String()
 			return
 		case "iso4217-currency-code":
@@ -422,8 +475,18 @@ func (typ *Type) getXGoOmitempty(schema *openapi3.Schema) bool {
 	return value
 }
 
+func isTimeFormat(schema *openapi3.Schema) bool {
+	return isSchemaType(schema.Type, "string") && slices.Contains([]string{"date", "date-time", "duration"}, schema.Format)
+}
+
+// isPreciseTimeType reports whether the schema is rendered as time.Time, Date or Duration.
+func (typ *Type) isPreciseTimeType(schema *openapi3.Schema) bool {
+	return typ.config.PreciseTypes && isTimeFormat(schema)
+}
+
+// isCustomType reports whether a string schema is rendered as a type other than string, formats like email stay strings.
 func (typ *Type) isCustomType(schema *openapi3.Schema) bool {
-	return isSchemaType(schema.Type, "string") && (schema.Format != "" || typ.hasXGoTypeStringParse(schema))
+	return isSchemaType(schema.Type, "string") && (slices.Contains(customTypeFormats, schema.Format) || typ.hasXGoTypeStringParse(schema))
 }
 
 func (typ *Type) hasXGoSkipSecurityCheck(operation *openapi3.Operation) bool {
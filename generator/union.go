@@ -0,0 +1,194 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type unionVariant struct {
+	typeName            string
+	methodSuffix        string
+	discriminatorValues []string
+}
+
+func isUnionSchema(schema *openapi3.Schema) bool {
+	return len(schema.OneOf) > 0 || len(schema.AnyOf) > 0
+}
+
+// inlineUnionSchema returns the inline oneOf/anyOf schema of a property or of the items of an array property.
+func inlineUnionSchema(schema *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if schema.Ref != "" {
+		return nil
+	}
+
+	if isSchemaType(schema.Value.Type, "array") && schema.Value.Items != nil {
+		return inlineUnionSchema(schema.Value.Items)
+	}
+
+	if isUnionSchema(schema.Value) {
+		return schema
+	}
+
+	return nil
+}
+
+// unionFromSchema generates a tagged union for oneOf/anyOf schemas. The variant is picked by the discriminator
+// property when it is declared, otherwise each variant is unmarshalled and validated in turn and the first one that succeeds wins.
+func (generator *Generator) unionFromSchema(name string, schemaRef *openapi3.SchemaRef) jen.Code {
+	schema := schemaRef.Value
+	if schema.Discriminator == nil {
+		generator.useUnions = true
+	}
+
+	variantRefs := schema.OneOf
+	if len(variantRefs) == 0 {
+		variantRefs = schema.AnyOf
+	}
+
+	var inlineVariants []jen.Code
+	var variants []unionVariant
+	for i, variantRef := range variantRefs {
+		typeName := generator.normalizer.extractNameFromRef(variantRef.Ref)
+		variant := unionVariant{typeName: typeName, methodSuffix: typeName}
+		if typeName == "" {
+			variant.methodSuffix = "Variant" + strconv.Itoa(i+1)
+			variant.typeName = name + variant.methodSuffix
+			inlineVariants = append(inlineVariants, generator.inlineVariant(variant.typeName, variantRef))
+		}
+
+		if schema.Discriminator != nil {
+			for _, value := range sortedMapKeys(schema.Discriminator.Mapping) {
+				if generator.normalizer.extractNameFromRef(schema.Discriminator.Mapping[value]) == variant.typeName {
+					variant.discriminatorValues = append(variant.discriminatorValues, value)
+				}
+			}
+
+			if len(variant.discriminatorValues) == 0 && variantRef.Ref != "" {
+				variant.discriminatorValues = append(variant.discriminatorValues, variantRef.Ref[strings.LastIndex(variantRef.Ref, "/")+1:])
+			}
+		}
+
+		variants = append(variants, variant)
+	}
+
+	var declarations []jen.Code
+	declarations = append(declarations, jen.Type().Id(name).Struct(jen.Id("value").Interface()))
+
+	for _, variant := range variants {
+		declarations = append(declarations,
+			jen.Func().Id(name+"From"+variant.methodSuffix).Params(jen.Id("value").Qual(generator.config.ComponentsPackage, variant.typeName)).Id(name).Block(
+				jen.Return(jen.Id(name).Values(jen.Id("value").Op(":").Id("value"))),
+			),
+			jen.Func().Params(jen.Id("union").Id(name)).Id("As"+variant.methodSuffix).Params().Params(jen.Qual(generator.config.ComponentsPackage, variant.typeName), jen.Bool()).Block(
+				jen.List(jen.Id("value"), jen.Id("ok")).Op(":=").Id("union").Dot("value").Assert(jen.Qual(generator.config.ComponentsPackage, variant.typeName)),
+				jen.Return(jen.Id("value"), jen.Id("ok")),
+			),
+		)
+	}
+
+	declarations = append(declarations,
+		jen.Func().Params(jen.Id("union").Id(name)).Id("Value").Params().Interface().Block(
+			jen.Return(jen.Id("union").Dot("value")),
+		),
+		jen.Func().Params(jen.Id("union").Id(name)).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+			jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("union").Dot("value"))),
+		),
+		generator.unionUnmarshalFunc(name, schema, variants),
+		jen.Func().Params(jen.Id("union").Id(name)).Id("Validate").Params().Error().Block(
+			jen.If(jen.List(jen.Id("value"), jen.Id("ok")).Op(":=").Id("union").Dot("value").Assert(jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Validatable")), jen.Id("ok")).Block(
+				jen.Return(jen.Id("value").Dot("Validate").Call()),
+			),
+			jen.Line().Return(jen.Nil()),
+		),
+	)
+
+	return jen.Add(generator.normalizer.doubleLineAfterEachElement(append(inlineVariants, declarations...)...)...)
+}
+
+// inlineVariant declares an inline variant of a union. Variants without properties are aliases of their go type,
+// so a string variant and an integer variant are declared alike.
+func (generator *Generator) inlineVariant(name string, schema *openapi3.SchemaRef) jen.Code {
+	if value := schema.Value; len(value.Properties) > 0 || len(value.Enum) > 0 || isUnionSchema(value) || isEmbeddingAllOf(value) {
+		return generator.componentFromSchema(name, schema)
+	}
+
+	goType := jen.Null()
+	generator.typee.fillGoType(goType, "", name, schema, false, false)
+
+	return jen.Type().Id(name).Op("=").Add(goType)
+}
+
+func (generator *Generator) unionUnmarshalFunc(name string, schema *openapi3.Schema, variants []unionVariant) jen.Code {
+	var body []jen.Code
+
+	if schema.Discriminator != nil {
+		var cases []jen.Code
+		for _, variant := range variants {
+			if len(variant.discriminatorValues) == 0 {
+				continue
+			}
+
+			var values []jen.Code
+			for _, value := range variant.discriminatorValues {
+				values = append(values, jen.Lit(value))
+			}
+
+			cases = append(cases, jen.Case(values...).Block(
+				jen.Var().Id("value").Qual(generator.config.ComponentsPackage, variant.typeName),
+				jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("value")), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+				jen.Line().Id("union").Dot("value").Op("=").Id("value"),
+			))
+		}
+
+		cases = append(cases, jen.Default().Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("unknown %s discriminator value '%%s'", schema.Discriminator.PropertyName)), jen.Id("discriminator").Dot("Value"))),
+		))
+
+		body = append(body,
+			jen.Var().Id("discriminator").Struct(jen.Id("Value").String().Tag(map[string]string{"json": schema.Discriminator.PropertyName})),
+			jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("discriminator")), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Id("err")),
+			),
+			jen.Line().Switch(jen.Id("discriminator").Dot("Value")).Block(cases...),
+			jen.Line().Return(jen.Nil()),
+		)
+	} else {
+		for _, variant := range variants {
+			body = append(body,
+				jen.If(jen.List(jen.Id("value"), jen.Id("err")).Op(":=").Id("unmarshalUnionVariant").Types(jen.Qual(generator.config.ComponentsPackage, variant.typeName)).Call(jen.Id("data")), jen.Id("err").Op("==").Nil()).Block(
+					jen.Id("union").Dot("value").Op("=").Id("value"),
+					jen.Return(jen.Nil()),
+				),
+				jen.Line(),
+			)
+		}
+
+		body = append(body, jen.Return(jen.Qual("errors", "New").Call(jen.Lit(fmt.Sprintf("data does not match any %s variant", name)))))
+	}
+
+	return jen.Func().Params(jen.Id("union").Op("*").Id(name)).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(body...)
+}
+
+// unionHelpers is emitted once into the components when at least one union without a discriminator is generated.
+func (generator *Generator) unionHelpers() jen.Code {
+	if !generator.useUnions {
+		return jen.Null()
+	}
+
+	return jen.Func().Id("unmarshalUnionVariant").Types(jen.Id("T").Any()).Params(jen.Id("data").Index().Byte()).Params(jen.Id("T"), jen.Error()).Block(
+		jen.Var().Id("value").Id("T"),
+		jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("value")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Id("value"), jen.Id("err")),
+		),
+		jen.Line().If(jen.List(jen.Id("validatable"), jen.Id("ok")).Op(":=").Any().Call(jen.Id("value")).Assert(jen.Qual("github.com/go-ozzo/ozzo-validation/v4", "Validatable")), jen.Id("ok")).Block(
+			jen.Return(jen.Id("value"), jen.Id("validatable").Dot("Validate").Call()),
+		),
+		jen.Line().Return(jen.Id("value"), jen.Nil()),
+	)
+}
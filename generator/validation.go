@@ -0,0 +1,412 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const ozzoValidation = "github.com/go-ozzo/ozzo-validation/v4"
+
+// stringFormatRules are the string formats validated by the ozzo is package.
+var stringFormatRules = map[string]string{
+	"email":    "EmailFormat",
+	"uri":      "RequestURL",
+	"ipv4":     "IPv4",
+	"hostname": "DNSName",
+}
+
+// fieldValidationRuleFromSchema returns the ozzo rule of a field, absent and null Optional[T] fields are skipped by the rules themselves.
+// Fields of components are listed without rules as well, so ozzo validates the nested components.
+func (generator *Generator) fieldValidationRuleFromSchema(receiverName string, propertyName string, schema *openapi3.SchemaRef, required bool, optional bool) jen.Code {
+	if generator.typee.getXGoSkipValidation(schema.Value) {
+		return nil
+	}
+
+	field := jen.Id(receiverName).Dot(propertyName)
+	rules := generator.validationRules(schema, required, optional, field)
+	if len(rules) == 0 && !generator.isValidatableSchema(schema) {
+		return nil
+	}
+
+	return jen.Qual(ozzoValidation, "Field").Call(append([]jen.Code{jen.Op("&").Add(field)}, rules...)...)
+}
+
+// validationRules returns the ozzo rules of a schema. The value is nil for array items, which are always present.
+func (generator *Generator) validationRules(schema *openapi3.SchemaRef, required bool, optional bool, value jen.Code) []jen.Code {
+	v := schema.Value
+
+	var rules []jen.Code
+	if value != nil && !required && !optional && schema.Ref != "" && generator.isValidatableSchema(schema) && !generator.typee.getXGoPointer(v) {
+		// a missing component which is not a pointer is the zero struct, its required properties are not validated then
+		rules = append(rules, jen.Qual(ozzoValidation, "Skip").Dot("When").Call(jen.Qual("reflect", "ValueOf").Call(value).Dot("IsZero").Call()))
+	}
+
+	switch {
+	case isSchemaType(v.Type, "string") && !generator.typee.isPreciseTimeType(v):
+		rules = append(rules, generator.stringValidationRules(v, required || value == nil, optional, value)...)
+	case isSchemaType(v.Type, "integer") || isSchemaType(v.Type, "number"):
+		// zero is a sent value unless the field is a plain one which is neither required nor defaulted
+		rules = append(rules, generator.numberValidationRules(v, optional, required || optional || value == nil || v.Default != nil)...)
+	case isSchemaType(v.Type, "array"):
+		rules = append(rules, generator.arrayValidationRules(v, required, optional)...)
+	case isSchemaType(v.Type, "object") && len(v.Properties) == 0 && (v.AdditionalProperties.Has != nil || v.AdditionalProperties.Schema != nil):
+		if v.MinProps > 0 || v.MaxProps != nil {
+			rules = append(rules, generator.lengthRules(v.MinProps, v.MaxProps, "properties")...)
+		}
+		rules = append(rules, generator.eachRules(v.AdditionalProperties.Schema, optional)...)
+	}
+
+	return rules
+}
+
+func (generator *Generator) stringValidationRules(v *openapi3.Schema, required bool, optional bool, value jen.Code) (rules []jen.Code) {
+	if v.MaxLength != nil || v.MinLength > 0 {
+		if v.MinLength > 0 && required {
+			rules = append(rules, jen.Qual(ozzoValidation, "Required"))
+		} else if v.MinLength > 0 && !optional {
+			rules = append(rules, jen.Qual(ozzoValidation, "Skip").Dot("When").Call(jen.Add(value).Op("==").Lit("")))
+		}
+		// the bounds are checked apart, so a violation tells minLength from maxLength
+		if v.MinLength > 0 {
+			rules = append(rules, jen.Qual(ozzoValidation, "RuneLength").Call(jen.Lit(int(v.MinLength)), jen.Lit(0)))
+		}
+		if v.MaxLength != nil {
+			rules = append(rules, jen.Qual(ozzoValidation, "RuneLength").Call(jen.Lit(0), jen.Lit(int(*v.MaxLength))))
+		}
+	}
+
+	// patterns and formats only apply to values which are strings in go
+	if generator.typee.hasXGoType(v) || (generator.typee.isCustomType(v) && !isTimeFormat(v)) {
+		return rules
+	}
+
+	if v.Pattern != "" {
+		rules = append(rules, jen.Qual(ozzoValidation, "Match").Call(generator.patternVariable(v.Pattern)))
+	}
+
+	if rule, ok := stringFormatRules[v.Format]; ok {
+		rules = append(rules, jen.Qual(ozzoValidation+"/is", rule))
+	}
+
+	return rules
+}
+
+// numberValidationRules checks the bounds with ozzo's Min and Max, which skip zero values. A bound zero violates is checked
+// by the generated Minimum and Maximum rules instead when zero is a sent value.
+func (generator *Generator) numberValidationRules(v *openapi3.Schema, optional bool, zeroIsValue bool) (rules []jen.Code) {
+	isInteger := isSchemaType(v.Type, "integer")
+
+	if v.Min != nil {
+		min := jen.Lit(*v.Min)
+		if isInteger {
+			min = jen.Lit(int(*v.Min))
+		}
+		if zeroIsValue && (*v.Min > 0 || (*v.Min == 0 && v.ExclusiveMin)) {
+			generator.useMinimum = true
+			rules = append(rules, jen.Qual(ozzoValidation, "By").Call(jen.Qual(generator.config.ComponentsPackage, "Minimum").Call(min, jen.Lit(v.ExclusiveMin))))
+		} else {
+			r := jen.Qual(ozzoValidation, "Min").Call(min)
+			if v.ExclusiveMin {
+				r.Dot("Exclusive").Call()
+			}
+			rules = append(rules, r)
+		}
+	}
+	if v.Max != nil {
+		max := jen.Lit(*v.Max)
+		if isInteger {
+			max = jen.Lit(int(*v.Max))
+		}
+		if zeroIsValue && (*v.Max < 0 || (*v.Max == 0 && v.ExclusiveMax)) {
+			generator.useMaximum = true
+			rules = append(rules, jen.Qual(ozzoValidation, "By").Call(jen.Qual(generator.config.ComponentsPackage, "Maximum").Call(max, jen.Lit(v.ExclusiveMax))))
+		} else {
+			r := jen.Qual(ozzoValidation, "Max").Call(max)
+			if v.ExclusiveMax {
+				r.Dot("Exclusive").Call()
+			}
+			rules = append(rules, r)
+		}
+	}
+	if v.MultipleOf != nil {
+		// ozzo supports integer bases of plain values only, the generated MultipleOf rule checks fractional bases and Optional[T] values
+		if isInteger && *v.MultipleOf == float64(int(*v.MultipleOf)) && !optional {
+			rules = append(rules, jen.Qual(ozzoValidation, "MultipleOf").Call(jen.Lit(int(*v.MultipleOf))))
+		} else {
+			generator.useMultipleOf = true
+			rules = append(rules, jen.Qual(ozzoValidation, "By").Call(jen.Qual(generator.config.ComponentsPackage, "MultipleOf").Call(jen.Lit(*v.MultipleOf))))
+		}
+	}
+
+	return rules
+}
+
+func (generator *Generator) arrayValidationRules(v *openapi3.Schema, required bool, optional bool) (rules []jen.Code) {
+	if v.MinItems > 0 && required {
+		rules = append(rules, jen.Qual(ozzoValidation, "Required"))
+	}
+	if v.MinItems > 0 || v.MaxItems != nil {
+		rules = append(rules, generator.lengthRules(v.MinItems, v.MaxItems, "items")...)
+	}
+	if v.UniqueItems {
+		generator.useUniqueItems = true
+		rules = append(rules, jen.Qual(ozzoValidation, "By").Call(jen.Qual(generator.config.ComponentsPackage, "UniqueItems")))
+	}
+
+	return append(rules, generator.eachRules(v.Items, optional)...)
+}
+
+// eachRules validates the items of an array or the values of a map against their schema.
+func (generator *Generator) eachRules(items *openapi3.SchemaRef, optional bool) []jen.Code {
+	if items == nil || items.Value == nil || generator.typee.getXGoSkipValidation(items.Value) {
+		return nil
+	}
+
+	itemRules := generator.validationRules(items, true, false, nil)
+	if len(itemRules) == 0 {
+		return nil
+	}
+
+	each := jen.Qual(ozzoValidation, "Each").Call(itemRules...)
+	if optional {
+		// Each does not look into a driver.Valuer, the items of an Optional[T] are validated through its value
+		each = jen.Qual(ozzoValidation, "By").Call(jen.Func().Params(jen.Id("value").Interface()).Error().Block(
+			jen.List(jen.Id("items"), jen.Id("isNil")).Op(":=").Qual(ozzoValidation, "Indirect").Call(jen.Id("value")),
+			jen.If(jen.Id("isNil")).Block(
+				jen.Return(jen.Nil()),
+			),
+			jen.Line().Return(jen.Qual(ozzoValidation, "Validate").Call(jen.Id("items"), each)),
+		))
+	}
+
+	return []jen.Code{each}
+}
+
+// lengthRules checks the number of items or properties, the errors are coded as validation_min_items, validation_max_properties etc.
+// ozzo's Length skips empty values, the minimum is checked by the generated MinCount rule instead.
+func (generator *Generator) lengthRules(min uint64, max *uint64, of string) (rules []jen.Code) {
+	if min > 0 {
+		generator.useMinCount = true
+		rules = append(rules, jen.Qual(ozzoValidation, "By").Call(jen.Qual(generator.config.ComponentsPackage, "MinCount").Call(jen.Lit(int(min)), jen.Lit(of))))
+	}
+	if max != nil {
+		rules = append(rules, jen.Qual(ozzoValidation, "Length").Call(jen.Lit(0), jen.Lit(int(*max))).Dot("ErrorObject").Call(
+			jen.Qual(ozzoValidation, "NewError").Call(jen.Lit("validation_max_"+of), jen.Lit(fmt.Sprintf("must contain no more than %d %s", *max, of)))))
+	}
+
+	return rules
+}
+
+// isValidatableSchema reports whether values of the schema are, or contain, components with a Validate method.
+func (generator *Generator) isValidatableSchema(schema *openapi3.SchemaRef) bool {
+	if schema == nil || schema.Value == nil || generator.typee.hasXGoType(schema.Value) || generator.typee.getXGoSkipValidation(schema.Value) {
+		return false
+	}
+
+	v := schema.Value
+	switch {
+	case schema.Ref != "":
+		return len(v.Enum) == 0 && (len(v.Properties) > 0 || isUnionSchema(v) || isEmbeddingAllOf(v))
+	case isUnionSchema(v):
+		return true
+	case isSchemaType(v.Type, "array"):
+		return generator.isValidatableSchema(v.Items)
+	case isSchemaType(v.Type, "object"):
+		return generator.isValidatableSchema(v.AdditionalProperties.Schema)
+	}
+
+	return false
+}
+
+// variablesForPatterns declares the regexps of the pattern keyword of a schema and its items or map values, sharing them with x-go-regex.
+func (generator *Generator) variablesForPatterns(name string, schema *openapi3.SchemaRef) jen.Code {
+	result := jen.Null()
+
+	for ; schema != nil && schema.Value != nil; schema = elementSchema(schema.Value) {
+		pattern := schema.Value.Pattern
+		if pattern == "" || !isSchemaType(schema.Value.Type, "string") {
+			continue
+		}
+
+		if generator.useRegex == nil {
+			generator.useRegex = map[string]string{}
+		}
+
+		if _, ok := generator.useRegex[pattern]; ok {
+			continue
+		}
+
+		varName := generator.normalizer.decapitalize(name) + "Pattern"
+		for i := 2; generator.isRegexVariable(varName); i++ {
+			varName = fmt.Sprintf("%sPattern%d", generator.normalizer.decapitalize(name), i)
+		}
+
+		generator.useRegex[pattern] = varName
+		result.Add(jen.Var().Id(varName).Op("=").Qual("regexp", "MustCompile").Call(jen.Lit(pattern)).Line())
+	}
+
+	return result
+}
+
+// elementSchema returns the schema of the items of an array or of the values of a map.
+func elementSchema(schema *openapi3.Schema) *openapi3.SchemaRef {
+	if schema.Items != nil {
+		return schema.Items
+	}
+
+	return schema.AdditionalProperties.Schema
+}
+
+func (generator *Generator) isRegexVariable(name string) bool {
+	for _, variable := range generator.useRegex {
+		if variable == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// patternVariable returns the regexp of a pattern, patterns of schemas without a declared variable are compiled in place.
+func (generator *Generator) patternVariable(pattern string) jen.Code {
+	if name, ok := generator.useRegex[pattern]; ok {
+		return jen.Id(name)
+	}
+
+	return jen.Qual("regexp", "MustCompile").Call(jen.Lit(pattern))
+}
+
+// validationHelpers is emitted into the components after the routes are generated, so the rules used only by parameters are included.
+func (generator *Generator) validationHelpers() []jen.Code {
+	var result []jen.Code
+
+	if generator.useUniqueItems {
+		result = append(result,
+			jen.Comment("UniqueItems is a validation rule failing on arrays with equal items, items are compared by their JSON.").Line().
+				Func().Id("UniqueItems").Params(jen.Id("value").Interface()).Error().Block(
+				jen.List(jen.Id("value"), jen.Id("_")).Op("=").Qual(ozzoValidation, "Indirect").Call(jen.Id("value")),
+				jen.Id("items").Op(":=").Qual("reflect", "ValueOf").Call(jen.Id("value")),
+				jen.If(jen.Id("items").Dot("Kind").Call().Op("!=").Qual("reflect", "Slice")).Block(
+					jen.Return(jen.Nil()),
+				),
+				jen.Line().Id("seen").Op(":=").Map(jen.String()).Struct().Values(),
+				jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("items").Dot("Len").Call(), jen.Id("i").Op("++")).Block(
+					jen.List(jen.Id("data"), jen.Id("err")).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("items").Dot("Index").Call(jen.Id("i")).Dot("Interface").Call()),
+					jen.If(jen.Id("err").Op("!=").Nil()).Block(
+						jen.Return(jen.Id("err")),
+					),
+					jen.Line().If(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("seen").Index(jen.String().Call(jen.Id("data"))), jen.Id("ok")).Block(
+						jen.Return(jen.Qual(ozzoValidation, "NewError").Call(jen.Lit("validation_unique_items"), jen.Lit("must not contain duplicate items"))),
+					),
+					jen.Line().Id("seen").Index(jen.String().Call(jen.Id("data"))).Op("=").Struct().Values(),
+				),
+				jen.Line().Return(jen.Nil()),
+			),
+		)
+	}
+
+	if generator.useMultipleOf {
+		result = append(result,
+			jen.Comment("MultipleOf returns a validation rule checking that a number is a multiple of a fractional base.").Line().
+				Func().Id("MultipleOf").Params(jen.Id("base").Float64()).Qual(ozzoValidation, "RuleFunc").Block(
+				jen.Return(jen.Func().Params(jen.Id("value").Interface()).Error().Block(
+					jen.List(jen.Id("value"), jen.Id("_")).Op("=").Qual(ozzoValidation, "Indirect").Call(jen.Id("value")),
+					jen.If(jen.Qual(ozzoValidation, "IsEmpty").Call(jen.Id("value"))).Block(
+						jen.Return(jen.Nil()),
+					),
+					jen.Line().List(jen.Id("number"), jen.Id("err")).Op(":=").Id("numberOf").Call(jen.Id("value")),
+					jen.If(jen.Id("err").Op("!=").Nil()).Block(
+						jen.Return(jen.Id("err")),
+					),
+					jen.Line().Id("quotient").Op(":=").Id("number").Op("/").Id("base"),
+					jen.If(jen.Qual("math", "Abs").Call(jen.Id("quotient").Op("-").Qual("math", "Round").Call(jen.Id("quotient"))).Op(">").Lit(1e-9)).Block(
+						jen.Return(jen.Qual(ozzoValidation, "ErrMultipleOfInvalid").Dot("SetParams").Call(jen.Map(jen.String()).Interface().Values(jen.Dict{jen.Lit("base"): jen.Id("base")}))),
+					),
+					jen.Line().Return(jen.Nil()),
+				)),
+			),
+		)
+	}
+
+	bound := func(name string, comment string, compare string, err string, exclusiveErr string) jen.Code {
+		return jen.Comment(comment).Line().
+			Comment("zero values.").Line().
+			Func().Id(name).Params(jen.Id("threshold").Float64(), jen.Id("exclusive").Bool()).Qual(ozzoValidation, "RuleFunc").Block(
+			jen.Return(jen.Func().Params(jen.Id("value").Interface()).Error().Block(
+				jen.List(jen.Id("value"), jen.Id("isNil")).Op(":=").Qual(ozzoValidation, "Indirect").Call(jen.Id("value")),
+				jen.If(jen.Id("isNil")).Block(
+					jen.Return(jen.Nil()),
+				),
+				jen.Line().List(jen.Id("number"), jen.Id("err")).Op(":=").Id("numberOf").Call(jen.Id("value")),
+				jen.If(jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				),
+				jen.Line().Id("params").Op(":=").Map(jen.String()).Interface().Values(jen.Dict{jen.Lit("threshold"): jen.Id("threshold")}),
+				jen.Switch().Block(
+					jen.Case(jen.Id("exclusive").Op("&&").Id("number").Op(compare+"=").Id("threshold")).Block(
+						jen.Return(jen.Qual(ozzoValidation, exclusiveErr).Dot("SetParams").Call(jen.Id("params"))),
+					),
+					jen.Case(jen.Id("number").Op(compare).Id("threshold")).Block(
+						jen.Return(jen.Qual(ozzoValidation, err).Dot("SetParams").Call(jen.Id("params"))),
+					),
+				),
+				jen.Line().Return(jen.Nil()),
+			)),
+		)
+	}
+
+	if generator.useMinimum {
+		result = append(result, bound("Minimum",
+			"Minimum returns a validation rule checking that a number is no less than threshold, or greater when exclusive, unlike ozzo's Min it checks", "<", "ErrMinGreaterEqualThanRequired", "ErrMinGreaterThanRequired"))
+	}
+
+	if generator.useMaximum {
+		result = append(result, bound("Maximum",
+			"Maximum returns a validation rule checking that a number is no greater than threshold, or less when exclusive, unlike ozzo's Max it checks", ">", "ErrMaxLessEqualThanRequired", "ErrMaxLessThanRequired"))
+	}
+
+	if generator.useMultipleOf || generator.useMinimum || generator.useMaximum {
+		result = append(result,
+			jen.Comment("numberOf converts an integer or a floating point value for the numeric rules.").Line().
+				Func().Id("numberOf").Params(jen.Id("value").Interface()).Params(jen.Float64(), jen.Error()).Block(
+				jen.List(jen.Id("number"), jen.Id("err")).Op(":=").Qual(ozzoValidation, "ToFloat").Call(jen.Id("value")),
+				jen.If(jen.Id("err").Op("==").Nil()).Block(
+					jen.Return(jen.Id("number"), jen.Nil()),
+				),
+				jen.Line().List(jen.Id("integer"), jen.Id("intErr")).Op(":=").Qual(ozzoValidation, "ToInt").Call(jen.Id("value")),
+				jen.If(jen.Id("intErr").Op("!=").Nil()).Block(
+					jen.Return(jen.Lit(0), jen.Id("err")),
+				),
+				jen.Line().Return(jen.Float64().Call(jen.Id("integer")), jen.Nil()),
+			),
+		)
+	}
+
+	if generator.useMinCount {
+		result = append(result,
+			jen.Comment("MinCount returns a validation rule checking that a slice or map holds at least min items or properties, nil").Line().
+				Comment("values are left to Required while empty ones fail.").Line().
+				Func().Id("MinCount").Params(jen.Id("min").Int(), jen.Id("of").String()).Qual(ozzoValidation, "RuleFunc").Block(
+				jen.Return(jen.Func().Params(jen.Id("value").Interface()).Error().Block(
+					jen.List(jen.Id("value"), jen.Id("isNil")).Op(":=").Qual(ozzoValidation, "Indirect").Call(jen.Id("value")),
+					jen.If(jen.Id("isNil")).Block(
+						jen.Return(jen.Nil()),
+					),
+					jen.Line().Id("values").Op(":=").Qual("reflect", "ValueOf").Call(jen.Id("value")),
+					jen.If(jen.Id("values").Dot("Kind").Call().Op("!=").Qual("reflect", "Slice").Op("&&").Id("values").Dot("Kind").Call().Op("!=").Qual("reflect", "Map")).Block(
+						jen.Return(jen.Nil()),
+					),
+					jen.Line().If(jen.Id("values").Dot("Len").Call().Op("<").Id("min")).Block(
+						jen.Return(jen.Qual(ozzoValidation, "NewError").Call(jen.Lit("validation_min_").Op("+").Id("of"),
+							jen.Qual("fmt", "Sprintf").Call(jen.Lit("must contain no less than %d %s"), jen.Id("min"), jen.Id("of")))),
+					),
+					jen.Line().Return(jen.Nil()),
+				)),
+			),
+		)
+	}
+
+	return result
+}
@@ -0,0 +1,213 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// violationKeywords maps the codes of ozzo errors to the JSON Schema keywords they check.
+var violationKeywords = map[string]string{
+	"validation_required":                        "required",
+	"validation_nil_or_not_empty_required":       "required",
+	"validation_not_nil_required":                "required",
+	"validation_length_too_short":                "minLength",
+	"validation_length_too_long":                 "maxLength",
+	"validation_match_invalid":                   "pattern",
+	"validation_min_greater_equal_than_required": "minimum",
+	"validation_min_greater_than_required":       "exclusiveMinimum",
+	"validation_max_less_equal_than_required":    "maximum",
+	"validation_max_less_than_required":          "exclusiveMaximum",
+	"validation_min_items":                       "minItems",
+	"validation_max_items":                       "maxItems",
+	"validation_min_properties":                  "minProperties",
+	"validation_max_properties":                  "maxProperties",
+	"validation_multiple_of_invalid":             "multipleOf",
+	"validation_in_invalid":                      "enum",
+	"validation_unique_items":                    "uniqueItems",
+	"validation_is_email":                        "format",
+	"validation_is_request_url":                  "format",
+	"validation_is_ipv4":                         "format",
+	"validation_is_dns_name":                     "format",
+}
+
+// jsonPointer returns the JSON pointer of a path of property names.
+func jsonPointer(path ...string) string {
+	var pointer string
+	for _, name := range path {
+		pointer += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+	}
+
+	return pointer
+}
+
+// newViolation returns a ValidationError of a single violation, location is empty in components and set by the routes.
+func (generator *Generator) newViolation(location string, pointer string, keyword string, message jen.Code) jen.Code {
+	return jen.Qual(generator.config.ComponentsPackage, "NewViolation").Call(jen.Lit(location), jen.Lit(pointer), jen.Lit(keyword), message)
+}
+
+// validationErrorOf converts an error of a parser or a validator found in location into a ValidationError.
+func (generator *Generator) validationErrorOf(location string, err jen.Code) jen.Code {
+	return jen.Qual(generator.config.ComponentsPackage, "ValidationErrorOf").Call(jen.Lit(location), jen.Lit(""), err)
+}
+
+// validationErrorTypes is emitted into the components, ValidationError is returned by the unmarshalling of components
+// and reported through the hooks of the routes.
+func (generator *Generator) validationErrorTypes() []jen.Code {
+	violation := func(pointer jen.Code, keyword jen.Code, message jen.Code) jen.Code {
+		return jen.Id("Violation").Values(jen.Dict{
+			jen.Id("Pointer"):  pointer,
+			jen.Id("Location"): jen.Id("location"),
+			jen.Id("Keyword"):  keyword,
+			jen.Id("Message"):  message,
+		})
+	}
+
+	appendViolations := func(violations ...jen.Code) jen.Code {
+		return jen.Id("result").Dot("Violations").Op("=").Append(append([]jen.Code{jen.Id("result").Dot("Violations")}, violations...)...)
+	}
+
+	return []jen.Code{
+		jen.Comment("Violation is a failed constraint of a request. Pointer is the JSON pointer of the failed value in its location,").Line().
+			Comment("the body, query, header, path or cookie, and Keyword is the failed JSON Schema keyword.").Line().
+			Type().Id("Violation").Struct(
+			jen.Id("Pointer").String().Tag(map[string]string{"json": "pointer"}),
+			jen.Id("Location").String().Tag(map[string]string{"json": "location"}),
+			jen.Id("Keyword").String().Tag(map[string]string{"json": "keyword,omitempty"}),
+			jen.Id("Message").String().Tag(map[string]string{"json": "message"}),
+		),
+		jen.Comment("ValidationError lists the violations found while parsing and validating a request.").Line().
+			Type().Id("ValidationError").Struct(
+			jen.Id("Violations").Index().Id("Violation").Tag(map[string]string{"json": "violations"}),
+		),
+		jen.Var().Id("violationKeywords").Op("=").Map(jen.String()).String().Values(jen.DictFunc(func(dict jen.Dict) {
+			for _, code := range sortedMapKeys(violationKeywords) {
+				dict[jen.Lit(code)] = jen.Lit(violationKeywords[code])
+			}
+		})),
+		jen.Var().Id("pointerEscaper").Op("=").Qual("strings", "NewReplacer").Call(jen.Lit("~"), jen.Lit("~0"), jen.Lit("/"), jen.Lit("~1")),
+		jen.Func().Id("NewViolation").Params(jen.List(jen.Id("location"), jen.Id("pointer"), jen.Id("keyword"), jen.Id("message")).String()).Id("ValidationError").Block(
+			jen.Return(jen.Id("ValidationError").Values(jen.Id("Violations").Op(":").Index().Id("Violation").Values(
+				violation(jen.Id("pointer"), jen.Id("keyword"), jen.Id("message")),
+			))),
+		),
+		jen.Func().Params(jen.Id("err").Id("ValidationError")).Id("Error").Params().String().Block(
+			jen.Id("messages").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(jen.Id("err").Dot("Violations"))),
+			jen.For(jen.List(jen.Id("_"), jen.Id("violation")).Op(":=").Range().Id("err").Dot("Violations")).Block(
+				jen.If(jen.Id("violation").Dot("Pointer").Op("==").Lit("")).Block(
+					jen.Id("messages").Op("=").Append(jen.Id("messages"), jen.Id("violation").Dot("Message")),
+					jen.Continue(),
+				),
+				jen.Line().Id("messages").Op("=").Append(jen.Id("messages"), jen.Id("violation").Dot("Pointer").Op("+").Lit(": ").Op("+").Id("violation").Dot("Message")),
+			),
+			jen.Line().Return(jen.Qual("strings", "Join").Call(jen.Id("messages"), jen.Lit("; "))),
+		),
+		jen.Comment("ValidationErrorOf converts an error of a parser or a validator found in location into a ValidationError,").Line().
+			Comment("the violations of ozzo errors, encoding/json type errors and nested ValidationErrors are prefixed with pointer.").Line().
+			Comment("A nil err has no violations.").Line().
+			Func().Id("ValidationErrorOf").Params(jen.List(jen.Id("location"), jen.Id("pointer")).String(), jen.Id("err").Error()).Id("ValidationError").Block(
+			jen.If(jen.Id("err").Op("==").Nil()).Block(
+				jen.Return(jen.Id("ValidationError").Values()),
+			),
+			jen.Line().Var().Defs(
+				jen.Id("result").Id("ValidationError"),
+				jen.Id("violations").Id("ValidationError"),
+				jen.Id("errs").Qual(ozzoValidation, "Errors"),
+				jen.Id("ruleErr").Qual(ozzoValidation, "Error"),
+				jen.Id("typeErr").Op("*").Qual("encoding/json", "UnmarshalTypeError"),
+			),
+			jen.Line().Switch().Block(
+				jen.Case(jen.Qual("errors", "As").Call(jen.Id("err"), jen.Op("&").Id("violations"))).Block(
+					jen.For(jen.List(jen.Id("_"), jen.Id("violation")).Op(":=").Range().Id("violations").Dot("Violations")).Block(
+						jen.Id("violation").Dot("Pointer").Op("=").Id("pointer").Op("+").Id("violation").Dot("Pointer"),
+						jen.If(jen.Id("violation").Dot("Location").Op("==").Lit("")).Block(
+							jen.Id("violation").Dot("Location").Op("=").Id("location"),
+						),
+						appendViolations(jen.Id("violation")),
+					),
+				),
+				jen.Case(jen.Qual("errors", "As").Call(jen.Id("err"), jen.Op("&").Id("errs"))).Block(
+					jen.Id("keys").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(jen.Id("errs"))),
+					jen.For(jen.Id("key").Op(":=").Range().Id("errs")).Block(
+						jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("key")),
+					),
+					jen.Qual("sort", "Strings").Call(jen.Id("keys")),
+					jen.Line().For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("keys")).Block(
+						appendViolations(jen.Id("ValidationErrorOf").Call(jen.Id("location"), jen.Id("pointer").Op("+").Lit("/").Op("+").Id("pointerEscaper").Dot("Replace").Call(jen.Id("key")), jen.Id("errs").Index(jen.Id("key"))).Dot("Violations").Op("...")),
+					),
+				),
+				jen.Case(jen.Qual("errors", "As").Call(jen.Id("err"), jen.Op("&").Id("ruleErr"))).Block(
+					jen.List(jen.Id("keyword"), jen.Id("ok")).Op(":=").Id("violationKeywords").Index(jen.Id("ruleErr").Dot("Code").Call()),
+					jen.If(jen.Op("!").Id("ok")).Block(
+						jen.Id("keyword").Op("=").Id("ruleErr").Dot("Code").Call(),
+					),
+					jen.Line().Add(appendViolations(violation(jen.Id("pointer"), jen.Id("keyword"), jen.Id("ruleErr").Dot("Error").Call()))),
+				),
+				jen.Case(jen.Qual("errors", "As").Call(jen.Id("err"), jen.Op("&").Id("typeErr"))).Block(
+					jen.If(jen.Id("typeErr").Dot("Field").Op("!=").Lit("")).Block(
+						jen.Id("pointer").Op("+=").Lit("/").Op("+").Qual("strings", "ReplaceAll").Call(jen.Id("typeErr").Dot("Field"), jen.Lit("."), jen.Lit("/")),
+					),
+					jen.Line().Add(appendViolations(violation(jen.Id("pointer"), jen.Lit("type"),
+						jen.Qual("fmt", "Sprintf").Call(jen.Lit("cannot unmarshal %s into %s"), jen.Id("typeErr").Dot("Value"), jen.Id("typeErr").Dot("Type"))))),
+				),
+				jen.Default().Block(
+					appendViolations(violation(jen.Id("pointer"), jen.Lit(""), jen.Id("err").Dot("Error").Call())),
+				),
+			),
+			jen.Line().Return(jen.Id("result")),
+		),
+		jen.Comment("unmarshalViolations locates the properties of data failing to unmarshal into the fields of the unmarshal helper").Line().
+			Comment("target, so the violations of nested components point at them. It is called once unmarshalling has failed.").Line().
+			Func().Id("unmarshalViolations").Params(jen.Id("data").Index().Byte(), jen.Id("target").Interface(), jen.Id("err").Error()).Error().Block(
+			jen.Var().Id("properties").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+			jen.If(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("properties")).Op("!=").Nil()).Block(
+				jen.Return(jen.Id("ValidationErrorOf").Call(jen.Lit(""), jen.Lit(""), jen.Id("err"))),
+			),
+			jen.Line().Var().Id("result").Id("ValidationError"),
+			jen.Id("fields").Op(":=").Qual("reflect", "TypeOf").Call(jen.Id("target")).Dot("Elem").Call(),
+			jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("fields").Dot("NumField").Call(), jen.Id("i").Op("++")).Block(
+				jen.List(jen.Id("name"), jen.Id("_"), jen.Id("_")).Op(":=").Qual("strings", "Cut").Call(jen.Id("fields").Dot("Field").Call(jen.Id("i")).Dot("Tag").Dot("Get").Call(jen.Lit("json")), jen.Lit(",")),
+				jen.If(jen.List(jen.Id("property"), jen.Id("ok")).Op(":=").Id("properties").Index(jen.Id("name")), jen.Id("ok")).Block(
+					appendViolations(jen.Id("propertyViolations").Call(jen.Lit("/").Op("+").Id("pointerEscaper").Dot("Replace").Call(jen.Id("name")), jen.Id("property"), jen.Id("fields").Dot("Field").Call(jen.Id("i")).Dot("Type")).Op("...")),
+				),
+			),
+			jen.Line().If(jen.Len(jen.Id("result").Dot("Violations")).Op("==").Lit(0)).Block(
+				jen.Return(jen.Id("ValidationErrorOf").Call(jen.Lit(""), jen.Lit(""), jen.Id("err"))),
+			),
+			jen.Line().Return(jen.Id("result")),
+		),
+		jen.Comment("propertyViolations unmarshals a property on its own, the items of failing arrays and maps are unmarshalled one by one.").Line().
+			Func().Id("propertyViolations").Params(jen.Id("pointer").String(), jen.Id("data").Index().Byte(), jen.Id("typ").Qual("reflect", "Type")).Index().Id("Violation").Block(
+			jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Qual("reflect", "New").Call(jen.Id("typ")).Dot("Interface").Call()),
+			jen.If(jen.Id("err").Op("==").Nil()).Block(
+				jen.Return(jen.Nil()),
+			),
+			jen.Line().For(jen.Id("typ").Dot("Kind").Call().Op("==").Qual("reflect", "Ptr")).Block(
+				jen.Id("typ").Op("=").Id("typ").Dot("Elem").Call(),
+			),
+			jen.Line().Var().Id("violations").Index().Id("Violation"),
+			jen.Switch(jen.Id("typ").Dot("Kind").Call()).Block(
+				jen.Case(jen.Qual("reflect", "Slice")).Block(
+					jen.Var().Id("items").Index().Qual("encoding/json", "RawMessage"),
+					jen.If(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("items")).Op("==").Nil()).Block(
+						jen.For(jen.List(jen.Id("i"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+							jen.Id("violations").Op("=").Append(jen.Id("violations"), jen.Id("propertyViolations").Call(jen.Id("pointer").Op("+").Lit("/").Op("+").Qual("strconv", "Itoa").Call(jen.Id("i")), jen.Id("item"), jen.Id("typ").Dot("Elem").Call()).Op("...")),
+						),
+					),
+				),
+				jen.Case(jen.Qual("reflect", "Map")).Block(
+					jen.Var().Id("items").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+					jen.If(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("items")).Op("==").Nil()).Block(
+						jen.For(jen.List(jen.Id("key"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+							jen.Id("violations").Op("=").Append(jen.Id("violations"), jen.Id("propertyViolations").Call(jen.Id("pointer").Op("+").Lit("/").Op("+").Id("pointerEscaper").Dot("Replace").Call(jen.Id("key")), jen.Id("item"), jen.Id("typ").Dot("Elem").Call()).Op("...")),
+						),
+					),
+				),
+			),
+			jen.Line().If(jen.Len(jen.Id("violations")).Op("==").Lit(0)).Block(
+				jen.Return(jen.Id("ValidationErrorOf").Call(jen.Lit(""), jen.Id("pointer"), jen.Id("err")).Dot("Violations")),
+			),
+			jen.Line().Return(jen.Id("violations")),
+		),
+	}
+}
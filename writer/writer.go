@@ -32,6 +32,12 @@ func (writer *Writer) Write(result *generator.Result) error {
 		return err
 	}
 
+	if result.ClientCode != nil {
+		if err := writer.write(path.Join(writer.config.Path, "client_gen.go"), result.ClientCode); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 